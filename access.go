@@ -0,0 +1,157 @@
+package mysqlparser_go
+
+import (
+	"strings"
+)
+
+// ColumnAccess 语句访问的一列
+// Column为*时表示未提供库表结构、无法展开的全部列，为空时表示只按行访问该表而未读取具体的列，如COUNT(*)
+type ColumnAccess struct {
+	Database string
+	Table    string
+	Column   string
+}
+
+// StatementAccess 语句在列级别读取和写入的库表列
+type StatementAccess struct {
+	ReadList  []*ColumnAccess
+	WriteList []*ColumnAccess
+}
+
+// GetStatementAccess 获取DML语句读取和写入的列，catalog不为nil时据其绑定未限定的列并展开*
+// UPDATE写入SET中的列，INSERT/REPLACE写入目标列，DELETE写入目标表的全部列，其余列引用均为读取
+// 无法确定来源表的列Table为空；列引用有误时仍返回其余的读写列及第一个错误
+func GetStatementAccess(s MySQLStatement, catalog *Catalog) (*StatementAccess, error) {
+	a := &StatementAccess{
+		ReadList:  make([]*ColumnAccess, 0),
+		WriteList: make([]*ColumnAccess, 0),
+	}
+	referenceList, err := resolveColumnReference(s, catalog)
+	for _, reference := range referenceList {
+		// 派生表的列在解析子查询时已记录其来源
+		if reference.Error != nil || reference.SelectAlias || reference.Derived {
+			continue
+		}
+		access := &ColumnAccess{
+			Column: reference.Column,
+		}
+		if reference.SourceAlias != "" {
+			access.Database = reference.SourceDatabase
+			access.Table = reference.SourceTable
+			access.Column = reference.SourceColumn
+		}
+		if reference.Write {
+			a.WriteList = appendColumnAccess(a.WriteList, access)
+		} else {
+			a.ReadList = appendColumnAccess(a.ReadList, access)
+		}
+	}
+
+	var readDatabaseList, readTableList []string
+	switch s.Type() {
+	case "SelectStatement":
+		readDatabaseList, readTableList = s.(*SelectStatement).DatabaseList, s.(*SelectStatement).TableList
+	case "UnionStatement":
+		readDatabaseList, readTableList = s.(*UnionStatement).DatabaseList, s.(*UnionStatement).TableList
+	case "InsertStatement":
+		insert := s.(*InsertStatement)
+		readDatabaseList, readTableList = insert.FromDatabaseList, insert.FromTableList
+		if len(insert.ColumnList) == 0 && len(insert.SetList) == 0 && len(insert.DatabaseList) > 0 {
+			a.addTableWrite(catalog, insert.DatabaseList[0], insert.TableList[0])
+		}
+	case "ReplaceStatement":
+		replace := s.(*ReplaceStatement)
+		readDatabaseList, readTableList = replace.FromDatabaseList, replace.FromTableList
+		if len(replace.ColumnList) == 0 && len(replace.SetList) == 0 && len(replace.DatabaseList) > 0 {
+			a.addTableWrite(catalog, replace.DatabaseList[0], replace.TableList[0])
+		}
+	case "UpdateStatement":
+		for _, tableName := range s.(*UpdateStatement).ReadTables {
+			readDatabaseList = append(readDatabaseList, tableName.Database)
+			readTableList = append(readTableList, tableName.Table)
+		}
+	case "DeleteStatement":
+		for _, tableName := range s.(*DeleteStatement).ReadTables {
+			readDatabaseList = append(readDatabaseList, tableName.Database)
+			readTableList = append(readTableList, tableName.Table)
+		}
+		for _, tableName := range s.(*DeleteStatement).TargetTables {
+			a.addTableWrite(catalog, tableName.Database, tableName.Table)
+		}
+	}
+	// 未读取具体列的表按行访问记录
+	for i, table := range readTableList {
+		if table == "" {
+			continue
+		}
+		database := accessDatabase(catalog, readDatabaseList[i])
+		if !hasTableAccess(a.ReadList, database, table) {
+			a.ReadList = appendColumnAccess(a.ReadList, &ColumnAccess{
+				Database: database,
+				Table:    table,
+			})
+		}
+	}
+	return a, err
+}
+
+// accessDatabase 未指定库名时使用当前库
+func accessDatabase(catalog *Catalog, database string) string {
+	if database == "" && catalog != nil {
+		return catalog.CurrentDatabase
+	}
+	return database
+}
+
+// addTableWrite 记录写入表的全部列，表结构未知时记为*
+func (a *StatementAccess) addTableWrite(catalog *Catalog, database string, table string) {
+	database = accessDatabase(catalog, database)
+	var t *CatalogTable
+	if catalog != nil {
+		t = catalog.GetTable(database, table)
+	}
+	if t == nil {
+		a.WriteList = appendColumnAccess(a.WriteList, &ColumnAccess{
+			Database: database,
+			Table:    table,
+			Column:   "*",
+		})
+		return
+	}
+	for _, column := range t.ColumnList {
+		a.WriteList = appendColumnAccess(a.WriteList, &ColumnAccess{
+			Database: t.Database,
+			Table:    t.Name,
+			Column:   column.Name,
+		})
+	}
+}
+
+// appendColumnAccess 将列追加到列表中，已存在时忽略
+func appendColumnAccess(accessList []*ColumnAccess, access *ColumnAccess) []*ColumnAccess {
+	for _, a := range accessList {
+		if a.Database == access.Database && a.Table == access.Table && strings.EqualFold(a.Column, access.Column) {
+			return accessList
+		}
+	}
+	return append(accessList, access)
+}
+
+// appendColumnAccessList 将多个列追加到列表中，已存在时忽略，不修改原列表
+func appendColumnAccessList(accessList []*ColumnAccess, newAccessList []*ColumnAccess) []*ColumnAccess {
+	result := append(make([]*ColumnAccess, 0, len(accessList)+len(newAccessList)), accessList...)
+	for _, access := range newAccessList {
+		result = appendColumnAccess(result, access)
+	}
+	return result
+}
+
+// hasTableAccess 判断列表中是否已有该表的列
+func hasTableAccess(accessList []*ColumnAccess, database string, table string) bool {
+	for _, a := range accessList {
+		if a.Database == database && a.Table == table {
+			return true
+		}
+	}
+	return false
+}
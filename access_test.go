@@ -0,0 +1,82 @@
+package mysqlparser_go
+
+import (
+	"strings"
+	"testing"
+)
+
+func formatColumnAccessList(accessList []*ColumnAccess) string {
+	resultList := make([]string, 0)
+	for _, access := range accessList {
+		resultList = append(resultList, access.Database+"."+access.Table+"."+access.Column)
+	}
+	return strings.Join(resultList, " ")
+}
+
+func Test_StatementAccess(t *testing.T) {
+	c := NewCatalog()
+	err := c.ApplySQL("CREATE DATABASE db1; USE db1; " +
+		"CREATE TABLE a (id INT, x INT, y INT); " +
+		"CREATE TABLE b (id INT, a_id INT, z INT)")
+	if err != nil {
+		t.Fatalf("Error: %+v", err)
+	}
+	sqlList := map[string][4]string{
+		"UPDATE a SET x = x + 1 WHERE id = 1": {
+			"db1.a.x db1.a.id", "db1.a.x",
+			".a.x .a.id", ".a.x",
+		},
+		"INSERT INTO a (id, x) SELECT b.id, z FROM b WHERE a_id > 0": {
+			"db1.b.id db1.b.z db1.b.a_id", "db1.a.id db1.a.x",
+			".b.id .b.z .b.a_id", ".a.id .a.x",
+		},
+		"SELECT * FROM a JOIN b ON a.id = b.a_id": {
+			"db1.a.id db1.b.a_id db1.a.x db1.a.y db1.b.id db1.b.z", "",
+			".a.id .b.a_id .a.* .b.*", "",
+		},
+		"SELECT COUNT(*) FROM a WHERE EXISTS (SELECT 1 FROM b WHERE b.a_id = a.id)": {
+			"db1.b.a_id db1.a.id", "",
+			".b.a_id .a.id", "",
+		},
+		"SELECT COUNT(*) FROM db1.a": {
+			"db1.a.", "",
+			"db1.a.", "",
+		},
+		"DELETE p FROM a AS p JOIN b ON p.id = b.a_id WHERE z = 1": {
+			"db1.a.id db1.b.a_id db1.b.z", "db1.a.id db1.a.x db1.a.y",
+			".a.id .b.a_id ..z", ".a.*",
+		},
+		"INSERT INTO b TABLE a": {
+			"db1.a.id db1.a.x db1.a.y", "db1.b.id db1.b.a_id db1.b.z",
+			".a.*", ".b.*",
+		},
+		"INSERT INTO b (id, a_id, z) TABLE a ORDER BY y": {
+			"db1.a.id db1.a.x db1.a.y", "db1.b.id db1.b.a_id db1.b.z",
+			".a.* .a.y", ".b.id .b.a_id .b.z",
+		},
+		"INSERT INTO b VALUES (1, 2, 3)": {
+			"", "db1.b.id db1.b.a_id db1.b.z",
+			"", ".b.*",
+		},
+	}
+	for sql, expected := range sqlList {
+		statementList, err := Parse(sql)
+		if err != nil {
+			t.Errorf("%s: Error: %+v", sql, err)
+			continue
+		}
+		for i, catalog := range []*Catalog{c, nil} {
+			access, err := GetStatementAccess(statementList[0], catalog)
+			if err != nil {
+				t.Errorf("%s: Error: %+v", sql, err)
+				continue
+			}
+			if formatColumnAccessList(access.ReadList) != expected[i*2] {
+				t.Errorf("%s: Read Respect: %s, Got: %s", sql, expected[i*2], formatColumnAccessList(access.ReadList))
+			}
+			if formatColumnAccessList(access.WriteList) != expected[i*2+1] {
+				t.Errorf("%s: Write Respect: %s, Got: %s", sql, expected[i*2+1], formatColumnAccessList(access.WriteList))
+			}
+		}
+	}
+}
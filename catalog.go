@@ -0,0 +1,1050 @@
+package mysqlparser_go
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MySQL错误码，与服务端返回的错误保持一致
+const (
+	ErrDbCreateExists      = 1007
+	ErrDbDropExists        = 1008
+	ErrNoDb                = 1046
+	ErrBadDb               = 1049
+	ErrTableExists         = 1050
+	ErrBadTable            = 1051
+	ErrNonUniq             = 1052
+	ErrBadField            = 1054
+	ErrDupFieldName        = 1060
+	ErrDupKeyName          = 1061
+	ErrMultiplePriKey      = 1068
+	ErrKeyColumnNotExists  = 1072
+	ErrCantRemoveAllFields = 1090
+	ErrCantDropFieldOrKey  = 1091
+	ErrTableMustHaveColumn = 1113
+	ErrNoSuchTable         = 1146
+	ErrKeyDoesNotExist     = 1176
+)
+
+// CatalogError 应用DDL语句时产生的错误
+type CatalogError struct {
+	Code    int
+	Message string
+}
+
+func (e *CatalogError) Error() string {
+	return fmt.Sprintf("ERROR %d: %s", e.Code, e.Message)
+}
+
+func newCatalogError(code int, format string, args ...interface{}) *CatalogError {
+	return &CatalogError{
+		Code:    code,
+		Message: fmt.Sprintf(format, args...),
+	}
+}
+
+// Catalog 通过依次应用DDL语句维护的内存库表结构
+// 库名和表名区分大小写(lower_case_table_names=0)，列名和索引名不区分大小写
+type Catalog struct {
+	CurrentDatabase string
+	DatabaseList    []*CatalogDatabase
+}
+
+type CatalogDatabase struct {
+	Name      string
+	TableList []*CatalogTable
+}
+
+type CatalogTable struct {
+	Database       string
+	Name           string
+	Temporary      bool
+	ColumnList     []*CatalogColumn
+	IndexList      []*CatalogIndex
+	ForeignKeyList []*CatalogForeignKey
+	OptionList     []*CatalogTableOption
+	Partition      string
+}
+
+type CatalogColumn struct {
+	Name          string
+	DataType      string
+	Nullable      bool
+	HasDefault    bool
+	Default       string
+	OnUpdate      string
+	AutoIncrement bool
+	Comment       string
+	Generated     string
+	Stored        bool
+	Invisible     bool
+}
+
+type CatalogIndex struct {
+	Name       string
+	Kind       IndexKind
+	ColumnList []string
+	PartList   []string
+	Invisible  bool
+}
+
+type CatalogForeignKey struct {
+	Name                string
+	ColumnList          []string
+	ReferenceDatabase   string
+	ReferenceTable      string
+	ReferenceColumnList []string
+	OnDelete            string
+	OnUpdate            string
+}
+
+// CatalogTableOption 表选项，Name为大写的选项名(字符集统一为CHARSET)，Value保留原始写法
+type CatalogTableOption struct {
+	Name  string
+	Value string
+}
+
+func NewCatalog() *Catalog {
+	return &Catalog{
+		DatabaseList: make([]*CatalogDatabase, 0),
+	}
+}
+
+// ApplySQL 解析SQL并依次应用其中的语句
+func (c *Catalog) ApplySQL(sql string) error {
+	statementList, err := Parse(sql)
+	if err != nil {
+		return err
+	}
+	for _, s := range statementList {
+		if err := c.Apply(s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Apply 应用单条语句，不影响库表结构的语句被忽略；出错时库表结构保持不变
+func (c *Catalog) Apply(s MySQLStatement) error {
+	switch s.Type() {
+	case "CreateDatabaseStatement":
+		return c.applyCreateDatabase(s.(*CreateDatabaseStatement))
+	case "DropDatabaseStatement":
+		return c.applyDropDatabase(s.(*DropDatabaseStatement))
+	case "UseStatement":
+		if c.GetDatabase(s.(*UseStatement).Database) == nil {
+			return newCatalogError(ErrBadDb, "Unknown database '%s'", s.(*UseStatement).Database)
+		}
+		c.CurrentDatabase = s.(*UseStatement).Database
+	case "CreateTableStatement":
+		return c.applyCreateTable(s.(*CreateTableStatement))
+	case "AlterTableStatement":
+		return c.applyAlterTable(s.(*AlterTableStatement))
+	case "CreateIndexStatement":
+		return c.applyCreateIndex(s.(*CreateIndexStatement))
+	case "DropIndexStatement":
+		return c.applyDropIndex(s.(*DropIndexStatement))
+	case "RenameTableStatement":
+		return c.applyRenameTable(s.(*RenameTableStatement))
+	case "DropTableStatement":
+		return c.applyDropTable(s.(*DropTableStatement))
+	case "TruncateTableStatement":
+		_, err := c.findTable(s.(*TruncateTableStatement).Database, s.(*TruncateTableStatement).Table)
+		return err
+	}
+	return nil
+}
+
+// GetDatabase 按库名查找库
+func (c *Catalog) GetDatabase(name string) *CatalogDatabase {
+	for _, d := range c.DatabaseList {
+		if d.Name == name {
+			return d
+		}
+	}
+	return nil
+}
+
+// GetTable 按库名和表名查找表，库名为空时使用当前库
+func (c *Catalog) GetTable(database string, table string) *CatalogTable {
+	if database == "" {
+		database = c.CurrentDatabase
+	}
+	d := c.GetDatabase(database)
+	if d == nil {
+		return nil
+	}
+	return d.GetTable(table)
+}
+
+// GetTable 按表名查找表
+func (d *CatalogDatabase) GetTable(name string) *CatalogTable {
+	for _, t := range d.TableList {
+		if t.Name == name {
+			return t
+		}
+	}
+	return nil
+}
+
+// GetColumn 按列名查找列
+func (t *CatalogTable) GetColumn(name string) *CatalogColumn {
+	for _, column := range t.ColumnList {
+		if strings.EqualFold(column.Name, name) {
+			return column
+		}
+	}
+	return nil
+}
+
+// GetIndex 按索引名查找索引
+func (t *CatalogTable) GetIndex(name string) *CatalogIndex {
+	for _, index := range t.IndexList {
+		if strings.EqualFold(index.Name, name) {
+			return index
+		}
+	}
+	return nil
+}
+
+// GetForeignKey 按约束名查找外键
+func (t *CatalogTable) GetForeignKey(name string) *CatalogForeignKey {
+	for _, foreignKey := range t.ForeignKeyList {
+		if strings.EqualFold(foreignKey.Name, name) {
+			return foreignKey
+		}
+	}
+	return nil
+}
+
+// GetOption 按选项名查找表选项，不存在时返回空字符串
+func (t *CatalogTable) GetOption(name string) string {
+	for _, option := range t.OptionList {
+		if option.Name == strings.ToUpper(name) {
+			return option.Value
+		}
+	}
+	return ""
+}
+
+// PrimaryKey 获取主键，不存在时返回nil
+func (t *CatalogTable) PrimaryKey() *CatalogIndex {
+	for _, index := range t.IndexList {
+		if index.Kind == IndexKindPrimary {
+			return index
+		}
+	}
+	return nil
+}
+
+func (t *CatalogTable) clone() *CatalogTable {
+	newTable := &CatalogTable{
+		Database:       t.Database,
+		Name:           t.Name,
+		Temporary:      t.Temporary,
+		ColumnList:     make([]*CatalogColumn, 0, len(t.ColumnList)),
+		IndexList:      make([]*CatalogIndex, 0, len(t.IndexList)),
+		ForeignKeyList: make([]*CatalogForeignKey, 0, len(t.ForeignKeyList)),
+		OptionList:     make([]*CatalogTableOption, 0, len(t.OptionList)),
+		Partition:      t.Partition,
+	}
+	for _, column := range t.ColumnList {
+		newColumn := *column
+		newTable.ColumnList = append(newTable.ColumnList, &newColumn)
+	}
+	for _, index := range t.IndexList {
+		newIndex := *index
+		newIndex.ColumnList = append([]string{}, index.ColumnList...)
+		newIndex.PartList = append([]string{}, index.PartList...)
+		newTable.IndexList = append(newTable.IndexList, &newIndex)
+	}
+	for _, foreignKey := range t.ForeignKeyList {
+		newForeignKey := *foreignKey
+		newForeignKey.ColumnList = append([]string{}, foreignKey.ColumnList...)
+		newForeignKey.ReferenceColumnList = append([]string{}, foreignKey.ReferenceColumnList...)
+		newTable.ForeignKeyList = append(newTable.ForeignKeyList, &newForeignKey)
+	}
+	for _, option := range t.OptionList {
+		newOption := *option
+		newTable.OptionList = append(newTable.OptionList, &newOption)
+	}
+	return newTable
+}
+
+func (t *CatalogTable) fullName() string {
+	return t.Database + "." + t.Name
+}
+
+// resolveDatabase 获取语句中的库名，未指定时使用当前库
+func (c *Catalog) resolveDatabase(database string) (*CatalogDatabase, error) {
+	if database == "" {
+		database = c.CurrentDatabase
+	}
+	if database == "" {
+		return nil, newCatalogError(ErrNoDb, "No database selected")
+	}
+	d := c.GetDatabase(database)
+	if d == nil {
+		return nil, newCatalogError(ErrBadDb, "Unknown database '%s'", database)
+	}
+	return d, nil
+}
+
+// findTable 查找已存在的表，不存在时返回错误
+func (c *Catalog) findTable(database string, table string) (*CatalogTable, error) {
+	d, err := c.resolveDatabase(database)
+	if err != nil {
+		return nil, err
+	}
+	t := d.GetTable(table)
+	if t == nil {
+		return nil, newCatalogError(ErrNoSuchTable, "Table '%s.%s' doesn't exist", d.Name, table)
+	}
+	return t, nil
+}
+
+// replaceTable 用修改后的表替换原表
+func (c *Catalog) replaceTable(oldTable *CatalogTable, newTable *CatalogTable) {
+	d := c.GetDatabase(oldTable.Database)
+	for i, t := range d.TableList {
+		if t == oldTable {
+			d.TableList[i] = newTable
+		}
+	}
+}
+
+// removeTable 从库中删除表
+func (c *Catalog) removeTable(table *CatalogTable) {
+	d := c.GetDatabase(table.Database)
+	for i, t := range d.TableList {
+		if t == table {
+			d.TableList = append(d.TableList[:i], d.TableList[i+1:]...)
+			return
+		}
+	}
+}
+
+func (c *Catalog) applyCreateDatabase(s *CreateDatabaseStatement) error {
+	if c.GetDatabase(s.Database) != nil {
+		if s.IfNotExists {
+			return nil
+		}
+		return newCatalogError(ErrDbCreateExists, "Can't create database '%s'; database exists", s.Database)
+	}
+	c.DatabaseList = append(c.DatabaseList, &CatalogDatabase{
+		Name:      s.Database,
+		TableList: make([]*CatalogTable, 0),
+	})
+	return nil
+}
+
+func (c *Catalog) applyDropDatabase(s *DropDatabaseStatement) error {
+	for i, d := range c.DatabaseList {
+		if d.Name == s.Database {
+			c.DatabaseList = append(c.DatabaseList[:i], c.DatabaseList[i+1:]...)
+			if c.CurrentDatabase == s.Database {
+				c.CurrentDatabase = ""
+			}
+			return nil
+		}
+	}
+	if s.IfExists {
+		return nil
+	}
+	return newCatalogError(ErrDbDropExists, "Can't drop database '%s'; database doesn't exist", s.Database)
+}
+
+func (c *Catalog) applyCreateTable(s *CreateTableStatement) error {
+	d, err := c.resolveDatabase(s.DatabaseList[0])
+	if err != nil {
+		return err
+	}
+	if d.GetTable(s.TableList[0]) != nil {
+		if s.IfNotExists {
+			return nil
+		}
+		return newCatalogError(ErrTableExists, "Table '%s' already exists", s.TableList[0])
+	}
+	t := &CatalogTable{
+		Database:       d.Name,
+		Name:           s.TableList[0],
+		Temporary:      s.Temporary,
+		ColumnList:     make([]*CatalogColumn, 0),
+		IndexList:      make([]*CatalogIndex, 0),
+		ForeignKeyList: make([]*CatalogForeignKey, 0),
+		OptionList:     make([]*CatalogTableOption, 0),
+	}
+	if s.FromTable != "" {
+		// CREATE TABLE ... LIKE 不复制外键
+		fromTable, err := c.findTable(s.FromDatabase, s.FromTable)
+		if err != nil {
+			return err
+		}
+		likeTable := fromTable.clone()
+		t.ColumnList = likeTable.ColumnList
+		t.IndexList = likeTable.IndexList
+		t.OptionList = likeTable.OptionList
+		t.Partition = likeTable.Partition
+	}
+	for _, definition := range s.DefinitionList {
+		if definition.ColumnDefinition != nil {
+			if err := t.addColumn(definition.ColumnName, definition.ColumnDefinition, false, ""); err != nil {
+				return err
+			}
+		}
+	}
+	for _, definition := range s.DefinitionList {
+		if definition.IndexKind != IndexKindNone && definition.IndexKind != IndexKindForeign {
+			err := t.addIndex(definition.IndexKind, definition.IndexName, definition.ConstraintName, definition.KeyPartList,
+				definition.Invisible)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	for _, definition := range s.DefinitionList {
+		if definition.IndexKind == IndexKindForeign {
+			err := t.addForeignKey(definition.ConstraintName, definition.IndexName, definition.KeyPartList,
+				definition.Reference)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	for _, option := range s.TableOptionList {
+		t.setOption(option.Name, option.OptionValue)
+	}
+	if s.Partition != nil {
+		t.Partition = strings.TrimSpace(s.Partition.Value())
+	}
+	if s.Select != nil {
+		if err := c.addSelectColumnList(t, s.Select); err != nil {
+			return err
+		}
+	}
+	if len(t.ColumnList) == 0 {
+		return newCatalogError(ErrTableMustHaveColumn, "A table must have at least 1 column")
+	}
+	d.TableList = append(d.TableList, t)
+	return nil
+}
+
+// addSelectColumnList 将CREATE TABLE ... AS SELECT的查询列追加到表中，同名列以显式定义为准
+func (c *Catalog) addSelectColumnList(t *CatalogTable, statement MySQLStatement) error {
+	if statement.Type() == "UnionStatement" {
+		for _, obj := range statement.(*UnionStatement).ObjectList {
+			if (*obj).Type() == "SelectStatement" {
+				statement = (*obj).(*SelectStatement)
+				break
+			}
+		}
+	}
+	if statement.Type() != "SelectStatement" {
+		return nil
+	}
+	s := statement.(*SelectStatement)
+	factorList := getTableFactorList(s.ObjectList, false)
+	for i, field := range s.FieldList {
+		columnList := make([]*CatalogColumn, 0)
+		database, table, column, isColumn := getExpressionColumnName(field)
+		if isColumn {
+			sourceList, err := c.findSourceColumnList(factorList, database, table, column)
+			if err != nil {
+				return err
+			}
+			columnList = append(columnList, sourceList...)
+		} else {
+			columnList = append(columnList, &CatalogColumn{
+				Name:     strings.TrimSpace(field.Value()),
+				Nullable: true,
+			})
+		}
+		if s.AliasList[i] != "" && len(columnList) == 1 {
+			columnList[0].Name = s.AliasList[i]
+		}
+		for _, newColumn := range columnList {
+			if t.GetColumn(newColumn.Name) == nil {
+				t.ColumnList = append(t.ColumnList, newColumn)
+			}
+		}
+	}
+	return nil
+}
+
+// findSourceColumnList 在查询的表因子中查找列引用对应的列，*展开为全部列
+func (c *Catalog) findSourceColumnList(factorList []*TableFactorComponent, database string, table string,
+	column string) ([]*CatalogColumn, error) {
+	columnList := make([]*CatalogColumn, 0)
+	for _, factor := range factorList {
+		if factor.TableName == nil {
+			continue
+		}
+		if table != "" && findTableFactor([]*TableFactorComponent{factor}, database, table) == nil {
+			continue
+		}
+		sourceTable := c.GetTable(factor.TableName.Database, factor.TableName.Table)
+		if sourceTable == nil {
+			continue
+		}
+		for _, sourceColumn := range sourceTable.clone().ColumnList {
+			if column == "*" || strings.EqualFold(sourceColumn.Name, column) {
+				sourceColumn.AutoIncrement = false
+				columnList = append(columnList, sourceColumn)
+			}
+		}
+	}
+	if column != "*" && len(columnList) == 0 {
+		if table != "" {
+			column = table + "." + column
+		}
+		return nil, newCatalogError(ErrBadField, "Unknown column '%s' in 'field list'", column)
+	}
+	if column != "*" {
+		return columnList[:1], nil
+	}
+	return columnList, nil
+}
+
+// addColumn 添加列，first/after指定列位置，均为空时追加到末尾
+func (t *CatalogTable) addColumn(name string, definition *MySQLColumnDefinitionComponent, first bool,
+	after string) error {
+	if t.GetColumn(name) != nil {
+		return newCatalogError(ErrDupFieldName, "Duplicate column name '%s'", name)
+	}
+	column := newCatalogColumn(name, definition)
+	if err := t.insertColumn(column, first, after); err != nil {
+		return err
+	}
+	keyPart := &MySQLIndexColumnNameComponent{Column: name}
+	if definition.PrimaryKey {
+		if err := t.addIndex(IndexKindPrimary, "", "", []*MySQLIndexColumnNameComponent{keyPart}, false); err != nil {
+			return err
+		}
+	}
+	if definition.UniqueKey {
+		if err := t.addIndex(IndexKindUnique, "", "", []*MySQLIndexColumnNameComponent{keyPart}, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// insertColumn 将列插入到指定位置
+func (t *CatalogTable) insertColumn(column *CatalogColumn, first bool, after string) error {
+	if first {
+		t.ColumnList = append([]*CatalogColumn{column}, t.ColumnList...)
+		return nil
+	}
+	if after == "" {
+		t.ColumnList = append(t.ColumnList, column)
+		return nil
+	}
+	for i, c := range t.ColumnList {
+		if strings.EqualFold(c.Name, after) {
+			t.ColumnList = append(t.ColumnList[:i+1], append([]*CatalogColumn{column}, t.ColumnList[i+1:]...)...)
+			return nil
+		}
+	}
+	return newCatalogError(ErrBadField, "Unknown column '%s' in '%s'", after, t.Name)
+}
+
+// removeColumn 删除列并返回其原位置
+func (t *CatalogTable) removeColumn(name string) int {
+	for i, c := range t.ColumnList {
+		if strings.EqualFold(c.Name, name) {
+			t.ColumnList = append(t.ColumnList[:i], t.ColumnList[i+1:]...)
+			return i
+		}
+	}
+	return -1
+}
+
+func newCatalogColumn(name string, definition *MySQLColumnDefinitionComponent) *CatalogColumn {
+	column := &CatalogColumn{
+		Name:          name,
+		DataType:      definition.DataType,
+		Nullable:      !definition.NotNull && !definition.PrimaryKey,
+		HasDefault:    definition.HasDefault,
+		Default:       definition.Default,
+		OnUpdate:      definition.OnUpdate,
+		AutoIncrement: definition.AutoIncrement,
+		Comment:       definition.Comment,
+		Stored:        definition.Stored,
+		Invisible:     definition.Invisible,
+	}
+	if definition.Generated != nil {
+		column.Generated = strings.TrimSpace(definition.Generated.Value())
+	}
+	return column
+}
+
+// addIndex 添加索引，未指定索引名时按MySQL规则以首列名生成
+func (t *CatalogTable) addIndex(kind IndexKind, name string, constraintName string,
+	keyPartList []*MySQLIndexColumnNameComponent, invisible bool) error {
+	if kind == IndexKindPrimary {
+		if t.PrimaryKey() != nil {
+			return newCatalogError(ErrMultiplePriKey, "Multiple primary key defined")
+		}
+		name = "PRIMARY"
+	} else if name == "" {
+		name = constraintName
+	}
+	index := &CatalogIndex{
+		Name:       name,
+		Kind:       kind,
+		ColumnList: make([]string, 0),
+		PartList:   make([]string, 0),
+		Invisible:  invisible,
+	}
+	for _, keyPart := range keyPartList {
+		if keyPart.Column != "" {
+			column := t.GetColumn(keyPart.Column)
+			if column == nil {
+				return newCatalogError(ErrKeyColumnNotExists, "Key column '%s' doesn't exist in table", keyPart.Column)
+			}
+			if kind == IndexKindPrimary {
+				column.Nullable = false
+			}
+			index.ColumnList = append(index.ColumnList, column.Name)
+		}
+		if keyPart.MySQLBaseComponent != nil {
+			index.PartList = append(index.PartList, strings.TrimSpace(keyPart.Value()))
+		} else {
+			index.PartList = append(index.PartList, keyPart.Column)
+		}
+	}
+	if index.Name == "" {
+		index.Name = t.generateIndexName(index.ColumnList)
+	} else if t.GetIndex(index.Name) != nil {
+		return newCatalogError(ErrDupKeyName, "Duplicate key name '%s'", index.Name)
+	}
+	t.IndexList = append(t.IndexList, index)
+	return nil
+}
+
+// generateIndexName 以首列名生成索引名，重名时追加_2、_3等后缀
+func (t *CatalogTable) generateIndexName(columnList []string) string {
+	base := "functional_index"
+	if len(columnList) > 0 {
+		base = columnList[0]
+	}
+	name := base
+	for i := 2; t.GetIndex(name) != nil || strings.EqualFold(name, "PRIMARY"); i++ {
+		name = fmt.Sprintf("%s_%d", base, i)
+	}
+	return name
+}
+
+// removeIndex 删除索引
+func (t *CatalogTable) removeIndex(name string) error {
+	for i, index := range t.IndexList {
+		if strings.EqualFold(index.Name, name) {
+			t.IndexList = append(t.IndexList[:i], t.IndexList[i+1:]...)
+			return nil
+		}
+	}
+	return newCatalogError(ErrCantDropFieldOrKey, "Can't DROP '%s'; check that column/key exists", name)
+}
+
+// renameIndexColumn 修改索引中引用的列名，newName为空时从索引中移除该列，索引不再包含列时删除索引
+func (t *CatalogTable) renameIndexColumn(oldName string, newName string) {
+	indexList := make([]*CatalogIndex, 0, len(t.IndexList))
+	for _, index := range t.IndexList {
+		columnList := make([]string, 0, len(index.ColumnList))
+		partList := make([]string, 0, len(index.PartList))
+		for _, part := range index.PartList {
+			column, suffix := splitKeyPart(part)
+			if column == "" {
+				partList = append(partList, part)
+			} else if !strings.EqualFold(column, oldName) {
+				columnList = append(columnList, column)
+				partList = append(partList, part)
+			} else if newName != "" {
+				columnList = append(columnList, newName)
+				partList = append(partList, newName+suffix)
+			}
+		}
+		index.ColumnList = columnList
+		index.PartList = partList
+		if len(index.PartList) > 0 {
+			indexList = append(indexList, index)
+		}
+	}
+	t.IndexList = indexList
+}
+
+// splitKeyPart 将索引列定义拆分为列名和其后的前缀长度、排序等部分，函数索引返回空列名
+func splitKeyPart(part string) (string, string) {
+	part = strings.TrimSpace(part)
+	if strings.HasPrefix(part, "(") {
+		return "", part
+	}
+	if strings.HasPrefix(part, "`") {
+		end := strings.Index(part[1:], "`")
+		if end == -1 {
+			return part, ""
+		}
+		return part[1 : end+1], part[end+2:]
+	}
+	end := strings.IndexAny(part, "( ")
+	if end == -1 {
+		return part, ""
+	}
+	return part[:end], part[end:]
+}
+
+// addForeignKey 添加外键，未指定约束名时按MySQL规则生成tbl_ibfk_N；外键列上没有可用索引时自动创建索引
+func (t *CatalogTable) addForeignKey(name string, indexName string, keyPartList []*MySQLIndexColumnNameComponent,
+	reference *MySQLReferenceDefinitionComponent) error {
+	foreignKey := &CatalogForeignKey{
+		Name:                name,
+		ColumnList:          make([]string, 0, len(keyPartList)),
+		ReferenceDatabase:   reference.Database,
+		ReferenceTable:      reference.Table,
+		ReferenceColumnList: append([]string{}, reference.ColumnList...),
+		OnDelete:            reference.OnDelete,
+		OnUpdate:            reference.OnUpdate,
+	}
+	for _, keyPart := range keyPartList {
+		column := t.GetColumn(keyPart.Column)
+		if column == nil {
+			return newCatalogError(ErrKeyColumnNotExists, "Key column '%s' doesn't exist in table", keyPart.Column)
+		}
+		foreignKey.ColumnList = append(foreignKey.ColumnList, column.Name)
+	}
+	if foreignKey.Name == "" {
+		for i := len(t.ForeignKeyList) + 1; foreignKey.Name == "" || t.GetForeignKey(foreignKey.Name) != nil; i++ {
+			foreignKey.Name = fmt.Sprintf("%s_ibfk_%d", t.Name, i)
+		}
+	} else if t.GetForeignKey(foreignKey.Name) != nil {
+		return newCatalogError(ErrDupKeyName, "Duplicate foreign key constraint name '%s'", foreignKey.Name)
+	}
+	if !t.hasIndexPrefix(foreignKey.ColumnList) {
+		if indexName == "" {
+			indexName = name
+		}
+		if err := t.addIndex(IndexKindIndex, indexName, "", keyPartList, false); err != nil {
+			return err
+		}
+	}
+	t.ForeignKeyList = append(t.ForeignKeyList, foreignKey)
+	return nil
+}
+
+// hasIndexPrefix 判断是否存在以指定列为前缀的索引
+func (t *CatalogTable) hasIndexPrefix(columnList []string) bool {
+	for _, index := range t.IndexList {
+		if len(index.ColumnList) < len(columnList) || len(index.ColumnList) != len(index.PartList) {
+			continue
+		}
+		match := true
+		for i, column := range columnList {
+			if !strings.EqualFold(index.ColumnList[i], column) {
+				match = false
+				break
+			}
+		}
+		if match {
+			return true
+		}
+	}
+	return false
+}
+
+// removeForeignKey 删除外键
+func (t *CatalogTable) removeForeignKey(name string) error {
+	for i, foreignKey := range t.ForeignKeyList {
+		if strings.EqualFold(foreignKey.Name, name) {
+			t.ForeignKeyList = append(t.ForeignKeyList[:i], t.ForeignKeyList[i+1:]...)
+			return nil
+		}
+	}
+	return newCatalogError(ErrCantDropFieldOrKey, "Can't DROP '%s'; check that column/key exists", name)
+}
+
+// renameForeignKeyColumn 修改外键中引用的列名，newName为空时删除包含该列的外键
+func (t *CatalogTable) renameForeignKeyColumn(oldName string, newName string) {
+	foreignKeyList := make([]*CatalogForeignKey, 0, len(t.ForeignKeyList))
+	for _, foreignKey := range t.ForeignKeyList {
+		keep := true
+		for i, column := range foreignKey.ColumnList {
+			if strings.EqualFold(column, oldName) && newName == "" {
+				keep = false
+			} else if strings.EqualFold(column, oldName) {
+				foreignKey.ColumnList[i] = newName
+			}
+		}
+		if keep {
+			foreignKeyList = append(foreignKeyList, foreignKey)
+		}
+	}
+	t.ForeignKeyList = foreignKeyList
+}
+
+// setOption 设置表选项，已存在时覆盖原值
+func (t *CatalogTable) setOption(name string, value string) {
+	name = strings.ToUpper(name)
+	for _, option := range t.OptionList {
+		if option.Name == name {
+			option.Value = value
+			return
+		}
+	}
+	t.OptionList = append(t.OptionList, &CatalogTableOption{Name: name, Value: value})
+}
+
+func (c *Catalog) applyAlterTable(s *AlterTableStatement) error {
+	oldTable, err := c.findTable(s.Database, s.Table)
+	if err != nil {
+		return err
+	}
+	t := oldTable.clone()
+	newDatabase := t.Database
+	newName := t.Name
+	for _, spec := range s.SpecificationList {
+		if err := t.applyAlterSpecification(spec); err != nil {
+			return err
+		}
+		if spec.Action == AlterTableActionRenameTable {
+			newDatabase, newName = spec.NewDatabase, spec.NewTable
+		}
+	}
+	if s.Partition != nil {
+		t.Partition = strings.TrimSpace(s.Partition.Value())
+	}
+	if len(t.ColumnList) == 0 {
+		return newCatalogError(ErrCantRemoveAllFields,
+			"You can't delete all columns with ALTER TABLE; use DROP TABLE instead")
+	}
+	if newDatabase != t.Database || newName != t.Name {
+		d, err := c.resolveDatabase(newDatabase)
+		if err != nil {
+			return err
+		}
+		if d.GetTable(newName) != nil {
+			return newCatalogError(ErrTableExists, "Table '%s' already exists", newName)
+		}
+		c.removeTable(oldTable)
+		t.Database = d.Name
+		t.Name = newName
+		d.TableList = append(d.TableList, t)
+		return nil
+	}
+	c.replaceTable(oldTable, t)
+	return nil
+}
+
+// applyAlterSpecification 在表上应用一个修改项
+func (t *CatalogTable) applyAlterSpecification(spec *MySQLAlterTableSpecificationComponent) error {
+	switch spec.Action {
+	case AlterTableActionAddColumn:
+		for i, name := range spec.ColumnList {
+			if err := t.addColumn(name, spec.DefinitionList[i], spec.First, spec.After); err != nil {
+				return err
+			}
+		}
+	case AlterTableActionAddIndex:
+		if spec.IndexKind == IndexKindForeign {
+			return t.addForeignKey(spec.ConstraintName, spec.IndexName, spec.KeyPartList, spec.Reference)
+		}
+		return t.addIndex(spec.IndexKind, spec.IndexName, spec.ConstraintName, spec.KeyPartList, spec.Invisible)
+	case AlterTableActionDropForeignKey:
+		return t.removeForeignKey(spec.ConstraintName)
+	case AlterTableActionTableOption:
+		for _, option := range spec.TableOptionList.OptionList {
+			t.setOption(option.Name, option.OptionValue)
+		}
+	case AlterTableActionCharset, AlterTableActionConvertCharset:
+		if spec.Charset != "" {
+			t.setOption("CHARSET", spec.Charset)
+		}
+		if spec.Collation != "" {
+			t.setOption("COLLATE", spec.Collation)
+		}
+	case AlterTableActionPartition:
+		if spec.RemovePartitioning {
+			t.Partition = ""
+		}
+	case AlterTableActionDropColumn:
+		if t.removeColumn(spec.ColumnName) == -1 {
+			return newCatalogError(ErrCantDropFieldOrKey, "Can't DROP '%s'; check that column/key exists",
+				spec.ColumnName)
+		}
+		t.renameIndexColumn(spec.ColumnName, "")
+		t.renameForeignKeyColumn(spec.ColumnName, "")
+	case AlterTableActionDropIndex, AlterTableActionDropPrimaryKey:
+		return t.removeIndex(spec.IndexName)
+	case AlterTableActionChangeColumn, AlterTableActionModifyColumn:
+		newName := spec.ColumnName
+		if spec.Action == AlterTableActionChangeColumn {
+			newName = spec.NewColumnName
+		}
+		oldColumn := t.GetColumn(spec.ColumnName)
+		if oldColumn == nil {
+			return newCatalogError(ErrBadField, "Unknown column '%s' in '%s'", spec.ColumnName, t.Name)
+		}
+		if !strings.EqualFold(newName, spec.ColumnName) && t.GetColumn(newName) != nil {
+			return newCatalogError(ErrDupFieldName, "Duplicate column name '%s'", newName)
+		}
+		column := newCatalogColumn(newName, spec.ColumnDefinition)
+		if pk := t.PrimaryKey(); pk != nil && InArray(oldColumn.Name, pk.ColumnList) {
+			column.Nullable = false
+		}
+		position := t.removeColumn(spec.ColumnName)
+		if !spec.First && spec.After == "" {
+			t.ColumnList = append(t.ColumnList[:position], append([]*CatalogColumn{column}, t.ColumnList[position:]...)...)
+		} else if err := t.insertColumn(column, spec.First, spec.After); err != nil {
+			return err
+		}
+		t.renameIndexColumn(oldColumn.Name, newName)
+		t.renameForeignKeyColumn(oldColumn.Name, newName)
+		if spec.ColumnDefinition.PrimaryKey {
+			keyPart := &MySQLIndexColumnNameComponent{Column: newName}
+			return t.addIndex(IndexKindPrimary, "", "", []*MySQLIndexColumnNameComponent{keyPart}, false)
+		}
+	case AlterTableActionAlterColumn:
+		column := t.GetColumn(spec.ColumnName)
+		if column == nil {
+			return newCatalogError(ErrBadField, "Unknown column '%s' in '%s'", spec.ColumnName, t.Name)
+		}
+		if spec.DropDefault {
+			column.HasDefault = false
+			column.Default = ""
+		} else if spec.Default != "" {
+			column.HasDefault = true
+			column.Default = spec.Default
+		} else {
+			column.Invisible = spec.Invisible
+		}
+	case AlterTableActionRenameColumn:
+		column := t.GetColumn(spec.ColumnName)
+		if column == nil {
+			return newCatalogError(ErrBadField, "Unknown column '%s' in '%s'", spec.ColumnName, t.Name)
+		}
+		if !strings.EqualFold(spec.NewColumnName, spec.ColumnName) && t.GetColumn(spec.NewColumnName) != nil {
+			return newCatalogError(ErrDupFieldName, "Duplicate column name '%s'", spec.NewColumnName)
+		}
+		t.renameIndexColumn(column.Name, spec.NewColumnName)
+		t.renameForeignKeyColumn(column.Name, spec.NewColumnName)
+		column.Name = spec.NewColumnName
+	case AlterTableActionRenameIndex:
+		index := t.GetIndex(spec.IndexName)
+		if index == nil {
+			return newCatalogError(ErrKeyDoesNotExist, "Key '%s' doesn't exist in table '%s'", spec.IndexName, t.Name)
+		}
+		if !strings.EqualFold(spec.NewIndexName, spec.IndexName) && t.GetIndex(spec.NewIndexName) != nil {
+			return newCatalogError(ErrDupKeyName, "Duplicate key name '%s'", spec.NewIndexName)
+		}
+		index.Name = spec.NewIndexName
+	case AlterTableActionAlterIndex:
+		index := t.GetIndex(spec.IndexName)
+		if index == nil {
+			return newCatalogError(ErrKeyDoesNotExist, "Key '%s' doesn't exist in table '%s'", spec.IndexName, t.Name)
+		}
+		index.Invisible = spec.Invisible
+	}
+	return nil
+}
+
+func (c *Catalog) applyCreateIndex(s *CreateIndexStatement) error {
+	oldTable, err := c.findTable(s.Database, s.Table)
+	if err != nil {
+		return err
+	}
+	t := oldTable.clone()
+	kind := s.IndexKind
+	if kind == IndexKindNone {
+		kind = IndexKindIndex
+	}
+	if err := t.addIndex(kind, s.IndexName, "", s.KeyPartList, s.Invisible); err != nil {
+		return err
+	}
+	c.replaceTable(oldTable, t)
+	return nil
+}
+
+func (c *Catalog) applyDropIndex(s *DropIndexStatement) error {
+	oldTable, err := c.findTable(s.Database, s.Table)
+	if err != nil {
+		return err
+	}
+	t := oldTable.clone()
+	if err := t.removeIndex(s.IndexName); err != nil {
+		return err
+	}
+	c.replaceTable(oldTable, t)
+	return nil
+}
+
+func (c *Catalog) applyRenameTable(s *RenameTableStatement) error {
+	type renamePair struct {
+		table    *CatalogTable
+		database *CatalogDatabase
+		name     string
+	}
+	// RENAME TABLE是原子操作，先在表名映射上模拟全部重命名，校验通过后再修改
+	nameMap := make(map[string]*CatalogTable)
+	for _, d := range c.DatabaseList {
+		for _, t := range d.TableList {
+			nameMap[t.fullName()] = t
+		}
+	}
+	pairList := make([]renamePair, 0, len(s.TableList))
+	for i := range s.TableList {
+		fromDatabase, err := c.resolveDatabase(s.FromDatabaseList[i])
+		if err != nil {
+			return err
+		}
+		fromName := fromDatabase.Name + "." + s.FromTableList[i]
+		t, ok := nameMap[fromName]
+		if !ok {
+			return newCatalogError(ErrNoSuchTable, "Table '%s' doesn't exist", fromName)
+		}
+		toDatabase, err := c.resolveDatabase(s.DatabaseList[i])
+		if err != nil {
+			return err
+		}
+		toName := toDatabase.Name + "." + s.TableList[i]
+		if _, ok := nameMap[toName]; ok {
+			return newCatalogError(ErrTableExists, "Table '%s' already exists", s.TableList[i])
+		}
+		delete(nameMap, fromName)
+		nameMap[toName] = t
+		pairList = append(pairList, renamePair{table: t, database: toDatabase, name: s.TableList[i]})
+	}
+	for _, pair := range pairList {
+		c.removeTable(pair.table)
+		pair.table.Database = pair.database.Name
+		pair.table.Name = pair.name
+		pair.database.TableList = append(pair.database.TableList, pair.table)
+	}
+	return nil
+}
+
+func (c *Catalog) applyDropTable(s *DropTableStatement) error {
+	tableList := make([]*CatalogTable, 0, len(s.TableList))
+	unknownList := make([]string, 0)
+	for i := range s.TableList {
+		d, err := c.resolveDatabase(s.DatabaseList[i])
+		if err != nil {
+			if catalogErr, ok := err.(*CatalogError); ok && catalogErr.Code == ErrBadDb {
+				unknownList = append(unknownList, s.DatabaseList[i]+"."+s.TableList[i])
+				continue
+			}
+			return err
+		}
+		t := d.GetTable(s.TableList[i])
+		if t == nil {
+			unknownList = append(unknownList, d.Name+"."+s.TableList[i])
+			continue
+		}
+		tableList = append(tableList, t)
+	}
+	if len(unknownList) > 0 && !s.IfExists {
+		return newCatalogError(ErrBadTable, "Unknown table '%s'", strings.Join(unknownList, ","))
+	}
+	for _, t := range tableList {
+		c.removeTable(t)
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+package mysqlparser_go
+
+import (
+	"testing"
+)
+
+func Test_Catalog(t *testing.T) {
+	c := NewCatalog()
+	err := c.ApplySQL("CREATE DATABASE db1; USE db1; " +
+		"CREATE TABLE t1 (id INT NOT NULL AUTO_INCREMENT, name VARCHAR(32) DEFAULT '' COMMENT 'user name', " +
+		"email VARCHAR(64) UNIQUE, PRIMARY KEY (id), KEY (name)); " +
+		"ALTER TABLE t1 ADD COLUMN age INT AFTER name, ADD INDEX idx_age (age), DROP INDEX name, " +
+		"CHANGE email mail VARCHAR(128) NOT NULL; " +
+		"CREATE TABLE t2 LIKE t1; CREATE INDEX idx_mail ON t2 (mail(10)); " +
+		"CREATE TABLE t3 AS SELECT id, name AS user_name, 1 + 1 AS two FROM t1; " +
+		"RENAME TABLE t2 TO t4; DROP TABLE t3")
+	if err != nil {
+		t.Fatalf("Error: %+v", err)
+	}
+	t1 := c.GetTable("", "t1")
+	if t1 == nil || len(t1.ColumnList) != 4 || t1.ColumnList[2].Name != "age" || t1.ColumnList[3].Name != "mail" {
+		t.Fatalf("Got unexpected table t1: %+v", t1)
+	}
+	if t1.ColumnList[1].Default != "''" || !t1.ColumnList[1].HasDefault || t1.ColumnList[1].Comment != "user name" {
+		t.Errorf("Got unexpected column name: %+v", t1.ColumnList[1])
+	}
+	if t1.ColumnList[3].DataType != "VARCHAR(128)" || t1.ColumnList[3].Nullable {
+		t.Errorf("Got unexpected column mail: %+v", t1.ColumnList[3])
+	}
+	if t1.PrimaryKey() == nil || t1.GetIndex("name") != nil || t1.GetIndex("idx_age") == nil {
+		t.Errorf("Got unexpected index list: %+v", t1.IndexList)
+	}
+	if index := t1.GetIndex("email"); index == nil || index.Kind != IndexKindUnique || index.ColumnList[0] != "mail" {
+		t.Errorf("Got unexpected unique index: %+v", index)
+	}
+	if c.GetTable("db1", "t2") != nil || c.GetTable("db1", "t3") != nil {
+		t.Errorf("Got unexpected table list: %+v", c.GetDatabase("db1").TableList)
+	}
+	t4 := c.GetTable("db1", "t4")
+	if t4 == nil || len(t4.ColumnList) != 4 || t4.GetIndex("idx_mail") == nil || t4.GetIndex("idx_mail").PartList[0] != "mail(10)" {
+		t.Errorf("Got unexpected table t4: %+v", t4)
+	}
+
+	err = c.ApplySQL("CREATE TABLE t5 AS SELECT id, name AS user_name, 1 + 1 AS two FROM t1")
+	if err != nil {
+		t.Fatalf("Error: %+v", err)
+	}
+	t5 := c.GetTable("db1", "t5")
+	if t5 == nil || len(t5.ColumnList) != 3 || t5.ColumnList[1].Name != "user_name" || t5.ColumnList[1].DataType != "VARCHAR(32)" ||
+		t5.ColumnList[2].Name != "two" || len(t5.IndexList) != 0 {
+		t.Errorf("Got unexpected table t5: %+v", t5)
+	}
+
+	errorList := map[string]int{
+		"CREATE DATABASE db1":                              ErrDbCreateExists,
+		"DROP DATABASE db2":                                ErrDbDropExists,
+		"USE db2":                                          ErrBadDb,
+		"CREATE TABLE t1 (id INT)":                         ErrTableExists,
+		"DROP TABLE t1, t9":                                ErrBadTable,
+		"ALTER TABLE t1 DROP COLUMN nothing":               ErrCantDropFieldOrKey,
+		"ALTER TABLE t1 ADD COLUMN age INT":                ErrDupFieldName,
+		"ALTER TABLE t1 ADD INDEX idx_age (name)":          ErrDupKeyName,
+		"ALTER TABLE t1 ADD PRIMARY KEY (name)":            ErrMultiplePriKey,
+		"CREATE INDEX idx_x ON t1 (nothing)":               ErrKeyColumnNotExists,
+		"ALTER TABLE t1 MODIFY nothing INT":                ErrBadField,
+		"ALTER TABLE t1 RENAME INDEX nothing TO idx":       ErrKeyDoesNotExist,
+		"TRUNCATE TABLE t9":                                ErrNoSuchTable,
+		"CREATE TABLE t6 AS SELECT nothing FROM t1":        ErrBadField,
+		"ALTER TABLE t5 DROP id, DROP user_name, DROP two": ErrCantRemoveAllFields,
+	}
+	for sql, code := range errorList {
+		err := c.ApplySQL(sql)
+		if catalogErr, ok := err.(*CatalogError); !ok || catalogErr.Code != code {
+			t.Errorf("%s: Respect: %d, Got: %v", sql, code, err)
+		}
+	}
+	if len(c.GetTable("db1", "t1").ColumnList) != 4 || c.GetTable("db1", "t1").GetColumn("age") == nil {
+		t.Errorf("Got table changed by failed statement: %+v", c.GetTable("db1", "t1"))
+	}
+
+	err = c.ApplySQL("DROP DATABASE db1; CREATE TABLE t1 (id INT)")
+	if catalogErr, ok := err.(*CatalogError); !ok || catalogErr.Code != ErrNoDb {
+		t.Errorf("Respect: %d, Got: %v", ErrNoDb, err)
+	}
+}
+
+func Test_Catalog_Keyword_Name(t *testing.T) {
+	c := NewCatalog()
+	err := c.ApplySQL("CREATE DATABASE d1; USE d1; " +
+		"CREATE TABLE `status` (id INT, name VARCHAR(32)) ENGINE=MyISAM ROW_FORMAT=Dynamic; " +
+		"ALTER TABLE status ADD COLUMN a INT; " +
+		"CREATE TABLE user (id INT, `name` VARCHAR(32), comment TEXT); " +
+		"ALTER TABLE `user` ADD COLUMN status INT; " +
+		"CREATE TABLE log AS SELECT name, comment FROM user")
+	if err != nil {
+		t.Fatalf("Error: %+v", err)
+	}
+	status := c.GetTable("d1", "status")
+	if status == nil || len(status.ColumnList) != 3 || status.ColumnList[1].Name != "name" || status.ColumnList[2].Name != "a" {
+		t.Fatalf("Got unexpected table status: %+v", status)
+	}
+	if status.GetOption("ENGINE") != "MyISAM" || status.GetOption("ROW_FORMAT") != "Dynamic" {
+		t.Errorf("Got unexpected option list: %+v", status.OptionList)
+	}
+	user := c.GetTable("d1", "user")
+	if user == nil || c.GetTable("d1", "USER") != nil || len(user.ColumnList) != 4 ||
+		user.ColumnList[2].Name != "comment" || user.ColumnList[3].Name != "status" {
+		t.Fatalf("Got unexpected table user: %+v", user)
+	}
+	log := c.GetTable("d1", "log")
+	if log == nil || len(log.ColumnList) != 2 || log.ColumnList[0].Name != "name" || log.ColumnList[1].Name != "comment" {
+		t.Errorf("Got unexpected table log: %+v", log)
+	}
+
+	err = c.ApplySQL("ALTER TABLE user ADD COLUMN name INT")
+	if catalogErr, ok := err.(*CatalogError); !ok || catalogErr.Code != ErrDupFieldName ||
+		catalogErr.Message != "Duplicate column name 'name'" {
+		t.Errorf("Respect: %d, Got: %v", ErrDupFieldName, err)
+	}
+}
@@ -0,0 +1,236 @@
+package mysqlparser_go
+
+import (
+	"strings"
+)
+
+// StatementCategory 语句类别，与MySQL手册SQL Statements一章的分节对应
+type StatementCategory int32
+
+const (
+	StatementCategoryUnknown     StatementCategory = 0
+	StatementCategoryDDL         StatementCategory = 1 // 数据定义
+	StatementCategoryDML         StatementCategory = 2 // 数据操作，含查询
+	StatementCategoryTCL         StatementCategory = 3 // 事务及锁
+	StatementCategoryDCL         StatementCategory = 4 // 账户管理
+	StatementCategoryReplication StatementCategory = 5 // 复制
+	StatementCategoryPrepared    StatementCategory = 6 // 预处理语句
+	StatementCategoryAdmin       StatementCategory = 7 // 数据库管理，含SET/SHOW
+	StatementCategoryUtility     StatementCategory = 8 // EXPLAIN/USE
+)
+
+// StatementClass 语句的分类及读写特征
+type StatementClass struct {
+	Category       StatementCategory
+	ReadOnly       bool // 不修改数据、库表结构、服务端状态及文件
+	ModifiesData   bool
+	ModifiesSchema bool
+	ImplicitCommit bool // 执行前隐式提交当前事务
+	ReplicaSafe    bool // 可以在从库执行：只读、不加锁，且不依赖也不改变会话状态
+	// 修改数据(或DO语句)且结果不确定，基于语句的复制在从库上可能得到不同的结果
+	Nondeterministic           bool
+	NondeterministicReasonList []string
+}
+
+var (
+	// nondeterministicFunctionList 结果随执行时间、会话或服务器变化的函数
+	nondeterministicFunctionList = []string{
+		"CONNECTION_ID", "CURDATE", "CURRENT_DATE", "CURRENT_TIME", "CURRENT_TIMESTAMP", "CURRENT_USER",
+		"CURTIME", "FOUND_ROWS", "GET_LOCK", "IS_FREE_LOCK", "IS_USED_LOCK", "LOAD_FILE", "LOCALTIME",
+		"LOCALTIMESTAMP", "MASTER_POS_WAIT", "NOW", "RAND", "RELEASE_ALL_LOCKS", "RELEASE_LOCK", "ROW_COUNT",
+		"SESSION_USER", "SLEEP", "SYSDATE", "SYSTEM_USER", "UNIX_TIMESTAMP", "USER", "UTC_DATE", "UTC_TIME",
+		"UTC_TIMESTAMP", "UUID", "UUID_SHORT", "VERSION",
+	}
+	// niladicFunctionList 可以不带括号调用的函数
+	niladicFunctionList = []string{
+		"CURRENT_DATE", "CURRENT_TIME", "CURRENT_TIMESTAMP", "CURRENT_USER", "LOCALTIME", "LOCALTIMESTAMP",
+		"UTC_DATE", "UTC_TIME", "UTC_TIMESTAMP",
+	}
+	// sessionFunctionList 依赖或改变会话状态、只能在主库同一连接上执行的函数
+	sessionFunctionList = []string{
+		"FOUND_ROWS", "GET_LOCK", "IS_FREE_LOCK", "IS_USED_LOCK", "LAST_INSERT_ID", "RELEASE_ALL_LOCKS",
+		"RELEASE_LOCK", "ROW_COUNT",
+	}
+	// sessionShowKindList 结果只与当前会话或当前服务器有关的SHOW
+	sessionShowKindList = []ShowKind{
+		ShowKindBinaryLogs, ShowKindBinlogEvents, ShowKindErrors, ShowKindMasterStatus, ShowKindProcesslist,
+		ShowKindProfile, ShowKindProfiles, ShowKindRelaylogEvents, ShowKindSlaveHosts, ShowKindSlaveStatus,
+		ShowKindWarnings,
+	}
+)
+
+// ClassifyStatement 获取语句的类别及读写特征，用于读写分离等按语句路由的场景
+// 隐式提交按MySQL 8.0的规则判断，CREATE/DROP TEMPORARY TABLE不隐式提交；
+// 存储过程及EXECUTE的实际语句未知，按修改数据处理
+func ClassifyStatement(s MySQLStatement) *StatementClass {
+	c := &StatementClass{
+		NondeterministicReasonList: make([]string, 0),
+	}
+	switch s.Type() {
+	case "SelectStatement", "UnionStatement", "TableStatement", "ValuesStatement":
+		c.Category = StatementCategoryDML
+		c.ReadOnly, c.ReplicaSafe = classifyQuery(s)
+	case "InsertStatement", "ReplaceStatement", "UpdateStatement", "DeleteStatement":
+		c.Category, c.ModifiesData = StatementCategoryDML, true
+		c.checkNondeterministic(s)
+	case "CallStatement":
+		c.Category, c.ModifiesData = StatementCategoryDML, true
+	case "DoStatement":
+		// DO只为表达式的副作用执行，含变量赋值、会话相关或结果不确定的函数时不是只读的
+		c.Category = StatementCategoryDML
+		_, replicaSafe := classifyQuery(s)
+		c.checkNondeterministic(s)
+		c.ReadOnly = replicaSafe && !c.Nondeterministic
+		c.ReplicaSafe = c.ReadOnly
+	case "HandlerStatement":
+		c.Category, c.ReadOnly = StatementCategoryDML, true
+	case "CreateTableStatement":
+		create := s.(*CreateTableStatement)
+		c.Category, c.ModifiesSchema, c.ImplicitCommit = StatementCategoryDDL, true, !create.Temporary
+		if create.Select != nil {
+			c.ModifiesData = true
+			c.checkNondeterministic(create.Select)
+		}
+	case "DropTableStatement":
+		c.Category, c.ModifiesSchema, c.ImplicitCommit = StatementCategoryDDL, true, !s.(*DropTableStatement).Temporary
+		c.ModifiesData = true
+	case "TruncateTableStatement", "DropDatabaseStatement":
+		c.Category, c.ModifiesSchema, c.ModifiesData, c.ImplicitCommit = StatementCategoryDDL, true, true, true
+	case "CreateDatabaseStatement", "AlterDatabaseStatement", "AlterTableStatement", "CreateIndexStatement",
+		"DropIndexStatement", "RenameTableStatement":
+		c.Category, c.ModifiesSchema, c.ImplicitCommit = StatementCategoryDDL, true, true
+	case "TransactionStatement":
+		c.Category, c.ReadOnly = StatementCategoryTCL, true
+		c.ImplicitCommit = s.(*TransactionStatement).Action == "START"
+	case "LockTablesStatement":
+		// UNLOCK TABLES只在已有LOCK TABLES锁定的表时隐式提交
+		c.Category, c.ReadOnly, c.ImplicitCommit = StatementCategoryTCL, true, true
+	case "XAStatement":
+		c.Category, c.ReadOnly = StatementCategoryTCL, true
+	case "SetStatement":
+		c.classifySet(s.(*SetStatement))
+	case "ShowStatement":
+		show := s.(*ShowStatement)
+		c.Category, c.ReadOnly, c.ReplicaSafe = StatementCategoryAdmin, true, true
+		for _, kind := range sessionShowKindList {
+			if show.Kind == kind {
+				c.ReplicaSafe = false
+			}
+		}
+		if (show.Kind == ShowKindStatus || show.Kind == ShowKindVariables) && show.Scope != VariableScopeGlobal {
+			c.ReplicaSafe = false
+		}
+	case "ChecksumTableStatement":
+		c.Category, c.ReadOnly, c.ReplicaSafe = StatementCategoryAdmin, true, true
+	case "CheckTableStatement":
+		c.Category, c.ReadOnly, c.ImplicitCommit = StatementCategoryAdmin, true, true
+	case "AnalyzeTableStatement", "OptimizeTableStatement", "RepairTableStatement", "FlushStatement":
+		c.Category, c.ImplicitCommit = StatementCategoryAdmin, true
+	case "ResetStatement":
+		c.Category, c.ImplicitCommit = StatementCategoryAdmin, !s.(*ResetStatement).Persist
+	case "KillStatement", "ShutdownStatement":
+		c.Category = StatementCategoryAdmin
+	case "ChangeMasterStatement", "ResetSlaveStatement", "StartSlaveStatement", "StopSlaveStatement":
+		c.Category, c.ImplicitCommit = StatementCategoryReplication, true
+	case "ChangeReplicationFilterStatement", "PurgeBinaryLogsStatement":
+		c.Category = StatementCategoryReplication
+	case "PrepareStatement", "DeallocatePrepareStatement":
+		c.Category, c.ReadOnly = StatementCategoryPrepared, true
+	case "ExecuteStatement":
+		c.Category, c.ModifiesData = StatementCategoryPrepared, true
+	case "ExplainStatement":
+		c.Category, c.ReadOnly, c.ReplicaSafe = StatementCategoryUtility, true, true
+	case "UseStatement":
+		c.Category, c.ReadOnly = StatementCategoryUtility, true
+	}
+	return c
+}
+
+// classifyQuery 判断查询是否只读及能否在从库执行
+// SELECT ... INTO OUTFILE/DUMPFILE写入文件，加锁读、INTO变量、变量赋值及会话相关的函数只能在主库执行
+func classifyQuery(s MySQLStatement) (bool, bool) {
+	readOnly, replicaSafe := true, true
+	walkStatement(s, func(t *MySQLObject) bool {
+		if (*t).Type() == "SelectStatement" && (*t).(*SelectStatement).LockMode.LockType != LockTypeNone {
+			replicaSafe = false
+		}
+		validList := getValidObjectList(getObjectList(t))
+		for i, child := range validList {
+			if (*t).Type() == "SelectStatement" && isKeywordToken(child, "INTO") {
+				replicaSafe = false
+				if i+1 < len(validList) && isKeywordToken(validList[i+1], "OUTFILE", "DUMPFILE") {
+					readOnly = false
+				}
+			} else if (*child).Type() == "MySQLOperatorToken" && (*child).Value() == ":=" {
+				replicaSafe = false
+			} else if name := getFunctionName(validList, i); InArray(name, sessionFunctionList) {
+				replicaSafe = false
+			}
+		}
+		return true
+	})
+	return readOnly, replicaSafe
+}
+
+// classifySet SET TRANSACTION属于事务语句，SET PASSWORD属于账户管理；
+// 修改全局变量的SET不是只读的，会话中将autocommit设为1时隐式提交
+func (c *StatementClass) classifySet(s *SetStatement) {
+	c.ReadOnly = true
+	switch s.Kind {
+	case SetKindTransaction:
+		c.Category = StatementCategoryTCL
+		c.ReadOnly = s.TransactionScope != VariableScopeGlobal
+	case SetKindPassword:
+		c.Category, c.ReadOnly = StatementCategoryDCL, false
+	default:
+		c.Category = StatementCategoryAdmin
+	}
+	for _, assignment := range s.AssignmentList {
+		if InArray(assignment.Scope, []VariableScope{VariableScopeGlobal, VariableScopePersist,
+			VariableScopePersistOnly}) {
+			c.ReadOnly = false
+		} else if strings.EqualFold(assignment.Name, "autocommit") && assignment.Scope != VariableScopeUser &&
+			InArray(strings.ToUpper(assignment.ValueText), []string{"1", "ON", "TRUE"}) {
+			c.ImplicitCommit = true
+		}
+	}
+}
+
+// checkNondeterministic 检查修改数据的语句中结果不确定的函数及没有ORDER BY的LIMIT
+func (c *StatementClass) checkNondeterministic(s MySQLStatement) {
+	walkStatement(s, func(t *MySQLObject) bool {
+		validList := getValidObjectList(getObjectList(t))
+		for i := range validList {
+			if name := getFunctionName(validList, i); InArray(name, nondeterministicFunctionList) {
+				c.addNondeterministicReason(name + "()")
+			}
+		}
+		return true
+	})
+	if len(getLimitWithoutOrderBy(s)) > 0 {
+		c.addNondeterministicReason("LIMIT without ORDER BY")
+	}
+}
+
+func (c *StatementClass) addNondeterministicReason(reason string) {
+	c.Nondeterministic = true
+	if !InArray(reason, c.NondeterministicReasonList) {
+		c.NondeterministicReasonList = append(c.NondeterministicReasonList, reason)
+	}
+}
+
+// getFunctionName 对象列表中第i个对象为函数调用时返回大写的函数名，否则返回空字符串
+func getFunctionName(validList []*MySQLObject, i int) string {
+	t := validList[i]
+	if (*t).Type() != "MySQLKeywordToken" && (*t).Type() != "MySQLUnquotedIdentifierToken" {
+		return ""
+	}
+	name := strings.ToUpper((*t).Value())
+	if InArray(name, niladicFunctionList) {
+		return name
+	}
+	if i+1 < len(validList) && (*validList[i+1]).Type() == "MySQLOperatorToken" && (*validList[i+1]).Value() == "(" {
+		return name
+	}
+	return ""
+}
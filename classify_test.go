@@ -0,0 +1,75 @@
+package mysqlparser_go
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func formatStatementClass(c *StatementClass) string {
+	flagList := make([]string, 0)
+	for _, flag := range []struct {
+		Name  string
+		Value bool
+	}{
+		{"ro", c.ReadOnly}, {"data", c.ModifiesData}, {"schema", c.ModifiesSchema},
+		{"commit", c.ImplicitCommit}, {"replica", c.ReplicaSafe}, {"nondet", c.Nondeterministic},
+	} {
+		if flag.Value {
+			flagList = append(flagList, flag.Name)
+		}
+	}
+	return fmt.Sprintf("%d:%s:%s", c.Category, strings.Join(flagList, ","), strings.Join(c.NondeterministicReasonList, ","))
+}
+
+func Test_ClassifyStatement(t *testing.T) {
+	sqlList := map[string]string{
+		"SELECT a FROM t WHERE id = 1":                          "2:ro,replica:",
+		"SELECT a FROM t WHERE id = 1 FOR UPDATE":               "2:ro:",
+		"SELECT a INTO @x FROM t":                               "2:ro:",
+		"SELECT a FROM t INTO OUTFILE '/tmp/a.txt'":             "2::",
+		"SELECT LAST_INSERT_ID()":                               "2:ro:",
+		"SELECT a FROM t UNION SELECT b FROM u":                 "2:ro,replica:",
+		"INSERT INTO t (a, b) VALUES (1, NOW())":                "2:data,nondet:NOW()",
+		"INSERT INTO t SELECT a, UUID() FROM u":                 "2:data,nondet:UUID()",
+		"UPDATE t SET a = RAND() WHERE b = CURRENT_DATE":        "2:data,nondet:RAND(),CURRENT_DATE()",
+		"UPDATE t SET a = 1 LIMIT 10":                           "2:data,nondet:LIMIT without ORDER BY",
+		"DELETE FROM t ORDER BY id LIMIT 10":                    "2:data:",
+		"DO 1 + 1":                                              "2:ro,replica:",
+		"DO GET_LOCK('l', 10)":                                  "2:nondet:GET_LOCK()",
+		"DO SLEEP(1)":                                           "2:nondet:SLEEP()",
+		"DO @a := 1":                                            "2::",
+		"DO RELEASE_LOCK('l'), (SELECT a FROM t)":               "2:nondet:RELEASE_LOCK()",
+		"CREATE TABLE t (id INT)":                               "1:schema,commit:",
+		"CREATE TEMPORARY TABLE t (id INT)":                     "1:schema:",
+		"CREATE TABLE t AS SELECT id FROM u LIMIT 1":            "1:data,schema,commit,nondet:LIMIT without ORDER BY",
+		"TRUNCATE TABLE t":                                      "1:data,schema,commit:",
+		"ALTER TABLE t ADD COLUMN c INT":                        "1:schema,commit:",
+		"START TRANSACTION":                                     "3:ro,commit:",
+		"COMMIT":                                                "3:ro:",
+		"LOCK TABLES t READ":                                    "3:ro,commit:",
+		"SET TRANSACTION ISOLATION LEVEL READ COMMITTED":        "3:ro:",
+		"SET GLOBAL TRANSACTION ISOLATION LEVEL READ COMMITTED": "3::",
+		"SET PASSWORD = 'secret'":                               "4::",
+		"SET autocommit = 1":                                    "7:ro,commit:",
+		"SET GLOBAL max_connections = 100":                      "7::",
+		"SHOW TABLES":                                           "7:ro,replica:",
+		"SHOW WARNINGS":                                         "7:ro:",
+		"SHOW SESSION STATUS":                                   "7:ro:",
+		"ANALYZE TABLE t":                                       "7:commit:",
+		"START SLAVE":                                           "5:commit:",
+		"PREPARE stmt FROM 'SELECT 1'":                          "6:ro:",
+		"EXPLAIN SELECT a FROM t":                               "8:ro,replica:",
+		"USE db":                                                "8:ro:",
+	}
+	for sql, expected := range sqlList {
+		statementList, err := Parse(sql)
+		if err != nil || len(statementList) != 1 {
+			t.Errorf("%s: Error: %+v", sql, err)
+			continue
+		}
+		if result := formatStatementClass(ClassifyStatement(statementList[0])); result != expected {
+			t.Errorf("%s: Respect: %s, Got: %s", sql, expected, result)
+		}
+	}
+}
@@ -25,6 +25,141 @@ func verbose(content string, level LogLevel) {
 	}
 }
 
+type LockType int32
+
+const (
+	LockTypeNone   LockType = 0
+	LockTypeShare  LockType = 1
+	LockTypeUpdate LockType = 2
+)
+
+type LockWaitPolicy int32
+
+const (
+	LockWaitDefault    LockWaitPolicy = 0
+	LockWaitNowait     LockWaitPolicy = 1
+	LockWaitSkipLocked LockWaitPolicy = 2
+)
+
+type LockMode struct {
+	LockType   LockType
+	WaitPolicy LockWaitPolicy
+	TableList  []*MySQLTableNameComponent
+}
+
+type VariableScope int32
+
+const (
+	VariableScopeDefault     VariableScope = 0
+	VariableScopeUser        VariableScope = 1
+	VariableScopeGlobal      VariableScope = 2
+	VariableScopeSession     VariableScope = 3
+	VariableScopePersist     VariableScope = 4
+	VariableScopePersistOnly VariableScope = 5
+)
+
+type SetKind int32
+
+const (
+	SetKindVariable     SetKind = 0
+	SetKindNames        SetKind = 1
+	SetKindCharacterSet SetKind = 2
+	SetKindTransaction  SetKind = 3
+	SetKindPassword     SetKind = 4
+)
+
+type ShowKind int32
+
+const (
+	ShowKindUnknown         ShowKind = 0
+	ShowKindAuthors         ShowKind = 1
+	ShowKindBinaryLogs      ShowKind = 2
+	ShowKindBinlogEvents    ShowKind = 3
+	ShowKindCharacterSet    ShowKind = 4
+	ShowKindCollation       ShowKind = 5
+	ShowKindColumns         ShowKind = 6
+	ShowKindContributors    ShowKind = 7
+	ShowKindCreateDatabase  ShowKind = 8
+	ShowKindCreateEvent     ShowKind = 9
+	ShowKindCreateFunction  ShowKind = 10
+	ShowKindCreateProcedure ShowKind = 11
+	ShowKindCreateTable     ShowKind = 12
+	ShowKindCreateTrigger   ShowKind = 13
+	ShowKindCreateView      ShowKind = 14
+	ShowKindDatabases       ShowKind = 15
+	ShowKindEngineStatus    ShowKind = 16
+	ShowKindEngineMutex     ShowKind = 17
+	ShowKindEngines         ShowKind = 18
+	ShowKindErrors          ShowKind = 19
+	ShowKindEvents          ShowKind = 20
+	ShowKindFunctionCode    ShowKind = 21
+	ShowKindFunctionStatus  ShowKind = 22
+	ShowKindGrants          ShowKind = 23
+	ShowKindIndex           ShowKind = 24
+	ShowKindMasterStatus    ShowKind = 25
+	ShowKindOpenTables      ShowKind = 26
+	ShowKindPlugins         ShowKind = 27
+	ShowKindProcedureCode   ShowKind = 28
+	ShowKindProcedureStatus ShowKind = 29
+	ShowKindPrivileges      ShowKind = 30
+	ShowKindProcesslist     ShowKind = 31
+	ShowKindProfile         ShowKind = 32
+	ShowKindProfiles        ShowKind = 33
+	ShowKindRelaylogEvents  ShowKind = 34
+	ShowKindSlaveHosts      ShowKind = 35
+	ShowKindSlaveStatus     ShowKind = 36
+	ShowKindStatus          ShowKind = 37
+	ShowKindTableStatus     ShowKind = 38
+	ShowKindTables          ShowKind = 39
+	ShowKindTriggers        ShowKind = 40
+	ShowKindVariables       ShowKind = 41
+	ShowKindWarnings        ShowKind = 42
+)
+
+type IndexKind int32
+
+const (
+	IndexKindNone     IndexKind = 0
+	IndexKindPrimary  IndexKind = 1
+	IndexKindUnique   IndexKind = 2
+	IndexKindIndex    IndexKind = 3
+	IndexKindFulltext IndexKind = 4
+	IndexKindSpatial  IndexKind = 5
+	IndexKindForeign  IndexKind = 6
+)
+
+type AlterTableAction int32
+
+const (
+	AlterTableActionUnknown           AlterTableAction = 0
+	AlterTableActionTableOption       AlterTableAction = 1
+	AlterTableActionAddColumn         AlterTableAction = 2
+	AlterTableActionAddIndex          AlterTableAction = 3
+	AlterTableActionAddCheck          AlterTableAction = 4
+	AlterTableActionAlterCheck        AlterTableAction = 5
+	AlterTableActionAlterColumn       AlterTableAction = 6
+	AlterTableActionAlterIndex        AlterTableAction = 7
+	AlterTableActionChangeColumn      AlterTableAction = 8
+	AlterTableActionModifyColumn      AlterTableAction = 9
+	AlterTableActionCharset           AlterTableAction = 10
+	AlterTableActionConvertCharset    AlterTableAction = 11
+	AlterTableActionDisableKeys       AlterTableAction = 12
+	AlterTableActionEnableKeys        AlterTableAction = 13
+	AlterTableActionDiscardTablespace AlterTableAction = 14
+	AlterTableActionImportTablespace  AlterTableAction = 15
+	AlterTableActionDropColumn        AlterTableAction = 16
+	AlterTableActionDropIndex         AlterTableAction = 17
+	AlterTableActionDropPrimaryKey    AlterTableAction = 18
+	AlterTableActionDropForeignKey    AlterTableAction = 19
+	AlterTableActionDropCheck         AlterTableAction = 20
+	AlterTableActionForce             AlterTableAction = 21
+	AlterTableActionOrderBy           AlterTableAction = 22
+	AlterTableActionRenameColumn      AlterTableAction = 23
+	AlterTableActionRenameIndex       AlterTableAction = 24
+	AlterTableActionRenameTable       AlterTableAction = 25
+	AlterTableActionPartition         AlterTableAction = 26
+)
+
 type ObjectType int
 
 const (
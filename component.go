@@ -47,6 +47,19 @@ func GetComponentGen(t string) func(tokenList MySQLTokenList,
 		"MySQLOrderListOptionComponent":           NewMySQLOrderListOptionComponent,
 		"MySQLIndexHintOptionComponent":           NewMySQLIndexHintOptionComponent,
 		"MySQLExportOptionComponent":              NewMySQLExportOptionComponent,
+		"MySQLReplicationOptionComponent":         NewMySQLReplicationOptionComponent,
+		"MySQLReplicationFilterComponent":         NewMySQLReplicationFilterComponent,
+		"MySQLXidComponent":                       NewMySQLXidComponent,
+		"MySQLVariableAssignmentComponent":        NewMySQLVariableAssignmentComponent,
+		"MySQLWindowSpecComponent":                NewMySQLWindowSpecComponent,
+		"MySQLWindowFrameComponent":               NewMySQLWindowFrameComponent,
+		"MySQLWindowFrameBoundComponent":          NewMySQLWindowFrameBoundComponent,
+		"MySQLWindowDefinitionComponent":          NewMySQLWindowDefinitionComponent,
+		"MySQLLockingClauseComponent":             NewMySQLLockingClauseComponent,
+		"MySQLJsonTableComponent":                 NewMySQLJsonTableComponent,
+		"MySQLJsonTableColumnComponent":           NewMySQLJsonTableColumnComponent,
+		"MySQLCheckConstraintComponent":           NewMySQLCheckConstraintComponent,
+		"MySQLValueListComponent":                 NewMySQLValueListComponent,
 		"MySQLDataTypeComponent":                  NewMySQLDataTypeComponent,
 		"MySQLReferenceDefinitionComponent":       NewMySQLReferenceDefinitionComponent,
 		"MySQLColumnDefinitionComponent":          NewMySQLColumnDefinitionComponent,
@@ -81,6 +94,11 @@ func (c *MySQLBaseComponent) GetFsmMap() []FsmMap {
 	return fsmMap
 }
 
+// GetObjectList 获取组成组件的对象列表
+func (c *MySQLBaseComponent) GetObjectList() []*MySQLObject {
+	return c.ObjectList
+}
+
 func (c *MySQLBaseComponent) ParseByFsm(fsmMap []FsmMap, tokenList MySQLTokenList, specialFinalStatus []int,
 	verboseFunc func(message string, level LogLevel)) int {
 	finalStatus := FinalStatus
@@ -249,6 +267,7 @@ func (c *MySQLBaseComponent) ParseByFsm(fsmMap []FsmMap, tokenList MySQLTokenLis
 //      [CHARACTER SET charset_name] [COLLATE collation_name]
 //  | SET(value1,value2,value3,...)
 //      [CHARACTER SET charset_name] [COLLATE collation_name]
+//  | JSON
 //  | spatial_type
 
 type MySQLDataTypeComponent struct {
@@ -309,6 +328,12 @@ func (c *MySQLDataTypeComponent) GetFsmMap() []FsmMap {
 			AcceptValue:  "LONGBLOB",
 			EndStatus:    FinalStatus,
 		},
+		{
+			StartStatus:  []int{0},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "JSON",
+			EndStatus:    FinalStatus,
+		},
 		{
 			StartStatus:  []int{0},
 			AcceptObject: "MySQLKeywordToken",
@@ -644,6 +669,11 @@ func NewMySQLDataTypeComponent(tokenList MySQLTokenList,
 
 type MySQLReferenceDefinitionComponent struct {
 	*MySQLBaseComponent
+	Database   string
+	Table      string
+	ColumnList []string
+	OnDelete   string
+	OnUpdate   string
 }
 
 func (c *MySQLReferenceDefinitionComponent) Type() string {
@@ -659,7 +689,7 @@ func (c *MySQLReferenceDefinitionComponent) GetFsmMap() []FsmMap {
 			EndStatus:    1,
 		},
 		{
-			StartStatus:  []int{0},
+			StartStatus:  []int{1},
 			AcceptObject: "MySQLTableNameComponent",
 			AcceptValue:  "",
 			EndStatus:    2,
@@ -752,12 +782,33 @@ func NewMySQLReferenceDefinitionComponent(tokenList MySQLTokenList,
 		MySQLBaseComponent: &MySQLBaseComponent{
 			ObjectList: make([]*MySQLObject, 0),
 		},
+		ColumnList: make([]string, 0),
 	}
 	endPos := c.ParseByFsm(c.GetFsmMap(), tokenList, []int{5, 7, 10}, verboseFunc)
 	if endPos == -1 {
 		tokenList.Reset(startPos)
 		return nil, tokenList
 	} else {
+		lastKeyword := ""
+		for _, t := range c.ObjectList {
+			if (*t).Type() == "MySQLTableNameComponent" {
+				c.Database = (*t).(*MySQLTableNameComponent).Database
+				c.Table = (*t).(*MySQLTableNameComponent).Table
+			} else if (*t).Type() == "MySQLIndexColumnNameListComponent" {
+				for _, name := range (*t).(*MySQLIndexColumnNameListComponent).NameList {
+					c.ColumnList = append(c.ColumnList, name.Column)
+				}
+			} else if (*t).Type() == "MySQLReferenceOptionComponent" {
+				option := strings.ToUpper(strings.Join(strings.Fields((*t).Value()), " "))
+				if lastKeyword == "DELETE" {
+					c.OnDelete = option
+				} else if lastKeyword == "UPDATE" {
+					c.OnUpdate = option
+				}
+			} else if (*t).Type() == "MySQLKeywordToken" {
+				lastKeyword = strings.ToUpper((*t).Value())
+			}
+		}
 		tokenList.Reset(endPos)
 		return c, tokenList
 	}
@@ -765,14 +816,36 @@ func NewMySQLReferenceDefinitionComponent(tokenList MySQLTokenList,
 
 // column_definition:
 //    data_type [NOT NULL | NULL] [DEFAULT default_value]
+//      [VISIBLE | INVISIBLE]
 //      [AUTO_INCREMENT | ON UPDATE CURRENT_TIMESTAMP] [UNIQUE [KEY]] [[PRIMARY] KEY]
 //      [COMMENT 'string']
 //      [COLUMN_FORMAT {FIXED|DYNAMIC|DEFAULT}]
 //      [STORAGE {DISK|MEMORY|DEFAULT}]
 //      [reference_definition]
+//      [check_constraint_definition]
+//  | data_type
+//      [GENERATED ALWAYS] AS (expr)
+//      [VIRTUAL | STORED] [NOT NULL | NULL]
+//      [VISIBLE | INVISIBLE]
+//      [UNIQUE [KEY]] [[PRIMARY] KEY]
+//      [COMMENT 'string']
+//      [reference_definition]
+//      [check_constraint_definition]
 
 type MySQLColumnDefinitionComponent struct {
 	*MySQLBaseComponent
+	DataType      string
+	NotNull       bool
+	HasDefault    bool
+	Default       string
+	OnUpdate      string
+	AutoIncrement bool
+	UniqueKey     bool
+	PrimaryKey    bool
+	Comment       string
+	Generated     *MySQLExpressionComponent
+	Stored        bool
+	Invisible     bool
 }
 
 func (c *MySQLColumnDefinitionComponent) Type() string {
@@ -788,19 +861,19 @@ func (c *MySQLColumnDefinitionComponent) GetFsmMap() []FsmMap {
 			EndStatus:    1,
 		},
 		{
-			StartStatus:  []int{1, 5, 6},
+			StartStatus:  []int{1, 5, 6, 24, 25, 26},
 			AcceptObject: "MySQLKeywordToken",
 			AcceptValue:  "NOT",
 			EndStatus:    2,
 		},
 		{
-			StartStatus:  []int{1, 2},
+			StartStatus:  []int{1, 2, 24, 25},
 			AcceptObject: "MySQLNullToken",
 			AcceptValue:  "NULL",
 			EndStatus:    3,
 		},
 		{
-			StartStatus:  []int{1, 3, 5, 6},
+			StartStatus:  []int{1, 3, 5, 6, 26},
 			AcceptObject: "MySQLKeywordToken",
 			AcceptValue:  "DEFAULT",
 			EndStatus:    4,
@@ -848,13 +921,13 @@ func (c *MySQLColumnDefinitionComponent) GetFsmMap() []FsmMap {
 			EndStatus:    6,
 		},
 		{
-			StartStatus:  []int{1, 3, 5},
+			StartStatus:  []int{1, 3, 5, 26},
 			AcceptObject: "MySQLKeywordToken",
 			AcceptValue:  "AUTO_INCREMENT",
 			EndStatus:    6,
 		},
 		{
-			StartStatus:  []int{1, 3, 5, 6},
+			StartStatus:  []int{1, 3, 5, 6, 24, 25, 26},
 			AcceptObject: "MySQLKeywordToken",
 			AcceptValue:  "UNIQUE",
 			EndStatus:    7,
@@ -866,25 +939,25 @@ func (c *MySQLColumnDefinitionComponent) GetFsmMap() []FsmMap {
 			EndStatus:    8,
 		},
 		{
-			StartStatus:  []int{1, 3, 5, 6, 7, 8},
+			StartStatus:  []int{1, 3, 5, 6, 7, 8, 24, 25, 26},
 			AcceptObject: "MySQLKeywordToken",
 			AcceptValue:  "PRIMARY",
 			EndStatus:    9,
 		},
 		{
-			StartStatus:  []int{1, 9},
+			StartStatus:  []int{1, 9, 24, 25},
 			AcceptObject: "MySQLKeywordToken",
 			AcceptValue:  "KEY",
 			EndStatus:    10,
 		},
 		{
-			StartStatus:  []int{3, 5, 6, 7, 8},
+			StartStatus:  []int{3, 5, 6, 7, 8, 26},
 			AcceptObject: "MySQLKeywordToken",
 			AcceptValue:  "KEY",
 			EndStatus:    9,
 		},
 		{
-			StartStatus:  []int{1, 3, 5, 6, 7, 8, 10},
+			StartStatus:  []int{1, 3, 5, 6, 7, 8, 10, 24, 25, 26},
 			AcceptObject: "MySQLKeywordToken",
 			AcceptValue:  "COMMENT",
 			EndStatus:    11,
@@ -896,7 +969,7 @@ func (c *MySQLColumnDefinitionComponent) GetFsmMap() []FsmMap {
 			EndStatus:    12,
 		},
 		{
-			StartStatus:  []int{1, 3, 5, 6, 7, 8, 10, 12},
+			StartStatus:  []int{1, 3, 5, 6, 7, 8, 10, 12, 24, 25, 26},
 			AcceptObject: "MySQLKeywordToken",
 			AcceptValue:  "COLUMN_FORMAT",
 			EndStatus:    13,
@@ -920,7 +993,7 @@ func (c *MySQLColumnDefinitionComponent) GetFsmMap() []FsmMap {
 			EndStatus:    14,
 		},
 		{
-			StartStatus:  []int{1, 3, 5, 6, 7, 8, 10, 12, 14},
+			StartStatus:  []int{1, 3, 5, 6, 7, 8, 10, 12, 14, 24, 25, 26},
 			AcceptObject: "MySQLKeywordToken",
 			AcceptValue:  "STORAGE",
 			EndStatus:    15,
@@ -944,10 +1017,76 @@ func (c *MySQLColumnDefinitionComponent) GetFsmMap() []FsmMap {
 			EndStatus:    16,
 		},
 		{
-			StartStatus:  []int{1, 3, 5, 6, 7, 8, 10, 12, 14, 16},
+			StartStatus:  []int{1, 3, 5, 6, 7, 8, 10, 12, 14, 16, 24, 25, 26},
 			AcceptObject: "MySQLReferenceDefinitionComponent",
 			AcceptValue:  "",
-			EndStatus:    FinalStatus,
+			EndStatus:    27,
+		},
+		{
+			StartStatus:  []int{1},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "GENERATED",
+			EndStatus:    19,
+		},
+		{
+			StartStatus:  []int{19},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "ALWAYS",
+			EndStatus:    20,
+		},
+		{
+			StartStatus:  []int{1, 20},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "AS",
+			EndStatus:    21,
+		},
+		{
+			StartStatus:  []int{21},
+			AcceptObject: "MySQLOperatorToken",
+			AcceptValue:  "(",
+			EndStatus:    22,
+		},
+		{
+			StartStatus:  []int{22},
+			AcceptObject: "MySQLExpressionComponent",
+			AcceptValue:  "",
+			EndStatus:    23,
+		},
+		{
+			StartStatus:  []int{23},
+			AcceptObject: "MySQLOperatorToken",
+			AcceptValue:  ")",
+			EndStatus:    24,
+		},
+		{
+			StartStatus:  []int{24},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "VIRTUAL",
+			EndStatus:    25,
+		},
+		{
+			StartStatus:  []int{24},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "STORED",
+			EndStatus:    25,
+		},
+		{
+			StartStatus:  []int{1, 3, 5, 24, 25},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "VISIBLE",
+			EndStatus:    26,
+		},
+		{
+			StartStatus:  []int{1, 3, 5, 24, 25},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "INVISIBLE",
+			EndStatus:    26,
+		},
+		{
+			StartStatus:  []int{1, 3, 5, 6, 7, 8, 10, 12, 14, 16, 24, 25, 26, 27},
+			AcceptObject: "MySQLCheckConstraintComponent",
+			AcceptValue:  "",
+			EndStatus:    27,
 		},
 	}
 }
@@ -960,11 +1099,55 @@ func NewMySQLColumnDefinitionComponent(tokenList MySQLTokenList,
 			ObjectList: make([]*MySQLObject, 0),
 		},
 	}
-	endPos := c.ParseByFsm(c.GetFsmMap(), tokenList, []int{1, 3, 5, 6, 7, 8, 10, 12, 14, 16}, verboseFunc)
+	endPos := c.ParseByFsm(c.GetFsmMap(), tokenList, []int{1, 3, 5, 6, 7, 8, 10, 12, 14, 16, 24, 25, 26, 27},
+		verboseFunc)
 	if endPos == -1 {
 		tokenList.Reset(startPos)
 		return nil, tokenList
 	} else {
+		lastKeyword := ""
+		for _, t := range c.ObjectList {
+			if (*t).Type() == "MySQLDataTypeComponent" {
+				c.DataType = strings.TrimSpace((*t).Value())
+			} else if (*t).Type() == "MySQLKeywordToken" {
+				keyword := strings.ToUpper((*t).Value())
+				if keyword == "DEFAULT" && lastKeyword != "COLUMN_FORMAT" && lastKeyword != "STORAGE" {
+					c.HasDefault = true
+				} else if keyword == "CURRENT_TIMESTAMP" && lastKeyword == "DEFAULT" {
+					c.Default = keyword
+				} else if keyword == "CURRENT_TIMESTAMP" && lastKeyword == "UPDATE" {
+					c.OnUpdate = keyword
+				} else if keyword == "AUTO_INCREMENT" {
+					c.AutoIncrement = true
+				} else if keyword == "UNIQUE" {
+					c.UniqueKey = true
+				} else if keyword == "PRIMARY" {
+					c.PrimaryKey = true
+				} else if keyword == "KEY" && lastKeyword != "UNIQUE" {
+					c.PrimaryKey = true
+				} else if keyword == "STORED" {
+					c.Stored = true
+				} else if keyword == "INVISIBLE" {
+					c.Invisible = true
+				}
+				lastKeyword = keyword
+			} else if (*t).Type() == "MySQLNullToken" {
+				if lastKeyword == "NOT" {
+					c.NotNull = true
+				} else if lastKeyword == "DEFAULT" {
+					c.Default = "NULL"
+				}
+				lastKeyword = "NULL"
+			} else if (*t).Type() == "MySQLStringToken" || (*t).Type() == "MySQLNumericToken" {
+				if lastKeyword == "DEFAULT" {
+					c.Default = (*t).Value()
+				} else if lastKeyword == "COMMENT" {
+					c.Comment = unquoteString((*t).Value())
+				}
+			} else if (*t).Type() == "MySQLExpressionComponent" {
+				c.Generated = (*t).(*MySQLExpressionComponent)
+			}
+		}
 		tokenList.Reset(endPos)
 		return c, tokenList
 	}
@@ -1443,6 +1626,101 @@ func NewMySQLPartitionDefinitionComponent(tokenList MySQLTokenList,
 	}
 }
 
+// check_constraint_definition:
+//    [CONSTRAINT [symbol]] CHECK (expr) [[NOT] ENFORCED]
+
+type MySQLCheckConstraintComponent struct {
+	*MySQLBaseComponent
+	Name       string
+	Expression *MySQLExpressionComponent
+	Enforced   bool
+}
+
+func (c *MySQLCheckConstraintComponent) Type() string {
+	return "MySQLCheckConstraintComponent"
+}
+
+func (c *MySQLCheckConstraintComponent) GetFsmMap() []FsmMap {
+	return []FsmMap{
+		{
+			StartStatus:  []int{0},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "CONSTRAINT",
+			EndStatus:    1,
+		},
+		{
+			StartStatus:  []int{1},
+			AcceptObject: "MySQLIdentifierComponent",
+			AcceptValue:  "",
+			EndStatus:    2,
+		},
+		{
+			StartStatus:  []int{0, 1, 2},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "CHECK",
+			EndStatus:    3,
+		},
+		{
+			StartStatus:  []int{3},
+			AcceptObject: "MySQLOperatorToken",
+			AcceptValue:  "(",
+			EndStatus:    4,
+		},
+		{
+			StartStatus:  []int{4},
+			AcceptObject: "MySQLExpressionComponent",
+			AcceptValue:  "",
+			EndStatus:    5,
+		},
+		{
+			StartStatus:  []int{5},
+			AcceptObject: "MySQLOperatorToken",
+			AcceptValue:  ")",
+			EndStatus:    6,
+		},
+		{
+			StartStatus:  []int{6},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "NOT",
+			EndStatus:    7,
+		},
+		{
+			StartStatus:  []int{6, 7},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "ENFORCED",
+			EndStatus:    FinalStatus,
+		},
+	}
+}
+
+func NewMySQLCheckConstraintComponent(tokenList MySQLTokenList,
+	verboseFunc func(message string, level LogLevel)) (MySQLComponent, MySQLTokenList) {
+	startPos := tokenList.CurrentPos()
+	c := &MySQLCheckConstraintComponent{
+		MySQLBaseComponent: &MySQLBaseComponent{
+			ObjectList: make([]*MySQLObject, 0),
+		},
+		Enforced: true,
+	}
+	endPos := c.ParseByFsm(c.GetFsmMap(), tokenList, []int{6}, verboseFunc)
+	if endPos == -1 {
+		tokenList.Reset(startPos)
+		return nil, tokenList
+	} else {
+		for _, t := range c.ObjectList {
+			if (*t).Type() == "MySQLIdentifierComponent" {
+				c.Name = strings.Trim((*t).Value(), "`")
+			} else if (*t).Type() == "MySQLExpressionComponent" {
+				c.Expression = (*t).(*MySQLExpressionComponent)
+			} else if (*t).Type() == "MySQLKeywordToken" && (*t).Value() == "NOT" {
+				c.Enforced = false
+			}
+		}
+		tokenList.Reset(endPos)
+		return c, tokenList
+	}
+}
+
 // create_definition:
 //    col_name column_definition
 //  | [CONSTRAINT [symbol]] PRIMARY KEY [index_type] (index_col_name,...)
@@ -1456,10 +1734,19 @@ func NewMySQLPartitionDefinitionComponent(tokenList MySQLTokenList,
 //      [index_option] ...
 //  | [CONSTRAINT [symbol]] FOREIGN KEY
 //      [index_name] (index_col_name,...) reference_definition
-//  | CHECK (expr)
+//  | check_constraint_definition
 
 type MySQLCreateTableDefinitionComponent struct {
 	*MySQLBaseComponent
+	ColumnName       string
+	ColumnDefinition *MySQLColumnDefinitionComponent
+	ConstraintName   string
+	IndexKind        IndexKind
+	IndexName        string
+	KeyPartList      []*MySQLIndexColumnNameComponent
+	Reference        *MySQLReferenceDefinitionComponent
+	Check            *MySQLCheckConstraintComponent
+	Invisible        bool
 }
 
 func (c *MySQLCreateTableDefinitionComponent) Type() string {
@@ -1480,6 +1767,12 @@ func (c *MySQLCreateTableDefinitionComponent) GetFsmMap() []FsmMap {
 			AcceptValue:  "",
 			EndStatus:    FinalStatus,
 		},
+		{
+			StartStatus:  []int{0},
+			AcceptObject: "MySQLCheckConstraintComponent",
+			AcceptValue:  "",
+			EndStatus:    FinalStatus,
+		},
 		{
 			StartStatus:  []int{0},
 			AcceptObject: "MySQLKeywordToken",
@@ -1624,30 +1917,6 @@ func (c *MySQLCreateTableDefinitionComponent) GetFsmMap() []FsmMap {
 			AcceptValue:  "",
 			EndStatus:    FinalStatus,
 		},
-		{
-			StartStatus:  []int{0},
-			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "CHECK",
-			EndStatus:    18,
-		},
-		{
-			StartStatus:  []int{18},
-			AcceptObject: "MySQLOperatorToken",
-			AcceptValue:  "(",
-			EndStatus:    19,
-		},
-		{
-			StartStatus:  []int{19},
-			AcceptObject: "MySQLPartitioningExpressionComponent",
-			AcceptValue:  "",
-			EndStatus:    20,
-		},
-		{
-			StartStatus:  []int{20},
-			AcceptObject: "MySQLOperatorToken",
-			AcceptValue:  ")",
-			EndStatus:    FinalStatus,
-		},
 	}
 }
 
@@ -1658,17 +1927,64 @@ func NewMySQLCreateTableDefinitionComponent(tokenList MySQLTokenList,
 		MySQLBaseComponent: &MySQLBaseComponent{
 			ObjectList: make([]*MySQLObject, 0),
 		},
+		KeyPartList: make([]*MySQLIndexColumnNameComponent, 0),
 	}
 	endPos := c.ParseByFsm(c.GetFsmMap(), tokenList, []int{9}, verboseFunc)
 	if endPos == -1 {
 		tokenList.Reset(startPos)
 		return nil, tokenList
 	} else {
+		lastKeyword := ""
+		for _, t := range c.ObjectList {
+			if (*t).Type() == "MySQLColumnNameComponent" {
+				c.ColumnName = (*t).(*MySQLColumnNameComponent).Column
+			} else if (*t).Type() == "MySQLColumnDefinitionComponent" {
+				c.ColumnDefinition = (*t).(*MySQLColumnDefinitionComponent)
+			} else if (*t).Type() == "MySQLCheckConstraintComponent" {
+				c.Check = (*t).(*MySQLCheckConstraintComponent)
+			} else if (*t).Type() == "MySQLReferenceDefinitionComponent" {
+				c.Reference = (*t).(*MySQLReferenceDefinitionComponent)
+			} else if (*t).Type() == "MySQLIndexColumnNameComponent" {
+				c.KeyPartList = append(c.KeyPartList, (*t).(*MySQLIndexColumnNameComponent))
+			} else if (*t).Type() == "MySQLIndexOptionComponent" {
+				c.Invisible = c.Invisible || (*t).(*MySQLIndexOptionComponent).Invisible
+			} else if (*t).Type() == "MySQLIdentifierComponent" {
+				if lastKeyword == "CONSTRAINT" {
+					c.ConstraintName = strings.Trim((*t).Value(), "`")
+				} else {
+					c.IndexName = strings.Trim((*t).Value(), "`")
+				}
+			} else if (*t).Type() == "MySQLKeywordToken" {
+				lastKeyword = strings.ToUpper((*t).Value())
+				c.IndexKind = getIndexKind(lastKeyword, c.IndexKind)
+			}
+		}
 		tokenList.Reset(endPos)
 		return c, tokenList
 	}
 }
 
+// getIndexKind 根据索引定义中的关键字确定索引类型
+func getIndexKind(keyword string, kind IndexKind) IndexKind {
+	switch keyword {
+	case "PRIMARY":
+		return IndexKindPrimary
+	case "UNIQUE":
+		return IndexKindUnique
+	case "FULLTEXT":
+		return IndexKindFulltext
+	case "SPATIAL":
+		return IndexKindSpatial
+	case "FOREIGN":
+		return IndexKindForeign
+	case "INDEX", "KEY":
+		if kind == IndexKindNone {
+			return IndexKindIndex
+		}
+	}
+	return kind
+}
+
 type MySQLExpressionComponent struct {
 	*MySQLBaseComponent
 }
@@ -1698,27 +2014,35 @@ var (
 		"CEILING", "CHAR", "CHARACTER_LENGTH", "CHARSET", "CHAR_LENGTH",
 		"COERCIBILITY", "COLLATION", "COMPRESS", "CONCAT", "CONCAT_WS",
 		"CONNECTION_ID", "CONV", "CONVERT", "CONVERT_TZ", "COS",
-		"COT", "COUNT", "CRC32", "CURDATE", "CURRENT_DATE",
+		"COT", "COUNT", "CRC32", "CUME_DIST", "CURDATE", "CURRENT_DATE",
 		"CURRENT_TIME", "CURRENT_TIMESTAMP", "CURRENT_USER", "CURTIME", "DATABASE",
 		"DATE", "DATEDIFF", "DATE_ADD", "DATE_FORMAT", "DATE_SUB",
 		"DAY", "DAYNAME", "DAYOFMONTH", "DAYOFWEEK", "DAYOFYEAR",
-		"DECODE", "DEFAULT", "DEGREES", "DES_DECRYPT", "DES_ENCRYPT",
+		"DECODE", "DEFAULT", "DEGREES", "DENSE_RANK", "DES_DECRYPT", "DES_ENCRYPT",
 		"ELT", "ENCODE", "ENCRYPT", "EXP", "EXPORT_SET",
-		"EXTRACT", "FIELD", "FIND_IN_SET", "FLOOR", "FORMAT",
+		"EXTRACT", "FIELD", "FIND_IN_SET", "FIRST_VALUE", "FLOOR", "FORMAT",
 		"FORM_UNIXTIME", "FOUND_ROWS", "FROM_DAYS", "GET_FORMAT", "GET_LOCK",
 		"GROUP_CONCAT", "HEX", "HOUR", "IF", "IFNULL",
 		"INET_ATON", "INET_NTOA", "INSERT", "INSTR", "IS_FREE_LOCK",
-		"IS_USED_LOCK", "LAST_INSERT_ID", "LCASE", "LEFT", "LENGTH",
+		"IS_USED_LOCK",
+		"JSON_ARRAY", "JSON_ARRAYAGG", "JSON_ARRAY_APPEND", "JSON_ARRAY_INSERT", "JSON_CONTAINS",
+		"JSON_CONTAINS_PATH", "JSON_DEPTH", "JSON_EXTRACT", "JSON_INSERT", "JSON_KEYS",
+		"JSON_LENGTH", "JSON_MERGE", "JSON_MERGE_PATCH", "JSON_MERGE_PRESERVE", "JSON_OBJECT",
+		"JSON_OBJECTAGG", "JSON_OVERLAPS", "JSON_PRETTY", "JSON_QUOTE", "JSON_REMOVE",
+		"JSON_REPLACE", "JSON_SCHEMA_VALID", "JSON_SCHEMA_VALIDATION_REPORT", "JSON_SEARCH", "JSON_SET",
+		"JSON_STORAGE_FREE", "JSON_STORAGE_SIZE", "JSON_TYPE", "JSON_UNQUOTE", "JSON_VALID",
+		"JSON_VALUE",
+		"LAG", "LAST_INSERT_ID", "LAST_VALUE", "LCASE", "LEAD", "LEFT", "LENGTH",
 		"LN", "LOAD_FILE", "LOCALTIME", "LOCALTIMESTAMP", "LOCATE",
 		"LOG", "LOG10", "LOG2", "LOWER", "LPAD",
 		"LTRIM", "MAKEDATE", "MAKETIME", "MAKE_SET", "MASTER_POS_WAIT",
 		"MAX", "MD5", "MICROSECOND", "MID", "MIN",
 		"MINUTE", "MOD", "MONTH", "MONTHNAME", "NAME_CONST",
-		"NOW", "NULLIF", "OCT", "OCTET_LENGTH", "OLD_PASSWORD",
-		"ORD", "PASSWORD", "PERIOD_ADD", "PERIOD_DIFF", "PI",
+		"NOW", "NTH_VALUE", "NTILE", "NULLIF", "OCT", "OCTET_LENGTH", "OLD_PASSWORD",
+		"ORD", "PASSWORD", "PERCENT_RANK", "PERIOD_ADD", "PERIOD_DIFF", "PI",
 		"POSITION", "POW", "POWER", "QUARTER", "QUOTE",
-		"RADIANS", "RAND", "RELEASE_LOCK", "REPEAT", "REPLACE",
-		"REVERSE", "RIGHT", "ROUND", "ROW_COUNT", "RPAD",
+		"RADIANS", "RAND", "RANK", "RELEASE_LOCK", "REPEAT", "REPLACE",
+		"REVERSE", "RIGHT", "ROUND", "ROW_COUNT", "ROW_NUMBER", "RPAD",
 		"RTRIM", "SCHEMA", "SECOND", "SESSION_USER", "SET_TO_TIME",
 		"SHA", "SHA1", "SHA2", "SIGN", "SIN",
 		"SLEEP", "SOUNDEX", "SPACE", "SQRT", "STD",
@@ -1735,6 +2059,19 @@ var (
 	}
 )
 
+// getExpressionTokenValue 获取表达式中词法单元的写法，其后不是括号的关键字用作名称(如列名rank)，保留原始写法
+func getExpressionTokenValue(t *MySQLToken, tokenList MySQLTokenList) string {
+	keyword, ok := (*t).(*MySQLKeywordToken)
+	if !ok || InArray(keyword.Value(), supportKeyword) || InArray(keyword.Value(), nonColumnKeyword) {
+		return (*t).Value()
+	}
+	nextToken := tokenList.GetNextValidToken(1)
+	if len(nextToken) > 0 && (*nextToken[0]).Type() == "MySQLOperatorToken" && (*nextToken[0]).Value() == "(" {
+		return (*t).Value()
+	}
+	return keyword.OriginValue()
+}
+
 func NewMySQLExpressionComponent(tokenList MySQLTokenList,
 	verboseFunc func(message string, level LogLevel)) (MySQLComponent, MySQLTokenList) {
 	startPos := tokenList.CurrentPos()
@@ -1847,6 +2184,35 @@ func NewMySQLExpressionComponent(tokenList MySQLTokenList,
 				tokenList.Reset(tokenList.CurrentPos() - 1)
 				break
 			}
+		} else if (*t).Type() == "MySQLKeywordToken" && (*t).Value() == "OVER" {
+			var window MySQLComponent
+			window, tokenList = NewMySQLWindowSpecComponent(tokenList, verboseFunc)
+			if window == nil {
+				window, tokenList = NewMySQLIdentifierComponent(tokenList, verboseFunc)
+			}
+			if window == nil {
+				tokenList.Reset(tokenList.CurrentPos() - 1)
+				break
+			}
+			if inBracket > 0 {
+				bracketTokenList = append(bracketTokenList, *t, window)
+				bracketValue += (*t).Value() + window.Value()
+			} else {
+				obj := (*t).(MySQLObject)
+				c.ObjectList = append(c.ObjectList, &obj)
+				windowObj := window.(MySQLObject)
+				c.ObjectList = append(c.ObjectList, &windowObj)
+				c.value += (*t).Value() + window.Value()
+				lastTermPos = tokenList.CurrentPos()
+			}
+			if verboseFunc != nil {
+				verboseFunc(fmt.Sprintf("EXPRESSION IN OVER %+v, %s, %d, %d",
+					c.ObjectList, c.Value(), lastTermPos, inBracket), LogLevelInfo)
+			}
+		} else if (*t).Type() == "MySQLKeywordToken" && (*t).Value() == "ROWS" && inBracket == 0 &&
+			isWindowFrameStart(tokenList) {
+			tokenList.Reset(tokenList.CurrentPos() - 1)
+			break
 		} else if (*t).Type() == "MySQLKeywordToken" && !InArray((*t).Value(), supportKeyword) &&
 			!InArray((*t).Value(), supportFunction) && !InArray((*t).Value(), Keywords) {
 			tokenList.Reset(tokenList.CurrentPos() - 1)
@@ -1854,7 +2220,7 @@ func NewMySQLExpressionComponent(tokenList MySQLTokenList,
 		} else {
 			if inBracket > 0 {
 				bracketTokenList = append(bracketTokenList, *t)
-				bracketValue += (*t).Value()
+				bracketValue += getExpressionTokenValue(t, tokenList)
 				if verboseFunc != nil {
 					verboseFunc(fmt.Sprintf("EXPRESSION IN ELSE %+v, %s, %d, %d",
 						bracketTokenList, bracketValue, lastTermPos, inBracket), LogLevelInfo)
@@ -1862,7 +2228,7 @@ func NewMySQLExpressionComponent(tokenList MySQLTokenList,
 			} else {
 				obj := (*t).(MySQLObject)
 				c.ObjectList = append(c.ObjectList, &obj)
-				c.value += (*t).Value()
+				c.value += getExpressionTokenValue(t, tokenList)
 				if !InArray((*t).Type(), []string{"MySQLCommentToken", "MySQLSpaceToken"}) {
 					lastTermPos = tokenList.CurrentPos()
 				}
@@ -1887,6 +2253,65 @@ func NewMySQLExpressionComponent(tokenList MySQLTokenList,
 	return c, tokenList
 }
 
+// getSubQueryTableList 获取表达式中子查询涉及的库表
+func getSubQueryTableList(expression *MySQLExpressionComponent) ([]string, []string) {
+	databaseList := make([]string, 0)
+	tableList := make([]string, 0)
+	for _, t := range expression.ObjectList {
+		if (*t).Type() == "SubQueryComponent" {
+			databaseList = append(databaseList, (*t).(*SubQueryComponent).DatabaseList...)
+			tableList = append(tableList, (*t).(*SubQueryComponent).TableList...)
+		}
+	}
+	return databaseList, tableList
+}
+
+// getExpressionColumnName 判断表达式是否仅为列引用(含*及tbl.*)，是则返回库名、表名和列名
+func getExpressionColumnName(expression *MySQLExpressionComponent) (string, string, string, bool) {
+	partList := make([]string, 0)
+	expectName := true
+	for _, t := range expression.ObjectList {
+		if (*t).Type() == "MySQLSpaceToken" || (*t).Type() == "MySQLCommentToken" {
+			continue
+		}
+		if expectName && ((*t).Type() == "MySQLUnquotedIdentifierToken" || (*t).Type() == "MySQLQuotedIdentifierToken" ||
+			(*t).Type() == "MySQLKeywordToken") {
+			partList = append(partList, strings.Trim((*t).Value(), "`"))
+			expectName = false
+		} else if expectName && (*t).Type() == "MySQLOperatorToken" && (*t).Value() == "*" {
+			partList = append(partList, "*")
+			expectName = false
+		} else if !expectName && (*t).Type() == "MySQLOperatorToken" && (*t).Value() == "." &&
+			partList[len(partList)-1] != "*" {
+			expectName = true
+		} else {
+			return "", "", "", false
+		}
+	}
+	if expectName || len(partList) > 3 {
+		return "", "", "", false
+	}
+	for len(partList) < 3 {
+		partList = append([]string{""}, partList...)
+	}
+	return partList[0], partList[1], partList[2], true
+}
+
+// isWindowFrameStart 判断ROWS之后是否为窗口frame定义
+func isWindowFrameStart(tokenList MySQLTokenList) bool {
+	nextToken := tokenList.GetNextValidToken(2)
+	if len(nextToken) == 0 {
+		return false
+	}
+	if (*nextToken[0]).Type() == "MySQLKeywordToken" &&
+		InArray((*nextToken[0]).Value(), []string{"BETWEEN", "UNBOUNDED", "CURRENT", "INTERVAL"}) {
+		return true
+	}
+	return len(nextToken) == 2 && (*nextToken[0]).Type() == "MySQLNumericToken" &&
+		(*nextToken[1]).Type() == "MySQLKeywordToken" &&
+		InArray((*nextToken[1]).Value(), []string{"PRECEDING", "FOLLOWING"})
+}
+
 // subpartitioning expr:
 //     [LINEAR] HASH(expr)
 //   | [LINEAR] KEY [ALGORITHM={1|2}] (column_list)
@@ -2144,6 +2569,8 @@ func NewMySQLPartitioningExpressionComponent(tokenList MySQLTokenList,
 
 type MySQLAssignmentExpressionComponent struct {
 	*MySQLBaseComponent
+	Column     *MySQLColumnNameComponent
+	Expression *MySQLExpressionComponent
 }
 
 func (c *MySQLAssignmentExpressionComponent) Type() string {
@@ -2186,6 +2613,13 @@ func NewMySQLAssignmentExpressionComponent(tokenList MySQLTokenList,
 		tokenList.Reset(startPos)
 		return nil, tokenList
 	} else {
+		for _, t := range c.ObjectList {
+			if (*t).Type() == "MySQLColumnNameComponent" {
+				c.Column = (*t).(*MySQLColumnNameComponent)
+			} else if (*t).Type() == "MySQLExpressionComponent" {
+				c.Expression = (*t).(*MySQLExpressionComponent)
+			}
+		}
 		tokenList.Reset(endPos)
 		return c, tokenList
 	}
@@ -2196,6 +2630,7 @@ func NewMySQLAssignmentExpressionComponent(tokenList MySQLTokenList,
 
 type MySQLAssignmentListExpressionComponent struct {
 	*MySQLBaseComponent
+	AssignmentList []*MySQLAssignmentExpressionComponent
 }
 
 func (c *MySQLAssignmentListExpressionComponent) Type() string {
@@ -2226,31 +2661,107 @@ func NewMySQLAssignmentListExpressionComponent(tokenList MySQLTokenList,
 		MySQLBaseComponent: &MySQLBaseComponent{
 			ObjectList: make([]*MySQLObject, 0),
 		},
+		AssignmentList: make([]*MySQLAssignmentExpressionComponent, 0),
 	}
 	endPos := c.ParseByFsm(c.GetFsmMap(), tokenList, []int{1}, verboseFunc)
 	if endPos == -1 {
 		tokenList.Reset(startPos)
 		return nil, tokenList
 	} else {
+		for _, t := range c.ObjectList {
+			if (*t).Type() == "MySQLAssignmentExpressionComponent" {
+				c.AssignmentList = append(c.AssignmentList, (*t).(*MySQLAssignmentExpressionComponent))
+			}
+		}
 		tokenList.Reset(endPos)
 		return c, tokenList
 	}
 }
 
-type MySQLIdentifierComponent struct {
+// (value_list)
+//
+// value:
+//    {expr | DEFAULT}
+//
+// value_list:
+//    value [, value] ...
+
+type MySQLValueListComponent struct {
 	*MySQLBaseComponent
+	ValueList []*MySQLExpressionComponent
 }
 
-func (c *MySQLIdentifierComponent) Type() string {
-	return "MySQLIdentifierComponent"
+func (c *MySQLValueListComponent) Type() string {
+	return "MySQLValueListComponent"
 }
 
-func NewMySQLIdentifierComponent(tokenList MySQLTokenList,
-	_ func(message string, level LogLevel)) (MySQLComponent, MySQLTokenList) {
-	startPos := tokenList.CurrentPos()
-	c := &MySQLIdentifierComponent{
-		&MySQLBaseComponent{
-			ObjectList: make([]*MySQLObject, 0),
+func (c *MySQLValueListComponent) GetFsmMap() []FsmMap {
+	return []FsmMap{
+		{
+			StartStatus:  []int{0},
+			AcceptObject: "MySQLOperatorToken",
+			AcceptValue:  "(",
+			EndStatus:    1,
+		},
+		{
+			StartStatus:  []int{1, 3},
+			AcceptObject: "MySQLExpressionComponent",
+			AcceptValue:  "",
+			EndStatus:    2,
+		},
+		{
+			StartStatus:  []int{2},
+			AcceptObject: "MySQLDelimiterToken",
+			AcceptValue:  ",",
+			EndStatus:    3,
+		},
+		{
+			StartStatus:  []int{1, 2},
+			AcceptObject: "MySQLOperatorToken",
+			AcceptValue:  ")",
+			EndStatus:    FinalStatus,
+		},
+	}
+}
+
+func NewMySQLValueListComponent(tokenList MySQLTokenList,
+	verboseFunc func(message string, level LogLevel)) (MySQLComponent, MySQLTokenList) {
+	startPos := tokenList.CurrentPos()
+	c := &MySQLValueListComponent{
+		MySQLBaseComponent: &MySQLBaseComponent{
+			ObjectList: make([]*MySQLObject, 0),
+		},
+		ValueList: make([]*MySQLExpressionComponent, 0),
+	}
+	endPos := c.ParseByFsm(c.GetFsmMap(), tokenList, []int{}, verboseFunc)
+	if endPos == -1 {
+		tokenList.Reset(startPos)
+		return nil, tokenList
+	} else {
+		for _, t := range c.ObjectList {
+			if (*t).Type() == "MySQLExpressionComponent" {
+				c.ValueList = append(c.ValueList, (*t).(*MySQLExpressionComponent))
+			}
+		}
+		tokenList.Reset(endPos)
+		return c, tokenList
+	}
+}
+
+type MySQLIdentifierComponent struct {
+	*MySQLBaseComponent
+}
+
+func (c *MySQLIdentifierComponent) Type() string {
+	return "MySQLIdentifierComponent"
+}
+
+func NewMySQLIdentifierComponent(tokenList MySQLTokenList,
+	_ func(message string, level LogLevel)) (MySQLComponent, MySQLTokenList) {
+	startPos := tokenList.CurrentPos()
+	c := &MySQLIdentifierComponent{
+		&MySQLBaseComponent{
+			ObjectList: make([]*MySQLObject, 0),
 		},
 	}
 	for !tokenList.EOF() {
@@ -2269,9 +2780,10 @@ func NewMySQLIdentifierComponent(tokenList MySQLTokenList,
 			c.value += (*t).Value()
 			return c, tokenList
 		} else if (*t).Type() == "MySQLKeywordToken" && InArray((*t).Value(), Keywords) {
+			// 用作标识符的关键字保留原始写法
 			obj := (*t).(MySQLObject)
 			c.ObjectList = append(c.ObjectList, &obj)
-			c.value += (*t).Value()
+			c.value += (*t).(*MySQLKeywordToken).OriginValue()
 			return c, tokenList
 		} else {
 			break
@@ -2563,8 +3075,15 @@ func NewMySQLColumnNameListComponent(tokenList MySQLTokenList,
 	}
 }
 
+// key_part:
+//    {col_name [(length)] | (expr)} [ASC | DESC]
+
 type MySQLIndexColumnNameComponent struct {
 	*MySQLBaseComponent
+	Column     string
+	Length     string
+	Expression *MySQLExpressionComponent
+	Desc       bool
 }
 
 func (c *MySQLIndexColumnNameComponent) Type() string {
@@ -2597,6 +3116,24 @@ func (c *MySQLIndexColumnNameComponent) GetFsmMap() []FsmMap {
 			AcceptValue:  ")",
 			EndStatus:    4,
 		},
+		{
+			StartStatus:  []int{0},
+			AcceptObject: "MySQLOperatorToken",
+			AcceptValue:  "(",
+			EndStatus:    5,
+		},
+		{
+			StartStatus:  []int{5},
+			AcceptObject: "MySQLExpressionComponent",
+			AcceptValue:  "",
+			EndStatus:    6,
+		},
+		{
+			StartStatus:  []int{6},
+			AcceptObject: "MySQLOperatorToken",
+			AcceptValue:  ")",
+			EndStatus:    4,
+		},
 		{
 			StartStatus:  []int{1, 4},
 			AcceptObject: "MySQLKeywordToken",
@@ -2625,6 +3162,17 @@ func NewMySQLIndexColumnNameComponent(tokenList MySQLTokenList,
 		tokenList.Reset(startPos)
 		return nil, tokenList
 	} else {
+		for _, t := range c.ObjectList {
+			if (*t).Type() == "MySQLColumnNameComponent" {
+				c.Column = (*t).(*MySQLColumnNameComponent).Column
+			} else if (*t).Type() == "MySQLNumericToken" {
+				c.Length = (*t).Value()
+			} else if (*t).Type() == "MySQLExpressionComponent" {
+				c.Expression = (*t).(*MySQLExpressionComponent)
+			} else if (*t).Type() == "MySQLKeywordToken" && strings.ToUpper((*t).Value()) == "DESC" {
+				c.Desc = true
+			}
+		}
 		tokenList.Reset(endPos)
 		return c, tokenList
 	}
@@ -2805,7 +3353,7 @@ func (c *MySQLEngineNameComponent) Type() string {
 }
 
 var supportEngine = []string{
-	"INNODB", "MyISAM", "MEMORY", "CSV", "ARCHIVE",
+	"INNODB", "MYISAM", "MEMORY", "CSV", "ARCHIVE",
 	"BLACKHOLE", "MERGE", "FEDERATED",
 }
 
@@ -2994,6 +3542,8 @@ func NewMySQLStringOptionValueComponent(tokenList MySQLTokenList,
 
 type MySQLDatabaseOptionComponent struct {
 	*MySQLBaseComponent
+	Name        string
+	OptionValue string
 }
 
 func (c *MySQLDatabaseOptionComponent) Type() string {
@@ -3076,6 +3626,15 @@ func NewMySQLDatabaseOptionComponent(tokenList MySQLTokenList,
 		tokenList.Reset(startPos)
 		return nil, tokenList
 	} else {
+		for _, t := range c.ObjectList {
+			if (*t).Type() == "MySQLCharsetNameComponent" {
+				c.Name = "CHARSET"
+				c.OptionValue = (*t).(*MySQLCharsetNameComponent).Charset
+			} else if (*t).Type() == "MySQLCollationNameComponent" {
+				c.Name = "COLLATE"
+				c.OptionValue = (*t).(*MySQLCollationNameComponent).Collation
+			}
+		}
 		tokenList.Reset(endPos)
 		return c, tokenList
 	}
@@ -3104,6 +3663,8 @@ func NewMySQLDatabaseOptionComponent(tokenList MySQLTokenList,
 
 type MySQLTableOptionComponent struct {
 	*MySQLBaseComponent
+	Name        string
+	OptionValue string
 }
 
 func (c *MySQLTableOptionComponent) Type() string {
@@ -3388,7 +3949,7 @@ func (c *MySQLTableOptionComponent) GetFsmMap() []FsmMap {
 		{
 			StartStatus:  []int{0},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "INSERT_METHOD",
+			AcceptValue:  "ROW_FORMAT",
 			EndStatus:    18,
 		},
 		{
@@ -3503,6 +4064,30 @@ func NewMySQLTableOptionComponent(tokenList MySQLTokenList,
 		tokenList.Reset(startPos)
 		return nil, tokenList
 	} else {
+		valueList := make([]string, 0)
+		for _, t := range c.ObjectList {
+			if (*t).Type() == "MySQLSpaceToken" || (*t).Type() == "MySQLCommentToken" ||
+				((*t).Type() == "MySQLOperatorToken" && (*t).Value() == "=") {
+				continue
+			} else if (*t).Type() == "MySQLDatabaseOptionComponent" {
+				c.Name = (*t).(*MySQLDatabaseOptionComponent).Name
+				valueList = append(valueList, (*t).(*MySQLDatabaseOptionComponent).OptionValue)
+			} else if (*t).Type() == "MySQLEngineNameComponent" {
+				for _, engine := range getValidObjectList((*t).(*MySQLEngineNameComponent).ObjectList) {
+					valueList = append(valueList, getNameValue(engine))
+				}
+			} else if (*t).Type() == "MySQLKeywordToken" && c.Name == "" {
+				c.Name = strings.ToUpper((*t).Value())
+			} else if (*t).Type() == "MySQLKeywordToken" && strings.ToUpper((*t).Value()) == "DIRECTORY" {
+				c.Name += " DIRECTORY"
+			} else if (*t).Type() == "MySQLKeywordToken" {
+				valueList = append(valueList, (*t).(*MySQLKeywordToken).OriginValue())
+			} else {
+				value := strings.TrimSpace((*t).Value())
+				valueList = append(valueList, strings.TrimSpace(strings.TrimPrefix(value, "=")))
+			}
+		}
+		c.OptionValue = strings.Join(valueList, " ")
 		tokenList.Reset(endPos)
 		return c, tokenList
 	}
@@ -3513,6 +4098,7 @@ func NewMySQLTableOptionComponent(tokenList MySQLTokenList,
 
 type MySQLTableOptionListComponent struct {
 	*MySQLBaseComponent
+	OptionList []*MySQLTableOptionComponent
 }
 
 func (c *MySQLTableOptionListComponent) Type() string {
@@ -3543,12 +4129,18 @@ func NewMySQLTableOptionListComponent(tokenList MySQLTokenList,
 		MySQLBaseComponent: &MySQLBaseComponent{
 			ObjectList: make([]*MySQLObject, 0),
 		},
+		OptionList: make([]*MySQLTableOptionComponent, 0),
 	}
 	endPos := c.ParseByFsm(c.GetFsmMap(), tokenList, []int{1}, verboseFunc)
 	if endPos == -1 {
 		tokenList.Reset(startPos)
 		return nil, tokenList
 	} else {
+		for _, t := range c.ObjectList {
+			if (*t).Type() == "MySQLTableOptionComponent" {
+				c.OptionList = append(c.OptionList, (*t).(*MySQLTableOptionComponent))
+			}
+		}
 		tokenList.Reset(endPos)
 		return c, tokenList
 	}
@@ -3611,9 +4203,11 @@ func NewMySQLIndexTypeComponent(tokenList MySQLTokenList,
 // | index_type
 // | WITH PARSER parser_name
 // | COMMENT 'string'
+// | {VISIBLE | INVISIBLE}
 
 type MySQLIndexOptionComponent struct {
 	*MySQLBaseComponent
+	Invisible bool
 }
 
 func (c *MySQLIndexOptionComponent) Type() string {
@@ -3670,6 +4264,18 @@ func (c *MySQLIndexOptionComponent) GetFsmMap() []FsmMap {
 			AcceptValue:  "",
 			EndStatus:    FinalStatus,
 		},
+		{
+			StartStatus:  []int{0},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "VISIBLE",
+			EndStatus:    FinalStatus,
+		},
+		{
+			StartStatus:  []int{0},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "INVISIBLE",
+			EndStatus:    FinalStatus,
+		},
 	}
 }
 
@@ -3686,6 +4292,11 @@ func NewMySQLIndexOptionComponent(tokenList MySQLTokenList,
 		tokenList.Reset(startPos)
 		return nil, tokenList
 	} else {
+		for _, t := range c.ObjectList {
+			if (*t).Type() == "MySQLKeywordToken" && strings.ToUpper((*t).Value()) == "INVISIBLE" {
+				c.Invisible = true
+			}
+		}
 		tokenList.Reset(endPos)
 		return c, tokenList
 	}
@@ -3992,231 +4603,1414 @@ func NewMySQLOrderListOptionComponent(tokenList MySQLTokenList,
 	}
 }
 
-// index_hint:
-//    USE {INDEX|KEY}
-//      [FOR {JOIN|ORDER BY|GROUP BY}] ([index_list])
-//  | IGNORE {INDEX|KEY}
-//      [FOR {JOIN|ORDER BY|GROUP BY}] (index_list)
-//  | FORCE {INDEX|KEY}
-//      [FOR {JOIN|ORDER BY|GROUP BY}] (index_list)
+// locking_clause:
+//    FOR {UPDATE | SHARE} [OF tbl_name [, tbl_name] ...] [NOWAIT | SKIP LOCKED]
+//  | LOCK IN SHARE MODE
 
-type MySQLIndexHintOptionComponent struct {
+type MySQLLockingClauseComponent struct {
 	*MySQLBaseComponent
+	LockMode
 }
 
-func (c *MySQLIndexHintOptionComponent) Type() string {
-	return "MySQLIndexHintOptionComponent"
+func (c *MySQLLockingClauseComponent) Type() string {
+	return "MySQLLockingClauseComponent"
 }
 
-func (c *MySQLIndexHintOptionComponent) GetFsmMap() []FsmMap {
+func (c *MySQLLockingClauseComponent) GetFsmMap() []FsmMap {
 	return []FsmMap{
 		{
 			StartStatus:  []int{0},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "USE",
+			AcceptValue:  "FOR",
 			EndStatus:    1,
 		},
 		{
 			StartStatus:  []int{1},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "INDEX",
+			AcceptValue:  "UPDATE",
 			EndStatus:    2,
 		},
 		{
 			StartStatus:  []int{1},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "KEY",
+			AcceptValue:  "SHARE",
 			EndStatus:    2,
 		},
 		{
 			StartStatus:  []int{2},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "FOR",
+			AcceptValue:  "OF",
 			EndStatus:    3,
 		},
 		{
-			StartStatus:  []int{3},
-			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "JOIN",
-			EndStatus:    5,
-		},
-		{
-			StartStatus:  []int{3},
-			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "ORDER",
-			EndStatus:    4,
-		},
-		{
-			StartStatus:  []int{3},
-			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "GROUP",
+			StartStatus:  []int{3, 5},
+			AcceptObject: "MySQLTableNameComponent",
+			AcceptValue:  "",
 			EndStatus:    4,
 		},
 		{
 			StartStatus:  []int{4},
-			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "BY",
-			EndStatus:    5,
-		},
-		{
-			StartStatus:  []int{2, 5},
-			AcceptObject: "MySQLOperatorToken",
-			AcceptValue:  "(",
-			EndStatus:    6,
-		},
-		{
-			StartStatus:  []int{6, 8},
-			AcceptObject: "MySQLIdentifierComponent",
-			AcceptValue:  "",
-			EndStatus:    7,
-		},
-		{
-			StartStatus:  []int{7},
 			AcceptObject: "MySQLDelimiterToken",
 			AcceptValue:  ",",
-			EndStatus:    8,
-		},
-		{
-			StartStatus:  []int{6, 7, 15},
-			AcceptObject: "MySQLOperatorToken",
-			AcceptValue:  ")",
-			EndStatus:    FinalStatus,
-		},
-		{
-			StartStatus:  []int{0},
-			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "IGNORE",
-			EndStatus:    9,
-		},
-		{
-			StartStatus:  []int{0},
-			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "FORCE",
-			EndStatus:    9,
+			EndStatus:    5,
 		},
 		{
-			StartStatus:  []int{9},
+			StartStatus:  []int{2, 4},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "INDEX",
-			EndStatus:    10,
+			AcceptValue:  "NOWAIT",
+			EndStatus:    FinalStatus,
 		},
 		{
-			StartStatus:  []int{9},
+			StartStatus:  []int{2, 4},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "KEY",
-			EndStatus:    10,
+			AcceptValue:  "SKIP",
+			EndStatus:    6,
 		},
 		{
-			StartStatus:  []int{10},
+			StartStatus:  []int{6},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "FOR",
-			EndStatus:    11,
+			AcceptValue:  "LOCKED",
+			EndStatus:    FinalStatus,
 		},
 		{
-			StartStatus:  []int{11},
+			StartStatus:  []int{0},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "JOIN",
-			EndStatus:    13,
+			AcceptValue:  "LOCK",
+			EndStatus:    7,
 		},
 		{
-			StartStatus:  []int{11},
+			StartStatus:  []int{7},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "ORDER",
-			EndStatus:    12,
+			AcceptValue:  "IN",
+			EndStatus:    8,
 		},
 		{
-			StartStatus:  []int{11},
+			StartStatus:  []int{8},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "GROUP",
-			EndStatus:    12,
+			AcceptValue:  "SHARE",
+			EndStatus:    9,
 		},
 		{
-			StartStatus:  []int{12},
+			StartStatus:  []int{9},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "BY",
-			EndStatus:    13,
-		},
-		{
-			StartStatus:  []int{10, 13},
-			AcceptObject: "MySQLOperatorToken",
-			AcceptValue:  "(",
-			EndStatus:    14,
-		},
-		{
-			StartStatus:  []int{14, 16},
-			AcceptObject: "MySQLIdentifierComponent",
-			AcceptValue:  "",
-			EndStatus:    15,
-		},
-		{
-			StartStatus:  []int{15},
-			AcceptObject: "MySQLDelimiterToken",
-			AcceptValue:  ",",
-			EndStatus:    16,
+			AcceptValue:  "MODE",
+			EndStatus:    FinalStatus,
 		},
 	}
 }
 
-func NewMySQLIndexHintOptionComponent(tokenList MySQLTokenList,
+func NewMySQLLockingClauseComponent(tokenList MySQLTokenList,
 	verboseFunc func(message string, level LogLevel)) (MySQLComponent, MySQLTokenList) {
 	startPos := tokenList.CurrentPos()
-	c := &MySQLIndexHintOptionComponent{
+	c := &MySQLLockingClauseComponent{
 		MySQLBaseComponent: &MySQLBaseComponent{
 			ObjectList: make([]*MySQLObject, 0),
 		},
+		LockMode: LockMode{
+			TableList: make([]*MySQLTableNameComponent, 0),
+		},
 	}
-	endPos := c.ParseByFsm(c.GetFsmMap(), tokenList, []int{}, verboseFunc)
+	endPos := c.ParseByFsm(c.GetFsmMap(), tokenList, []int{2, 4}, verboseFunc)
 	if endPos == -1 {
 		tokenList.Reset(startPos)
 		return nil, tokenList
 	} else {
+		for _, t := range c.ObjectList {
+			if (*t).Type() == "MySQLKeywordToken" && (*t).Value() == "UPDATE" {
+				c.LockType = LockTypeUpdate
+			} else if (*t).Type() == "MySQLKeywordToken" && (*t).Value() == "SHARE" {
+				c.LockType = LockTypeShare
+			} else if (*t).Type() == "MySQLKeywordToken" && (*t).Value() == "NOWAIT" {
+				c.WaitPolicy = LockWaitNowait
+			} else if (*t).Type() == "MySQLKeywordToken" && (*t).Value() == "SKIP" {
+				c.WaitPolicy = LockWaitSkipLocked
+			} else if (*t).Type() == "MySQLTableNameComponent" {
+				c.TableList = append(c.TableList, (*t).(*MySQLTableNameComponent))
+			}
+		}
 		tokenList.Reset(endPos)
 		return c, tokenList
 	}
 }
 
-// export_options:
-//    [{FIELDS | COLUMNS}
-//        [TERMINATED BY 'string']
-//        [[OPTIONALLY] ENCLOSED BY 'char']
-//        [ESCAPED BY 'char']
-//    ]
-//    [LINES
-//        [STARTING BY 'string']
-//        [TERMINATED BY 'string']
-//    ]
-
-type MySQLExportOptionComponent struct {
+// mergeLockMode 合并两个加锁方式，取较强的锁类型
+func mergeLockMode(lockMode LockMode, other LockMode) LockMode {
+	if other.LockType > lockMode.LockType {
+		lockMode.LockType = other.LockType
+	}
+	if lockMode.WaitPolicy == LockWaitDefault {
+		lockMode.WaitPolicy = other.WaitPolicy
+	}
+	lockMode.TableList = append(lockMode.TableList, other.TableList...)
+	return lockMode
+}
+
+// window_spec:
+//    [window_name] [partition_clause] [order_clause] [frame_clause]
+//
+// partition_clause:
+//    PARTITION BY expr [, expr] ...
+//
+// order_clause:
+//    ORDER BY expr [ASC|DESC] [, expr [ASC|DESC]] ...
+
+type MySQLWindowSpecComponent struct {
+	*MySQLBaseComponent
+	Name          string
+	PartitionList []*MySQLExpressionComponent
+	OrderList     *MySQLOrderListOptionComponent
+	Frame         *MySQLWindowFrameComponent
+}
+
+func (c *MySQLWindowSpecComponent) Type() string {
+	return "MySQLWindowSpecComponent"
+}
+
+func (c *MySQLWindowSpecComponent) GetFsmMap() []FsmMap {
+	return []FsmMap{
+		{
+			StartStatus:  []int{0},
+			AcceptObject: "MySQLOperatorToken",
+			AcceptValue:  "(",
+			EndStatus:    1,
+		},
+		{
+			StartStatus:  []int{1, 2},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "PARTITION",
+			EndStatus:    3,
+		},
+		{
+			StartStatus:  []int{3},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "BY",
+			EndStatus:    4,
+		},
+		{
+			StartStatus:  []int{4},
+			AcceptObject: "MySQLExpressionComponent",
+			AcceptValue:  "",
+			EndStatus:    5,
+		},
+		{
+			StartStatus:  []int{5},
+			AcceptObject: "MySQLDelimiterToken",
+			AcceptValue:  ",",
+			EndStatus:    4,
+		},
+		{
+			StartStatus:  []int{1, 2, 5},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "ORDER",
+			EndStatus:    6,
+		},
+		{
+			StartStatus:  []int{6},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "BY",
+			EndStatus:    7,
+		},
+		{
+			StartStatus:  []int{7},
+			AcceptObject: "MySQLOrderListOptionComponent",
+			AcceptValue:  "",
+			EndStatus:    8,
+		},
+		{
+			StartStatus:  []int{1, 2, 5, 8},
+			AcceptObject: "MySQLWindowFrameComponent",
+			AcceptValue:  "",
+			EndStatus:    9,
+		},
+		{
+			StartStatus:  []int{1, 2, 5, 8, 9},
+			AcceptObject: "MySQLOperatorToken",
+			AcceptValue:  ")",
+			EndStatus:    FinalStatus,
+		},
+		{
+			StartStatus:  []int{1},
+			AcceptObject: "MySQLIdentifierComponent",
+			AcceptValue:  "",
+			EndStatus:    2,
+		},
+	}
+}
+
+func NewMySQLWindowSpecComponent(tokenList MySQLTokenList,
+	verboseFunc func(message string, level LogLevel)) (MySQLComponent, MySQLTokenList) {
+	startPos := tokenList.CurrentPos()
+	c := &MySQLWindowSpecComponent{
+		MySQLBaseComponent: &MySQLBaseComponent{
+			ObjectList: make([]*MySQLObject, 0),
+		},
+		PartitionList: make([]*MySQLExpressionComponent, 0),
+	}
+	endPos := c.ParseByFsm(c.GetFsmMap(), tokenList, []int{}, verboseFunc)
+	if endPos == -1 {
+		tokenList.Reset(startPos)
+		return nil, tokenList
+	} else {
+		for _, t := range c.ObjectList {
+			if (*t).Type() == "MySQLIdentifierComponent" {
+				c.Name = strings.Trim((*t).Value(), "`")
+			} else if (*t).Type() == "MySQLExpressionComponent" {
+				c.PartitionList = append(c.PartitionList, (*t).(*MySQLExpressionComponent))
+			} else if (*t).Type() == "MySQLOrderListOptionComponent" {
+				c.OrderList = (*t).(*MySQLOrderListOptionComponent)
+			} else if (*t).Type() == "MySQLWindowFrameComponent" {
+				c.Frame = (*t).(*MySQLWindowFrameComponent)
+			}
+		}
+		tokenList.Reset(endPos)
+		return c, tokenList
+	}
+}
+
+// frame_clause:
+//    frame_units frame_extent
+//
+// frame_units:
+//    {ROWS | RANGE}
+//
+// frame_extent:
+//    {frame_start | frame_between}
+//
+// frame_between:
+//    BETWEEN frame_start AND frame_end
+
+type MySQLWindowFrameComponent struct {
+	*MySQLBaseComponent
+	Unit  string
+	Start *MySQLWindowFrameBoundComponent
+	End   *MySQLWindowFrameBoundComponent
+}
+
+func (c *MySQLWindowFrameComponent) Type() string {
+	return "MySQLWindowFrameComponent"
+}
+
+func (c *MySQLWindowFrameComponent) GetFsmMap() []FsmMap {
+	return []FsmMap{
+		{
+			StartStatus:  []int{0},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "ROWS",
+			EndStatus:    1,
+		},
+		{
+			StartStatus:  []int{0},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "RANGE",
+			EndStatus:    1,
+		},
+		{
+			StartStatus:  []int{1},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "BETWEEN",
+			EndStatus:    2,
+		},
+		{
+			StartStatus:  []int{1},
+			AcceptObject: "MySQLWindowFrameBoundComponent",
+			AcceptValue:  "",
+			EndStatus:    FinalStatus,
+		},
+		{
+			StartStatus:  []int{2},
+			AcceptObject: "MySQLWindowFrameBoundComponent",
+			AcceptValue:  "",
+			EndStatus:    3,
+		},
+		{
+			StartStatus:  []int{3},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "AND",
+			EndStatus:    4,
+		},
+		{
+			StartStatus:  []int{4},
+			AcceptObject: "MySQLWindowFrameBoundComponent",
+			AcceptValue:  "",
+			EndStatus:    FinalStatus,
+		},
+	}
+}
+
+func NewMySQLWindowFrameComponent(tokenList MySQLTokenList,
+	verboseFunc func(message string, level LogLevel)) (MySQLComponent, MySQLTokenList) {
+	startPos := tokenList.CurrentPos()
+	c := &MySQLWindowFrameComponent{
+		MySQLBaseComponent: &MySQLBaseComponent{
+			ObjectList: make([]*MySQLObject, 0),
+		},
+	}
+	endPos := c.ParseByFsm(c.GetFsmMap(), tokenList, []int{}, verboseFunc)
+	if endPos == -1 {
+		tokenList.Reset(startPos)
+		return nil, tokenList
+	} else {
+		for _, t := range c.ObjectList {
+			if (*t).Type() == "MySQLKeywordToken" && c.Unit == "" {
+				c.Unit = (*t).Value()
+			} else if (*t).Type() == "MySQLWindowFrameBoundComponent" {
+				if c.Start == nil {
+					c.Start = (*t).(*MySQLWindowFrameBoundComponent)
+				} else {
+					c.End = (*t).(*MySQLWindowFrameBoundComponent)
+				}
+			}
+		}
+		tokenList.Reset(endPos)
+		return c, tokenList
+	}
+}
+
+// frame_start, frame_end:
+//    CURRENT ROW
+//  | UNBOUNDED PRECEDING
+//  | UNBOUNDED FOLLOWING
+//  | expr PRECEDING
+//  | expr FOLLOWING
+//  | INTERVAL expr unit PRECEDING
+//  | INTERVAL expr unit FOLLOWING
+
+type MySQLWindowFrameBoundComponent struct {
+	*MySQLBaseComponent
+}
+
+func (c *MySQLWindowFrameBoundComponent) Type() string {
+	return "MySQLWindowFrameBoundComponent"
+}
+
+func (c *MySQLWindowFrameBoundComponent) GetFsmMap() []FsmMap {
+	return []FsmMap{
+		{
+			StartStatus:  []int{0},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "CURRENT",
+			EndStatus:    1,
+		},
+		{
+			StartStatus:  []int{1},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "ROW",
+			EndStatus:    FinalStatus,
+		},
+		{
+			StartStatus:  []int{0},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "UNBOUNDED",
+			EndStatus:    2,
+		},
+		{
+			StartStatus:  []int{0},
+			AcceptObject: "MySQLNumericToken",
+			AcceptValue:  "",
+			EndStatus:    2,
+		},
+		{
+			StartStatus:  []int{0},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "INTERVAL",
+			EndStatus:    3,
+		},
+		{
+			StartStatus:  []int{3},
+			AcceptObject: "MySQLNumericToken",
+			AcceptValue:  "",
+			EndStatus:    4,
+		},
+		{
+			StartStatus:  []int{3},
+			AcceptObject: "MySQLStringToken",
+			AcceptValue:  "",
+			EndStatus:    4,
+		},
+		{
+			StartStatus:  []int{4},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "",
+			EndStatus:    2,
+		},
+		{
+			StartStatus:  []int{2},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "PRECEDING",
+			EndStatus:    FinalStatus,
+		},
+		{
+			StartStatus:  []int{2},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "FOLLOWING",
+			EndStatus:    FinalStatus,
+		},
+	}
+}
+
+func NewMySQLWindowFrameBoundComponent(tokenList MySQLTokenList,
+	verboseFunc func(message string, level LogLevel)) (MySQLComponent, MySQLTokenList) {
+	startPos := tokenList.CurrentPos()
+	c := &MySQLWindowFrameBoundComponent{
+		MySQLBaseComponent: &MySQLBaseComponent{
+			ObjectList: make([]*MySQLObject, 0),
+		},
+	}
+	endPos := c.ParseByFsm(c.GetFsmMap(), tokenList, []int{}, verboseFunc)
+	if endPos == -1 {
+		tokenList.Reset(startPos)
+		return nil, tokenList
+	} else {
+		tokenList.Reset(endPos)
+		return c, tokenList
+	}
+}
+
+// window_definition:
+//    window_name AS (window_spec)
+
+type MySQLWindowDefinitionComponent struct {
+	*MySQLBaseComponent
+	Name string
+	Spec *MySQLWindowSpecComponent
+}
+
+func (c *MySQLWindowDefinitionComponent) Type() string {
+	return "MySQLWindowDefinitionComponent"
+}
+
+func (c *MySQLWindowDefinitionComponent) GetFsmMap() []FsmMap {
+	return []FsmMap{
+		{
+			StartStatus:  []int{0},
+			AcceptObject: "MySQLIdentifierComponent",
+			AcceptValue:  "",
+			EndStatus:    1,
+		},
+		{
+			StartStatus:  []int{1},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "AS",
+			EndStatus:    2,
+		},
+		{
+			StartStatus:  []int{2},
+			AcceptObject: "MySQLWindowSpecComponent",
+			AcceptValue:  "",
+			EndStatus:    FinalStatus,
+		},
+	}
+}
+
+func NewMySQLWindowDefinitionComponent(tokenList MySQLTokenList,
+	verboseFunc func(message string, level LogLevel)) (MySQLComponent, MySQLTokenList) {
+	startPos := tokenList.CurrentPos()
+	c := &MySQLWindowDefinitionComponent{
+		MySQLBaseComponent: &MySQLBaseComponent{
+			ObjectList: make([]*MySQLObject, 0),
+		},
+	}
+	endPos := c.ParseByFsm(c.GetFsmMap(), tokenList, []int{}, verboseFunc)
+	if endPos == -1 {
+		tokenList.Reset(startPos)
+		return nil, tokenList
+	} else {
+		for _, t := range c.ObjectList {
+			if (*t).Type() == "MySQLIdentifierComponent" {
+				c.Name = strings.Trim((*t).Value(), "`")
+			} else if (*t).Type() == "MySQLWindowSpecComponent" {
+				c.Spec = (*t).(*MySQLWindowSpecComponent)
+			}
+		}
+		tokenList.Reset(endPos)
+		return c, tokenList
+	}
+}
+
+// index_hint:
+//    USE {INDEX|KEY}
+//      [FOR {JOIN|ORDER BY|GROUP BY}] ([index_list])
+//  | IGNORE {INDEX|KEY}
+//      [FOR {JOIN|ORDER BY|GROUP BY}] (index_list)
+//  | FORCE {INDEX|KEY}
+//      [FOR {JOIN|ORDER BY|GROUP BY}] (index_list)
+
+type MySQLIndexHintOptionComponent struct {
+	*MySQLBaseComponent
+}
+
+func (c *MySQLIndexHintOptionComponent) Type() string {
+	return "MySQLIndexHintOptionComponent"
+}
+
+func (c *MySQLIndexHintOptionComponent) GetFsmMap() []FsmMap {
+	return []FsmMap{
+		{
+			StartStatus:  []int{0},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "USE",
+			EndStatus:    1,
+		},
+		{
+			StartStatus:  []int{1},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "INDEX",
+			EndStatus:    2,
+		},
+		{
+			StartStatus:  []int{1},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "KEY",
+			EndStatus:    2,
+		},
+		{
+			StartStatus:  []int{2},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "FOR",
+			EndStatus:    3,
+		},
+		{
+			StartStatus:  []int{3},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "JOIN",
+			EndStatus:    5,
+		},
+		{
+			StartStatus:  []int{3},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "ORDER",
+			EndStatus:    4,
+		},
+		{
+			StartStatus:  []int{3},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "GROUP",
+			EndStatus:    4,
+		},
+		{
+			StartStatus:  []int{4},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "BY",
+			EndStatus:    5,
+		},
+		{
+			StartStatus:  []int{2, 5},
+			AcceptObject: "MySQLOperatorToken",
+			AcceptValue:  "(",
+			EndStatus:    6,
+		},
+		{
+			StartStatus:  []int{6, 8},
+			AcceptObject: "MySQLIdentifierComponent",
+			AcceptValue:  "",
+			EndStatus:    7,
+		},
+		{
+			StartStatus:  []int{7},
+			AcceptObject: "MySQLDelimiterToken",
+			AcceptValue:  ",",
+			EndStatus:    8,
+		},
+		{
+			StartStatus:  []int{6, 7, 15},
+			AcceptObject: "MySQLOperatorToken",
+			AcceptValue:  ")",
+			EndStatus:    FinalStatus,
+		},
+		{
+			StartStatus:  []int{0},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "IGNORE",
+			EndStatus:    9,
+		},
+		{
+			StartStatus:  []int{0},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "FORCE",
+			EndStatus:    9,
+		},
+		{
+			StartStatus:  []int{9},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "INDEX",
+			EndStatus:    10,
+		},
+		{
+			StartStatus:  []int{9},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "KEY",
+			EndStatus:    10,
+		},
+		{
+			StartStatus:  []int{10},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "FOR",
+			EndStatus:    11,
+		},
+		{
+			StartStatus:  []int{11},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "JOIN",
+			EndStatus:    13,
+		},
+		{
+			StartStatus:  []int{11},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "ORDER",
+			EndStatus:    12,
+		},
+		{
+			StartStatus:  []int{11},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "GROUP",
+			EndStatus:    12,
+		},
+		{
+			StartStatus:  []int{12},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "BY",
+			EndStatus:    13,
+		},
+		{
+			StartStatus:  []int{10, 13},
+			AcceptObject: "MySQLOperatorToken",
+			AcceptValue:  "(",
+			EndStatus:    14,
+		},
+		{
+			StartStatus:  []int{14, 16},
+			AcceptObject: "MySQLIdentifierComponent",
+			AcceptValue:  "",
+			EndStatus:    15,
+		},
+		{
+			StartStatus:  []int{15},
+			AcceptObject: "MySQLDelimiterToken",
+			AcceptValue:  ",",
+			EndStatus:    16,
+		},
+	}
+}
+
+func NewMySQLIndexHintOptionComponent(tokenList MySQLTokenList,
+	verboseFunc func(message string, level LogLevel)) (MySQLComponent, MySQLTokenList) {
+	startPos := tokenList.CurrentPos()
+	c := &MySQLIndexHintOptionComponent{
+		MySQLBaseComponent: &MySQLBaseComponent{
+			ObjectList: make([]*MySQLObject, 0),
+		},
+	}
+	endPos := c.ParseByFsm(c.GetFsmMap(), tokenList, []int{}, verboseFunc)
+	if endPos == -1 {
+		tokenList.Reset(startPos)
+		return nil, tokenList
+	} else {
+		tokenList.Reset(endPos)
+		return c, tokenList
+	}
+}
+
+// export_options:
+//    [{FIELDS | COLUMNS}
+//        [TERMINATED BY 'string']
+//        [[OPTIONALLY] ENCLOSED BY 'char']
+//        [ESCAPED BY 'char']
+//    ]
+//    [LINES
+//        [STARTING BY 'string']
+//        [TERMINATED BY 'string']
+//    ]
+
+type MySQLExportOptionComponent struct {
+	*MySQLBaseComponent
+}
+
+func (c *MySQLExportOptionComponent) Type() string {
+	return "MySQLExportOptionComponent"
+}
+
+func (c *MySQLExportOptionComponent) GetFsmMap() []FsmMap {
+	//TODO
+	return []FsmMap{}
+}
+
+func NewMySQLExportOptionComponent(tokenList MySQLTokenList,
+	verboseFunc func(message string, level LogLevel)) (MySQLComponent, MySQLTokenList) {
+	startPos := tokenList.CurrentPos()
+	c := &MySQLExportOptionComponent{
+		MySQLBaseComponent: &MySQLBaseComponent{
+			ObjectList: make([]*MySQLObject, 0),
+		},
+	}
+	endPos := c.ParseByFsm(c.GetFsmMap(), tokenList, []int{}, verboseFunc)
+	if endPos == -1 {
+		tokenList.Reset(startPos)
+		return nil, tokenList
+	} else {
+		tokenList.Reset(endPos)
+		return c, tokenList
+	}
+}
+
+// replication_option:
+//    option_name = {'string' | number | NULL | identifier}
+//  | IGNORE_SERVER_IDS = (server_id [, server_id] ...)
+
+type MySQLReplicationOptionComponent struct {
+	*MySQLBaseComponent
+	Name        string
+	OptionValue string
+	ValueList   []string
+}
+
+func (c *MySQLReplicationOptionComponent) Type() string {
+	return "MySQLReplicationOptionComponent"
+}
+
+func (c *MySQLReplicationOptionComponent) GetFsmMap() []FsmMap {
+	return []FsmMap{
+		{
+			StartStatus:  []int{0},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "MASTER_SSL_VERIFY_SERVER_CERT",
+			EndStatus:    1,
+		},
+		{
+			StartStatus:  []int{0},
+			AcceptObject: "MySQLIdentifierComponent",
+			AcceptValue:  "",
+			EndStatus:    1,
+		},
+		{
+			StartStatus:  []int{1},
+			AcceptObject: "MySQLOperatorToken",
+			AcceptValue:  "=",
+			EndStatus:    2,
+		},
+		{
+			StartStatus:  []int{2},
+			AcceptObject: "MySQLStringToken",
+			AcceptValue:  "",
+			EndStatus:    FinalStatus,
+		},
+		{
+			StartStatus:  []int{2},
+			AcceptObject: "MySQLNumericToken",
+			AcceptValue:  "",
+			EndStatus:    FinalStatus,
+		},
+		{
+			StartStatus:  []int{2},
+			AcceptObject: "MySQLNullToken",
+			AcceptValue:  "",
+			EndStatus:    FinalStatus,
+		},
+		{
+			StartStatus:  []int{2},
+			AcceptObject: "MySQLIdentifierComponent",
+			AcceptValue:  "",
+			EndStatus:    FinalStatus,
+		},
+		{
+			StartStatus:  []int{2},
+			AcceptObject: "MySQLOperatorToken",
+			AcceptValue:  "(",
+			EndStatus:    3,
+		},
+		{
+			StartStatus:  []int{3, 5},
+			AcceptObject: "MySQLNumericToken",
+			AcceptValue:  "",
+			EndStatus:    4,
+		},
+		{
+			StartStatus:  []int{4},
+			AcceptObject: "MySQLDelimiterToken",
+			AcceptValue:  ",",
+			EndStatus:    5,
+		},
+		{
+			StartStatus:  []int{3, 4},
+			AcceptObject: "MySQLOperatorToken",
+			AcceptValue:  ")",
+			EndStatus:    FinalStatus,
+		},
+	}
+}
+
+func NewMySQLReplicationOptionComponent(tokenList MySQLTokenList,
+	verboseFunc func(message string, level LogLevel)) (MySQLComponent, MySQLTokenList) {
+	startPos := tokenList.CurrentPos()
+	c := &MySQLReplicationOptionComponent{
+		MySQLBaseComponent: &MySQLBaseComponent{
+			ObjectList: make([]*MySQLObject, 0),
+		},
+		ValueList: make([]string, 0),
+	}
+	endPos := c.ParseByFsm(c.GetFsmMap(), tokenList, []int{}, verboseFunc)
+	if endPos == -1 {
+		tokenList.Reset(startPos)
+		return nil, tokenList
+	} else {
+		inList := false
+		for _, t := range c.ObjectList {
+			if c.Name == "" && ((*t).Type() == "MySQLIdentifierComponent" || (*t).Type() == "MySQLKeywordToken") {
+				c.Name = strings.ToUpper(strings.Trim((*t).Value(), "`"))
+			} else if (*t).Type() == "MySQLOperatorToken" && (*t).Value() == "(" {
+				inList = true
+			} else if (*t).Type() == "MySQLNumericToken" && inList {
+				c.ValueList = append(c.ValueList, (*t).Value())
+			} else if (*t).Type() == "MySQLStringToken" {
+				c.OptionValue = unquoteString((*t).Value())
+			} else if (*t).Type() == "MySQLNumericToken" || (*t).Type() == "MySQLNullToken" {
+				c.OptionValue = (*t).Value()
+			} else if (*t).Type() == "MySQLIdentifierComponent" {
+				c.OptionValue = strings.Trim((*t).Value(), "`")
+			}
+		}
+		if inList {
+			c.OptionValue = strings.Join(c.ValueList, ",")
+		}
+		tokenList.Reset(endPos)
+		return c, tokenList
+	}
+}
+
+// IsPassword 判断该选项是否为密码类选项
+func (c *MySQLReplicationOptionComponent) IsPassword() bool {
+	return InArray(c.Name, []string{"MASTER_PASSWORD", "SOURCE_PASSWORD", "PASSWORD"})
+}
+
+// RedactedValue 返回隐去密码后的原始文本
+func (c *MySQLReplicationOptionComponent) RedactedValue() string {
+	if !c.IsPassword() {
+		return c.Value()
+	}
+	tmpList := make([]string, len(c.ObjectList))
+	for index, t := range c.ObjectList {
+		if (*t).Type() == "MySQLStringToken" {
+			tmpList[index] = "'***'"
+		} else {
+			tmpList[index] = (*t).Value()
+		}
+	}
+	return strings.Join(tmpList, "")
+}
+
+// replication_filter:
+//    REPLICATE_DO_DB = (db_list)
+//  | REPLICATE_IGNORE_DB = (db_list)
+//  | REPLICATE_DO_TABLE = (tbl_list)
+//  | REPLICATE_IGNORE_TABLE = (tbl_list)
+//  | REPLICATE_WILD_DO_TABLE = (wild_tbl_list)
+//  | REPLICATE_WILD_IGNORE_TABLE = (wild_tbl_list)
+//  | REPLICATE_REWRITE_DB = (db_pair_list)
+
+type MySQLReplicationFilterComponent struct {
+	*MySQLBaseComponent
+	Name        string
+	ValueList   []string
+	RewriteList [][2]string
+}
+
+func (c *MySQLReplicationFilterComponent) Type() string {
+	return "MySQLReplicationFilterComponent"
+}
+
+func (c *MySQLReplicationFilterComponent) GetFsmMap() []FsmMap {
+	return []FsmMap{
+		{
+			StartStatus:  []int{0},
+			AcceptObject: "MySQLIdentifierComponent",
+			AcceptValue:  "",
+			EndStatus:    1,
+		},
+		{
+			StartStatus:  []int{1},
+			AcceptObject: "MySQLOperatorToken",
+			AcceptValue:  "=",
+			EndStatus:    2,
+		},
+		{
+			StartStatus:  []int{2},
+			AcceptObject: "MySQLOperatorToken",
+			AcceptValue:  "(",
+			EndStatus:    3,
+		},
+		{
+			StartStatus:  []int{3, 5},
+			AcceptObject: "MySQLOperatorToken",
+			AcceptValue:  "(",
+			EndStatus:    6,
+		},
+		{
+			StartStatus:  []int{3, 5},
+			AcceptObject: "MySQLStringToken",
+			AcceptValue:  "",
+			EndStatus:    4,
+		},
+		{
+			StartStatus:  []int{3, 5},
+			AcceptObject: "MySQLTableNameComponent",
+			AcceptValue:  "",
+			EndStatus:    4,
+		},
+		{
+			StartStatus:  []int{4},
+			AcceptObject: "MySQLDelimiterToken",
+			AcceptValue:  ",",
+			EndStatus:    5,
+		},
+		{
+			StartStatus:  []int{3, 4},
+			AcceptObject: "MySQLOperatorToken",
+			AcceptValue:  ")",
+			EndStatus:    FinalStatus,
+		},
+		{
+			StartStatus:  []int{6},
+			AcceptObject: "MySQLIdentifierComponent",
+			AcceptValue:  "",
+			EndStatus:    7,
+		},
+		{
+			StartStatus:  []int{7},
+			AcceptObject: "MySQLDelimiterToken",
+			AcceptValue:  ",",
+			EndStatus:    8,
+		},
+		{
+			StartStatus:  []int{8},
+			AcceptObject: "MySQLIdentifierComponent",
+			AcceptValue:  "",
+			EndStatus:    9,
+		},
+		{
+			StartStatus:  []int{9},
+			AcceptObject: "MySQLOperatorToken",
+			AcceptValue:  ")",
+			EndStatus:    4,
+		},
+	}
+}
+
+func NewMySQLReplicationFilterComponent(tokenList MySQLTokenList,
+	verboseFunc func(message string, level LogLevel)) (MySQLComponent, MySQLTokenList) {
+	startPos := tokenList.CurrentPos()
+	c := &MySQLReplicationFilterComponent{
+		MySQLBaseComponent: &MySQLBaseComponent{
+			ObjectList: make([]*MySQLObject, 0),
+		},
+		ValueList:   make([]string, 0),
+		RewriteList: make([][2]string, 0),
+	}
+	endPos := c.ParseByFsm(c.GetFsmMap(), tokenList, []int{}, verboseFunc)
+	if endPos == -1 {
+		tokenList.Reset(startPos)
+		return nil, tokenList
+	} else {
+		rewriteFrom := ""
+		for _, t := range c.ObjectList {
+			if (*t).Type() == "MySQLIdentifierComponent" && c.Name == "" {
+				c.Name = strings.ToUpper(strings.Trim((*t).Value(), "`"))
+			} else if (*t).Type() == "MySQLIdentifierComponent" && rewriteFrom == "" {
+				rewriteFrom = strings.Trim((*t).Value(), "`")
+			} else if (*t).Type() == "MySQLIdentifierComponent" {
+				c.RewriteList = append(c.RewriteList, [2]string{rewriteFrom, strings.Trim((*t).Value(), "`")})
+				rewriteFrom = ""
+			} else if (*t).Type() == "MySQLStringToken" {
+				c.ValueList = append(c.ValueList, unquoteString((*t).Value()))
+			} else if (*t).Type() == "MySQLTableNameComponent" {
+				tableName := (*t).(*MySQLTableNameComponent)
+				if tableName.Database != "" {
+					c.ValueList = append(c.ValueList, tableName.Database+"."+tableName.Table)
+				} else {
+					c.ValueList = append(c.ValueList, tableName.Table)
+				}
+			}
+		}
+		tokenList.Reset(endPos)
+		return c, tokenList
+	}
+}
+
+// xid: gtrid [, bqual [, formatID ]]
+
+type MySQLXidComponent struct {
 	*MySQLBaseComponent
+	Gtrid    string
+	Bqual    string
+	FormatId string
 }
 
-func (c *MySQLExportOptionComponent) Type() string {
-	return "MySQLExportOptionComponent"
+func (c *MySQLXidComponent) Type() string {
+	return "MySQLXidComponent"
 }
 
-func (c *MySQLExportOptionComponent) GetFsmMap() []FsmMap {
-	//TODO
-	return []FsmMap{}
+func (c *MySQLXidComponent) GetFsmMap() []FsmMap {
+	return []FsmMap{
+		{
+			StartStatus:  []int{0},
+			AcceptObject: "MySQLStringToken",
+			AcceptValue:  "",
+			EndStatus:    1,
+		},
+		{
+			StartStatus:  []int{0},
+			AcceptObject: "MySQLHexadecimalToken",
+			AcceptValue:  "",
+			EndStatus:    1,
+		},
+		{
+			StartStatus:  []int{1},
+			AcceptObject: "MySQLDelimiterToken",
+			AcceptValue:  ",",
+			EndStatus:    2,
+		},
+		{
+			StartStatus:  []int{2},
+			AcceptObject: "MySQLStringToken",
+			AcceptValue:  "",
+			EndStatus:    3,
+		},
+		{
+			StartStatus:  []int{2},
+			AcceptObject: "MySQLHexadecimalToken",
+			AcceptValue:  "",
+			EndStatus:    3,
+		},
+		{
+			StartStatus:  []int{3},
+			AcceptObject: "MySQLDelimiterToken",
+			AcceptValue:  ",",
+			EndStatus:    4,
+		},
+		{
+			StartStatus:  []int{4},
+			AcceptObject: "MySQLNumericToken",
+			AcceptValue:  "",
+			EndStatus:    FinalStatus,
+		},
+	}
+}
+
+func NewMySQLXidComponent(tokenList MySQLTokenList,
+	verboseFunc func(message string, level LogLevel)) (MySQLComponent, MySQLTokenList) {
+	startPos := tokenList.CurrentPos()
+	c := &MySQLXidComponent{
+		MySQLBaseComponent: &MySQLBaseComponent{
+			ObjectList: make([]*MySQLObject, 0),
+		},
+	}
+	endPos := c.ParseByFsm(c.GetFsmMap(), tokenList, []int{1, 3}, verboseFunc)
+	if endPos == -1 {
+		tokenList.Reset(startPos)
+		return nil, tokenList
+	} else {
+		index := 0
+		for _, t := range c.ObjectList {
+			value := ""
+			if (*t).Type() == "MySQLStringToken" {
+				value = unquoteString((*t).Value())
+			} else if (*t).Type() == "MySQLHexadecimalToken" {
+				value = unquoteHexadecimal((*t).Value())
+			} else if (*t).Type() == "MySQLNumericToken" {
+				value = (*t).Value()
+			} else {
+				continue
+			}
+			switch index {
+			case 0:
+				c.Gtrid = value
+			case 1:
+				c.Bqual = value
+			case 2:
+				c.FormatId = value
+			}
+			index++
+		}
+		tokenList.Reset(endPos)
+		return c, tokenList
+	}
+}
+
+// variable_assignment:
+//      user_var_name = expr
+//    | param_name = expr
+//    | local_var_name = expr
+//    | {GLOBAL | @@GLOBAL.} system_var_name = expr
+//    | {PERSIST | @@PERSIST.} system_var_name = expr
+//    | {PERSIST_ONLY | @@PERSIST_ONLY.} system_var_name = expr
+//    | [SESSION | @@SESSION. | @@] system_var_name = expr
+//    | NAMES {'charset_name' [COLLATE 'collation_name'] | DEFAULT}
+//    | {CHARACTER SET | CHARSET} {'charset_name' | DEFAULT}
+//
+// NAMES及CHARACTER SET的Name为NAMES或CHARACTER SET，ValueText为字符集名或DEFAULT
+
+type MySQLVariableAssignmentComponent struct {
+	*MySQLBaseComponent
+	Scope      VariableScope
+	Name       string
+	Expression *MySQLExpressionComponent
+	ValueText  string
+	Collation  string
+}
+
+func (c *MySQLVariableAssignmentComponent) Type() string {
+	return "MySQLVariableAssignmentComponent"
+}
+
+func (c *MySQLVariableAssignmentComponent) GetFsmMap() []FsmMap {
+	return []FsmMap{
+		{
+			StartStatus:  []int{0},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "NAMES",
+			EndStatus:    4,
+		},
+		{
+			StartStatus:  []int{4},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "DEFAULT",
+			EndStatus:    FinalStatus,
+		},
+		{
+			StartStatus:  []int{4},
+			AcceptObject: "MySQLStringToken",
+			AcceptValue:  "",
+			EndStatus:    5,
+		},
+		{
+			StartStatus:  []int{4},
+			AcceptObject: "MySQLCharsetNameComponent",
+			AcceptValue:  "",
+			EndStatus:    5,
+		},
+		{
+			StartStatus:  []int{5},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "COLLATE",
+			EndStatus:    6,
+		},
+		{
+			StartStatus:  []int{6},
+			AcceptObject: "MySQLStringToken",
+			AcceptValue:  "",
+			EndStatus:    FinalStatus,
+		},
+		{
+			StartStatus:  []int{6},
+			AcceptObject: "MySQLCollationNameComponent",
+			AcceptValue:  "",
+			EndStatus:    FinalStatus,
+		},
+		{
+			StartStatus:  []int{0},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "CHARACTER",
+			EndStatus:    7,
+		},
+		{
+			StartStatus:  []int{7},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "SET",
+			EndStatus:    8,
+		},
+		{
+			StartStatus:  []int{0},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "CHARSET",
+			EndStatus:    8,
+		},
+		{
+			StartStatus:  []int{8},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "DEFAULT",
+			EndStatus:    FinalStatus,
+		},
+		{
+			StartStatus:  []int{8},
+			AcceptObject: "MySQLStringToken",
+			AcceptValue:  "",
+			EndStatus:    FinalStatus,
+		},
+		{
+			StartStatus:  []int{8},
+			AcceptObject: "MySQLCharsetNameComponent",
+			AcceptValue:  "",
+			EndStatus:    FinalStatus,
+		},
+		{
+			StartStatus:  []int{0},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "GLOBAL",
+			EndStatus:    1,
+		},
+		{
+			StartStatus:  []int{0},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "SESSION",
+			EndStatus:    1,
+		},
+		{
+			StartStatus:  []int{0},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "LOCAL",
+			EndStatus:    1,
+		},
+		{
+			StartStatus:  []int{0},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "PERSIST",
+			EndStatus:    1,
+		},
+		{
+			StartStatus:  []int{0},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "PERSIST_ONLY",
+			EndStatus:    1,
+		},
+		{
+			StartStatus:  []int{0},
+			AcceptObject: "MySQLVariableToken",
+			AcceptValue:  "",
+			EndStatus:    2,
+		},
+		{
+			StartStatus:  []int{0, 1},
+			AcceptObject: "MySQLUnquotedIdentifierToken",
+			AcceptValue:  "",
+			EndStatus:    2,
+		},
+		{
+			StartStatus:  []int{0, 1},
+			AcceptObject: "MySQLQuotedIdentifierToken",
+			AcceptValue:  "",
+			EndStatus:    2,
+		},
+		{
+			StartStatus:  []int{0, 1},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "",
+			EndStatus:    2,
+		},
+		{
+			StartStatus:  []int{2},
+			AcceptObject: "MySQLOperatorToken",
+			AcceptValue:  "=",
+			EndStatus:    3,
+		},
+		{
+			StartStatus:  []int{2},
+			AcceptObject: "MySQLOperatorToken",
+			AcceptValue:  ":=",
+			EndStatus:    3,
+		},
+		{
+			StartStatus:  []int{3},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "ON",
+			EndStatus:    FinalStatus,
+		},
+		{
+			StartStatus:  []int{3},
+			AcceptObject: "MySQLExpressionComponent",
+			AcceptValue:  "",
+			EndStatus:    FinalStatus,
+		},
+	}
 }
 
-func NewMySQLExportOptionComponent(tokenList MySQLTokenList,
+func NewMySQLVariableAssignmentComponent(tokenList MySQLTokenList,
 	verboseFunc func(message string, level LogLevel)) (MySQLComponent, MySQLTokenList) {
 	startPos := tokenList.CurrentPos()
-	c := &MySQLExportOptionComponent{
+	c := &MySQLVariableAssignmentComponent{
 		MySQLBaseComponent: &MySQLBaseComponent{
 			ObjectList: make([]*MySQLObject, 0),
 		},
 	}
-	endPos := c.ParseByFsm(c.GetFsmMap(), tokenList, []int{}, verboseFunc)
+	endPos := c.ParseByFsm(c.GetFsmMap(), tokenList, []int{5}, verboseFunc)
 	if endPos == -1 {
 		tokenList.Reset(startPos)
 		return nil, tokenList
+	} else if validList := getValidObjectList(c.ObjectList); isKeywordToken(validList[0], "NAMES", "CHARACTER", "CHARSET") {
+		c.Name = "CHARACTER SET"
+		if (*validList[0]).Value() == "NAMES" {
+			c.Name = "NAMES"
+		}
+		lastKeyword := ""
+		for _, t := range validList {
+			if isKeywordToken(t, "DEFAULT") {
+				c.ValueText = "DEFAULT"
+			} else if (*t).Type() == "MySQLKeywordToken" {
+				lastKeyword = (*t).Value()
+			} else if lastKeyword == "COLLATE" {
+				c.Collation = getSetCharsetValue(t)
+			} else {
+				c.ValueText = getSetCharsetValue(t)
+			}
+		}
+		tokenList.Reset(endPos)
+		return c, tokenList
 	} else {
+		assigned := false
+		for _, t := range c.ObjectList {
+			if (*t).Type() == "MySQLOperatorToken" && InArray((*t).Value(), []string{"=", ":="}) {
+				assigned = true
+			} else if assigned && (*t).Type() == "MySQLKeywordToken" {
+				c.ValueText = (*t).Value()
+			} else if (*t).Type() == "MySQLExpressionComponent" {
+				c.Expression = (*t).(*MySQLExpressionComponent)
+				c.ValueText = strings.TrimSpace((*t).Value())
+			} else if (*t).Type() == "MySQLKeywordToken" && c.Scope == VariableScopeDefault &&
+				InArray((*t).Value(), []string{"GLOBAL", "SESSION", "LOCAL", "PERSIST", "PERSIST_ONLY"}) {
+				c.Scope = getVariableScope((*t).Value())
+			} else if (*t).Type() == "MySQLVariableToken" {
+				c.Scope, c.Name = parseVariableName((*t).Value())
+			} else if (*t).Type() == "MySQLQuotedIdentifierToken" {
+				c.Name = strings.Trim((*t).Value(), "`")
+			} else if (*t).Type() == "MySQLUnquotedIdentifierToken" || (*t).Type() == "MySQLKeywordToken" {
+				c.Name = (*t).Value()
+			}
+		}
 		tokenList.Reset(endPos)
 		return c, tokenList
 	}
 }
 
+// getVariableScope 将作用域关键字转换为VariableScope
+func getVariableScope(keyword string) VariableScope {
+	switch strings.ToUpper(keyword) {
+	case "GLOBAL":
+		return VariableScopeGlobal
+	case "SESSION", "LOCAL":
+		return VariableScopeSession
+	case "PERSIST":
+		return VariableScopePersist
+	case "PERSIST_ONLY":
+		return VariableScopePersistOnly
+	}
+	return VariableScopeDefault
+}
+
+// parseVariableName 解析@var与@@[scope.]var形式的变量, 返回作用域与变量名
+func parseVariableName(variable string) (VariableScope, string) {
+	if !strings.HasPrefix(variable, "@@") {
+		name := variable[1:]
+		if len(name) >= 2 && (name[0] == '\'' || name[0] == '"') {
+			name = unquoteString(name)
+		} else {
+			name = strings.Trim(name, "`")
+		}
+		return VariableScopeUser, name
+	}
+	name := variable[2:]
+	if pos := strings.Index(name, "."); pos != -1 {
+		return getVariableScope(name[:pos]), name[pos+1:]
+	}
+	return VariableScopeSession, name
+}
+
 type SubQueryComponent struct {
 	*MySQLBaseComponent
 	DatabaseList []string
@@ -4230,54 +6024,351 @@ func (c *SubQueryComponent) Type() string {
 func (c *SubQueryComponent) GetFsmMap() []FsmMap {
 	return []FsmMap{
 		{
-			StartStatus:  []int{0},
+			StartStatus:  []int{0},
+			AcceptObject: "MySQLOperatorToken",
+			AcceptValue:  "(",
+			EndStatus:    1,
+		},
+		{
+			StartStatus:  []int{1},
+			AcceptObject: "UnionStatement",
+			AcceptValue:  "",
+			EndStatus:    2,
+		},
+		{
+			StartStatus:  []int{1},
+			AcceptObject: "SelectStatement",
+			AcceptValue:  "",
+			EndStatus:    2,
+		},
+		{
+			StartStatus:  []int{2},
+			AcceptObject: "MySQLOperatorToken",
+			AcceptValue:  ")",
+			EndStatus:    FinalStatus,
+		},
+	}
+}
+
+func NewSubQueryComponent(tokenList MySQLTokenList,
+	verboseFunc func(message string, level LogLevel)) (MySQLComponent, MySQLTokenList) {
+	startPos := tokenList.CurrentPos()
+	c := &SubQueryComponent{
+		MySQLBaseComponent: &MySQLBaseComponent{
+			ObjectList: make([]*MySQLObject, 0),
+		},
+		DatabaseList: make([]string, 0),
+		TableList:    make([]string, 0),
+	}
+	endPos := c.ParseByFsm(c.GetFsmMap(), tokenList, []int{}, verboseFunc)
+	if endPos == -1 {
+		tokenList.Reset(startPos)
+		return nil, tokenList
+	} else {
+		for _, t := range c.ObjectList {
+			if (*t).Type() == "SelectStatement" {
+				c.DatabaseList = append(c.DatabaseList, (*t).(*SelectStatement).DatabaseList...)
+				c.TableList = append(c.TableList, (*t).(*SelectStatement).TableList...)
+			} else if (*t).Type() == "UnionStatement" {
+				c.DatabaseList = append(c.DatabaseList, (*t).(*UnionStatement).DatabaseList...)
+				c.TableList = append(c.TableList, (*t).(*UnionStatement).TableList...)
+			}
+		}
+		tokenList.Reset(endPos)
+		return c, tokenList
+	}
+}
+
+// JSON_TABLE(expr, path COLUMNS (column_list)) [AS] alias
+//
+// column_list:
+//    column[, column][, ...]
+
+type MySQLJsonTableComponent struct {
+	*MySQLBaseComponent
+	Path       string
+	ColumnList []*MySQLJsonTableColumnComponent
+}
+
+func (c *MySQLJsonTableComponent) Type() string {
+	return "MySQLJsonTableComponent"
+}
+
+func (c *MySQLJsonTableComponent) GetFsmMap() []FsmMap {
+	return []FsmMap{
+		{
+			StartStatus:  []int{0},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "JSON_TABLE",
+			EndStatus:    1,
+		},
+		{
+			StartStatus:  []int{1},
+			AcceptObject: "MySQLOperatorToken",
+			AcceptValue:  "(",
+			EndStatus:    2,
+		},
+		{
+			StartStatus:  []int{2},
+			AcceptObject: "MySQLExpressionComponent",
+			AcceptValue:  "",
+			EndStatus:    3,
+		},
+		{
+			StartStatus:  []int{3},
+			AcceptObject: "MySQLDelimiterToken",
+			AcceptValue:  ",",
+			EndStatus:    4,
+		},
+		{
+			StartStatus:  []int{4},
+			AcceptObject: "MySQLStringToken",
+			AcceptValue:  "",
+			EndStatus:    5,
+		},
+		{
+			StartStatus:  []int{5},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "COLUMNS",
+			EndStatus:    6,
+		},
+		{
+			StartStatus:  []int{6},
+			AcceptObject: "MySQLOperatorToken",
+			AcceptValue:  "(",
+			EndStatus:    7,
+		},
+		{
+			StartStatus:  []int{7},
+			AcceptObject: "MySQLJsonTableColumnComponent",
+			AcceptValue:  "",
+			EndStatus:    8,
+		},
+		{
+			StartStatus:  []int{8},
+			AcceptObject: "MySQLDelimiterToken",
+			AcceptValue:  ",",
+			EndStatus:    7,
+		},
+		{
+			StartStatus:  []int{8},
+			AcceptObject: "MySQLOperatorToken",
+			AcceptValue:  ")",
+			EndStatus:    9,
+		},
+		{
+			StartStatus:  []int{9},
+			AcceptObject: "MySQLOperatorToken",
+			AcceptValue:  ")",
+			EndStatus:    FinalStatus,
+		},
+	}
+}
+
+func NewMySQLJsonTableComponent(tokenList MySQLTokenList,
+	verboseFunc func(message string, level LogLevel)) (MySQLComponent, MySQLTokenList) {
+	startPos := tokenList.CurrentPos()
+	c := &MySQLJsonTableComponent{
+		MySQLBaseComponent: &MySQLBaseComponent{
+			ObjectList: make([]*MySQLObject, 0),
+		},
+		ColumnList: make([]*MySQLJsonTableColumnComponent, 0),
+	}
+	endPos := c.ParseByFsm(c.GetFsmMap(), tokenList, []int{}, verboseFunc)
+	if endPos == -1 {
+		tokenList.Reset(startPos)
+		return nil, tokenList
+	} else {
+		for _, t := range c.ObjectList {
+			if (*t).Type() == "MySQLStringToken" {
+				c.Path = (*t).Value()
+			} else if (*t).Type() == "MySQLJsonTableColumnComponent" {
+				c.ColumnList = append(c.ColumnList, (*t).(*MySQLJsonTableColumnComponent))
+			}
+		}
+		tokenList.Reset(endPos)
+		return c, tokenList
+	}
+}
+
+// column:
+//    name FOR ORDINALITY
+//  | name type PATH string path [on_empty] [on_error]
+//  | name type EXISTS PATH string path
+//  | NESTED [PATH] path COLUMNS (column_list)
+//
+// on_empty:
+//    {NULL | DEFAULT json_string | ERROR} ON EMPTY
+//
+// on_error:
+//    {NULL | DEFAULT json_string | ERROR} ON ERROR
+
+type MySQLJsonTableColumnComponent struct {
+	*MySQLBaseComponent
+	Name       string
+	ColumnList []*MySQLJsonTableColumnComponent
+}
+
+func (c *MySQLJsonTableColumnComponent) Type() string {
+	return "MySQLJsonTableColumnComponent"
+}
+
+func (c *MySQLJsonTableColumnComponent) GetFsmMap() []FsmMap {
+	return []FsmMap{
+		{
+			StartStatus:  []int{0},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "NESTED",
+			EndStatus:    11,
+		},
+		{
+			StartStatus:  []int{11},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "PATH",
+			EndStatus:    12,
+		},
+		{
+			StartStatus:  []int{11, 12},
+			AcceptObject: "MySQLStringToken",
+			AcceptValue:  "",
+			EndStatus:    13,
+		},
+		{
+			StartStatus:  []int{13},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "COLUMNS",
+			EndStatus:    14,
+		},
+		{
+			StartStatus:  []int{14},
+			AcceptObject: "MySQLOperatorToken",
+			AcceptValue:  "(",
+			EndStatus:    15,
+		},
+		{
+			StartStatus:  []int{15},
+			AcceptObject: "MySQLJsonTableColumnComponent",
+			AcceptValue:  "",
+			EndStatus:    16,
+		},
+		{
+			StartStatus:  []int{16},
+			AcceptObject: "MySQLDelimiterToken",
+			AcceptValue:  ",",
+			EndStatus:    15,
+		},
+		{
+			StartStatus:  []int{16},
 			AcceptObject: "MySQLOperatorToken",
-			AcceptValue:  "(",
-			EndStatus:    1,
+			AcceptValue:  ")",
+			EndStatus:    FinalStatus,
 		},
 		{
-			StartStatus:  []int{1},
-			AcceptObject: "UnionStatement",
+			StartStatus:  []int{0},
+			AcceptObject: "MySQLIdentifierComponent",
 			AcceptValue:  "",
-			EndStatus:    2,
+			EndStatus:    1,
 		},
 		{
 			StartStatus:  []int{1},
-			AcceptObject: "SelectStatement",
-			AcceptValue:  "",
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "FOR",
 			EndStatus:    2,
 		},
 		{
 			StartStatus:  []int{2},
-			AcceptObject: "MySQLOperatorToken",
-			AcceptValue:  ")",
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "ORDINALITY",
 			EndStatus:    FinalStatus,
 		},
+		{
+			StartStatus:  []int{1},
+			AcceptObject: "MySQLDataTypeComponent",
+			AcceptValue:  "",
+			EndStatus:    3,
+		},
+		{
+			StartStatus:  []int{3},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "EXISTS",
+			EndStatus:    4,
+		},
+		{
+			StartStatus:  []int{3, 4},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "PATH",
+			EndStatus:    5,
+		},
+		{
+			StartStatus:  []int{5},
+			AcceptObject: "MySQLStringToken",
+			AcceptValue:  "",
+			EndStatus:    6,
+		},
+		{
+			StartStatus:  []int{6, 8},
+			AcceptObject: "MySQLNullToken",
+			AcceptValue:  "",
+			EndStatus:    7,
+		},
+		{
+			StartStatus:  []int{6, 8},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "ERROR",
+			EndStatus:    7,
+		},
+		{
+			StartStatus:  []int{6, 8},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "DEFAULT",
+			EndStatus:    9,
+		},
+		{
+			StartStatus:  []int{9},
+			AcceptObject: "MySQLStringToken",
+			AcceptValue:  "",
+			EndStatus:    7,
+		},
+		{
+			StartStatus:  []int{7},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "ON",
+			EndStatus:    10,
+		},
+		{
+			StartStatus:  []int{10},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "EMPTY",
+			EndStatus:    8,
+		},
+		{
+			StartStatus:  []int{10},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "ERROR",
+			EndStatus:    8,
+		},
 	}
 }
 
-func NewSubQueryComponent(tokenList MySQLTokenList,
+func NewMySQLJsonTableColumnComponent(tokenList MySQLTokenList,
 	verboseFunc func(message string, level LogLevel)) (MySQLComponent, MySQLTokenList) {
 	startPos := tokenList.CurrentPos()
-	c := &SubQueryComponent{
+	c := &MySQLJsonTableColumnComponent{
 		MySQLBaseComponent: &MySQLBaseComponent{
 			ObjectList: make([]*MySQLObject, 0),
 		},
-		DatabaseList: make([]string, 0),
-		TableList:    make([]string, 0),
+		ColumnList: make([]*MySQLJsonTableColumnComponent, 0),
 	}
-	endPos := c.ParseByFsm(c.GetFsmMap(), tokenList, []int{}, verboseFunc)
+	endPos := c.ParseByFsm(c.GetFsmMap(), tokenList, []int{6, 8}, verboseFunc)
 	if endPos == -1 {
 		tokenList.Reset(startPos)
 		return nil, tokenList
 	} else {
 		for _, t := range c.ObjectList {
-			if (*t).Type() == "SelectStatement" {
-				c.DatabaseList = append(c.DatabaseList, (*t).(*SelectStatement).DatabaseList...)
-				c.TableList = append(c.TableList, (*t).(*SelectStatement).TableList...)
-			} else if (*t).Type() == "UnionStatement" {
-				c.DatabaseList = append(c.DatabaseList, (*t).(*UnionStatement).DatabaseList...)
-				c.TableList = append(c.TableList, (*t).(*UnionStatement).TableList...)
+			if (*t).Type() == "MySQLIdentifierComponent" {
+				c.Name = strings.Trim((*t).Value(), "`")
+			} else if (*t).Type() == "MySQLJsonTableColumnComponent" {
+				c.ColumnList = append(c.ColumnList, (*t).(*MySQLJsonTableColumnComponent))
 			}
 		}
 		tokenList.Reset(endPos)
@@ -4288,12 +6379,15 @@ func NewSubQueryComponent(tokenList MySQLTokenList,
 // table_factor:
 //    tbl_name [[AS] alias] [index_hint_list]
 //  | table_subquery [AS] alias
+//  | JSON_TABLE(expr, path COLUMNS (column_list)) [AS] alias
 //  | ( table_references )
 
 type TableFactorComponent struct {
 	*MySQLBaseComponent
 	DatabaseList []string
 	TableList    []string
+	TableName    *MySQLTableNameComponent
+	Alias        string
 }
 
 func (c *TableFactorComponent) Type() string {
@@ -4338,6 +6432,12 @@ func (c *TableFactorComponent) GetFsmMap() []FsmMap {
 			AcceptValue:  "",
 			EndStatus:    6,
 		},
+		{
+			StartStatus:  []int{0},
+			AcceptObject: "MySQLJsonTableComponent",
+			AcceptValue:  "",
+			EndStatus:    6,
+		},
 		{
 			StartStatus:  []int{6},
 			AcceptObject: "MySQLKeywordToken",
@@ -4391,8 +6491,11 @@ func NewTableFactorComponent(tokenList MySQLTokenList,
 				c.DatabaseList = append(c.DatabaseList, (*t).(*SubQueryComponent).DatabaseList...)
 				c.TableList = append(c.TableList, (*t).(*SubQueryComponent).TableList...)
 			} else if (*t).Type() == "MySQLTableNameComponent" {
-				c.DatabaseList = append(c.DatabaseList, (*t).(*MySQLTableNameComponent).Database)
-				c.TableList = append(c.TableList, (*t).(*MySQLTableNameComponent).Table)
+				c.TableName = (*t).(*MySQLTableNameComponent)
+				c.DatabaseList = append(c.DatabaseList, c.TableName.Database)
+				c.TableList = append(c.TableList, c.TableName.Table)
+			} else if (*t).Type() == "MySQLIdentifierComponent" {
+				c.Alias = strings.Trim((*t).Value(), "`")
 			}
 		}
 		tokenList.Reset(endPos)
@@ -4673,6 +6776,84 @@ func NewTableReferenceListComponent(tokenList MySQLTokenList,
 	}
 }
 
+// getTableFactorList 获取对象列表中的表因子，withSubQuery为true时包含子查询中的表因子
+func getTableFactorList(objectList []*MySQLObject, withSubQuery bool) []*TableFactorComponent {
+	factorList := make([]*TableFactorComponent, 0)
+	for _, t := range objectList {
+		if (*t).Type() == "TableFactorComponent" {
+			factorList = append(factorList, (*t).(*TableFactorComponent))
+			factorList = append(factorList, getTableFactorList((*t).(*TableFactorComponent).ObjectList, withSubQuery)...)
+		} else if (*t).Type() == "TableReferenceComponent" {
+			factorList = append(factorList, getTableFactorList((*t).(*TableReferenceComponent).ObjectList, withSubQuery)...)
+		} else if (*t).Type() == "TableReferenceListComponent" {
+			factorList = append(factorList, getTableFactorList((*t).(*TableReferenceListComponent).ObjectList, withSubQuery)...)
+		} else if (*t).Type() == "MySQLExpressionComponent" {
+			factorList = append(factorList, getTableFactorList((*t).(*MySQLExpressionComponent).ObjectList, withSubQuery)...)
+		} else if (*t).Type() == "MySQLAssignmentListExpressionComponent" {
+			factorList = append(factorList, getTableFactorList((*t).(*MySQLAssignmentListExpressionComponent).ObjectList, withSubQuery)...)
+		} else if (*t).Type() == "MySQLAssignmentExpressionComponent" {
+			factorList = append(factorList, getTableFactorList((*t).(*MySQLAssignmentExpressionComponent).ObjectList, withSubQuery)...)
+		} else if (*t).Type() == "SubQueryComponent" && withSubQuery {
+			factorList = append(factorList, getTableFactorList((*t).(*SubQueryComponent).ObjectList, withSubQuery)...)
+		} else if (*t).Type() == "SelectStatement" {
+			factorList = append(factorList, getTableFactorList((*t).(*SelectStatement).ObjectList, withSubQuery)...)
+		} else if (*t).Type() == "UnionStatement" {
+			factorList = append(factorList, getTableFactorList((*t).(*UnionStatement).ObjectList, withSubQuery)...)
+		}
+	}
+	return factorList
+}
+
+// findTableFactor 根据别名或库表名查找对应的表因子
+func findTableFactor(factorList []*TableFactorComponent, database string, table string) *TableFactorComponent {
+	if database == "" {
+		for _, factor := range factorList {
+			if factor.Alias != "" && factor.Alias == table {
+				return factor
+			}
+		}
+	}
+	for _, factor := range factorList {
+		if factor.TableName != nil && factor.TableName.Table == table &&
+			(database == "" || factor.TableName.Database == database) {
+			return factor
+		}
+	}
+	return nil
+}
+
+// appendTableName 将表名组件追加到列表中，已存在时忽略
+func appendTableName(tableList []*MySQLTableNameComponent, tableName *MySQLTableNameComponent) []*MySQLTableNameComponent {
+	for _, t := range tableList {
+		if t == tableName {
+			return tableList
+		}
+	}
+	return append(tableList, tableName)
+}
+
+// getReadTableList 获取表因子中未被修改的表
+func getReadTableList(factorList []*TableFactorComponent,
+	targetList []*MySQLTableNameComponent) []*MySQLTableNameComponent {
+	readList := make([]*MySQLTableNameComponent, 0)
+	for _, factor := range factorList {
+		if factor.TableName == nil {
+			continue
+		}
+		isTarget := false
+		for _, t := range targetList {
+			if t == factor.TableName {
+				isTarget = true
+				break
+			}
+		}
+		if !isTarget {
+			readList = appendTableName(readList, factor.TableName)
+		}
+	}
+	return readList
+}
+
 // alter_specification:
 //   table_options
 // | ADD [COLUMN] col_name column_definition
@@ -4692,7 +6873,11 @@ func NewTableReferenceListComponent(tokenList MySQLTokenList,
 // | ADD [CONSTRAINT [symbol]]
 //       FOREIGN KEY [index_name] (index_col_name,...)
 //       reference_definition
-// | ALTER [COLUMN] col_name {SET DEFAULT literal | DROP DEFAULT}
+// | ADD [CONSTRAINT [symbol]] CHECK (expr) [[NOT] ENFORCED]
+// | ALTER {CHECK | CONSTRAINT} symbol [NOT] ENFORCED
+// | ALTER [COLUMN] col_name
+//       {SET DEFAULT literal | DROP DEFAULT | SET {VISIBLE | INVISIBLE}}
+// | ALTER INDEX index_name {VISIBLE | INVISIBLE}
 // | CHANGE [COLUMN] old_col_name new_col_name column_definition
 //       [FIRST|AFTER col_name]
 // | [DEFAULT] CHARACTER SET [=] charset_name [COLLATE [=] collation_name]
@@ -4703,10 +6888,13 @@ func NewTableReferenceListComponent(tokenList MySQLTokenList,
 // | DROP {INDEX|KEY} index_name
 // | DROP PRIMARY KEY
 // | DROP FOREIGN KEY fk_symbol
+// | DROP {CHECK | CONSTRAINT} symbol
 // | FORCE
 // | MODIFY [COLUMN] col_name column_definition
 //       [FIRST | AFTER col_name]
 // | ORDER BY col_name [, col_name] ...
+// | RENAME COLUMN old_col_name TO new_col_name
+// | RENAME {INDEX|KEY} old_index_name TO new_index_name
 // | RENAME [TO|AS] new_tbl_name
 // | ADD PARTITION (partition_definition)
 // | DROP PARTITION partition_names
@@ -4722,6 +6910,31 @@ func NewTableReferenceListComponent(tokenList MySQLTokenList,
 
 type MySQLAlterTableSpecificationComponent struct {
 	*MySQLBaseComponent
+	Action             AlterTableAction
+	ColumnName         string
+	NewColumnName      string
+	ColumnDefinition   *MySQLColumnDefinitionComponent
+	ColumnList         []string
+	DefinitionList     []*MySQLColumnDefinitionComponent
+	First              bool
+	After              string
+	ConstraintName     string
+	IndexKind          IndexKind
+	IndexName          string
+	NewIndexName       string
+	KeyPartList        []*MySQLIndexColumnNameComponent
+	Reference          *MySQLReferenceDefinitionComponent
+	Check              *MySQLCheckConstraintComponent
+	Default            string
+	DropDefault        bool
+	Invisible          bool
+	Enforced           bool
+	NewDatabase        string
+	NewTable           string
+	Charset            string
+	Collation          string
+	TableOptionList    *MySQLTableOptionListComponent
+	RemovePartitioning bool
 }
 
 func (c *MySQLAlterTableSpecificationComponent) Type() string {
@@ -4862,6 +7075,12 @@ func (c *MySQLAlterTableSpecificationComponent) GetFsmMap() []FsmMap {
 			AcceptValue:  "",
 			EndStatus:    16,
 		},
+		{
+			StartStatus:  []int{1},
+			AcceptObject: "MySQLCheckConstraintComponent",
+			AcceptValue:  "",
+			EndStatus:    FinalStatus,
+		},
 		{
 			StartStatus:  []int{1},
 			AcceptObject: "MySQLKeywordToken",
@@ -5006,6 +7225,12 @@ func (c *MySQLAlterTableSpecificationComponent) GetFsmMap() []FsmMap {
 			AcceptValue:  "",
 			EndStatus:    FinalStatus,
 		},
+		{
+			StartStatus:  []int{34},
+			AcceptObject: "MySQLNumericToken",
+			AcceptValue:  "",
+			EndStatus:    FinalStatus,
+		},
 		{
 			StartStatus:  []int{32},
 			AcceptObject: "MySQLKeywordToken",
@@ -5018,6 +7243,72 @@ func (c *MySQLAlterTableSpecificationComponent) GetFsmMap() []FsmMap {
 			AcceptValue:  "DEFAULT",
 			EndStatus:    FinalStatus,
 		},
+		{
+			StartStatus:  []int{33},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "VISIBLE",
+			EndStatus:    FinalStatus,
+		},
+		{
+			StartStatus:  []int{33},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "INVISIBLE",
+			EndStatus:    FinalStatus,
+		},
+		{
+			StartStatus:  []int{30},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "INDEX",
+			EndStatus:    82,
+		},
+		{
+			StartStatus:  []int{82},
+			AcceptObject: "MySQLIdentifierComponent",
+			AcceptValue:  "",
+			EndStatus:    83,
+		},
+		{
+			StartStatus:  []int{83},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "VISIBLE",
+			EndStatus:    FinalStatus,
+		},
+		{
+			StartStatus:  []int{83},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "INVISIBLE",
+			EndStatus:    FinalStatus,
+		},
+		{
+			StartStatus:  []int{30},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "CHECK",
+			EndStatus:    84,
+		},
+		{
+			StartStatus:  []int{30},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "CONSTRAINT",
+			EndStatus:    84,
+		},
+		{
+			StartStatus:  []int{84},
+			AcceptObject: "MySQLIdentifierComponent",
+			AcceptValue:  "",
+			EndStatus:    85,
+		},
+		{
+			StartStatus:  []int{85},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "NOT",
+			EndStatus:    86,
+		},
+		{
+			StartStatus:  []int{85, 86},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "ENFORCED",
+			EndStatus:    FinalStatus,
+		},
 		{
 			StartStatus:  []int{0},
 			AcceptObject: "MySQLKeywordToken",
@@ -5234,6 +7525,18 @@ func (c *MySQLAlterTableSpecificationComponent) GetFsmMap() []FsmMap {
 			AcceptValue:  "KEY",
 			EndStatus:    55,
 		},
+		{
+			StartStatus:  []int{53},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "CHECK",
+			EndStatus:    55,
+		},
+		{
+			StartStatus:  []int{53},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "CONSTRAINT",
+			EndStatus:    55,
+		},
 		{
 			StartStatus:  []int{0},
 			AcceptObject: "MySQLKeywordToken",
@@ -5282,6 +7585,60 @@ func (c *MySQLAlterTableSpecificationComponent) GetFsmMap() []FsmMap {
 			AcceptValue:  "RENAME",
 			EndStatus:    63,
 		},
+		{
+			StartStatus:  []int{63},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "COLUMN",
+			EndStatus:    87,
+		},
+		{
+			StartStatus:  []int{87},
+			AcceptObject: "MySQLColumnNameComponent",
+			AcceptValue:  "",
+			EndStatus:    88,
+		},
+		{
+			StartStatus:  []int{88},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "TO",
+			EndStatus:    89,
+		},
+		{
+			StartStatus:  []int{89},
+			AcceptObject: "MySQLColumnNameComponent",
+			AcceptValue:  "",
+			EndStatus:    FinalStatus,
+		},
+		{
+			StartStatus:  []int{63},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "INDEX",
+			EndStatus:    90,
+		},
+		{
+			StartStatus:  []int{63},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "KEY",
+			EndStatus:    90,
+		},
+		{
+			StartStatus:  []int{90},
+			AcceptObject: "MySQLIdentifierComponent",
+			AcceptValue:  "",
+			EndStatus:    91,
+		},
+		{
+			StartStatus:  []int{91},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "TO",
+			EndStatus:    92,
+		},
+		{
+			StartStatus:  []int{92},
+			AcceptObject: "MySQLIdentifierComponent",
+			AcceptValue:  "",
+			EndStatus:    FinalStatus,
+		},
 		{
 			StartStatus:  []int{63},
 			AcceptObject: "MySQLKeywordToken",
@@ -5460,13 +7817,179 @@ func NewMySQLAlterTableSpecificationComponent(tokenList MySQLTokenList,
 		MySQLBaseComponent: &MySQLBaseComponent{
 			ObjectList: make([]*MySQLObject, 0),
 		},
+		ColumnList:     make([]string, 0),
+		DefinitionList: make([]*MySQLColumnDefinitionComponent, 0),
+		KeyPartList:    make([]*MySQLIndexColumnNameComponent, 0),
+		Enforced:       true,
 	}
 	endPos := c.ParseByFsm(c.GetFsmMap(), tokenList, []int{4, 16, 42, 49, 62, 68, 70, 76}, verboseFunc)
 	if endPos == -1 {
 		tokenList.Reset(startPos)
 		return nil, tokenList
 	} else {
+		c.Action = getAlterTableAction(c.ObjectList)
+		lastKeyword := ""
+		for _, t := range c.ObjectList {
+			if (*t).Type() == "MySQLTableOptionListComponent" {
+				c.TableOptionList = (*t).(*MySQLTableOptionListComponent)
+			} else if (*t).Type() == "MySQLColumnNameComponent" {
+				column := (*t).(*MySQLColumnNameComponent).Column
+				if lastKeyword == "AFTER" {
+					c.After = column
+				} else if lastKeyword == "TO" || (c.Action == AlterTableActionChangeColumn && c.ColumnName != "") {
+					c.NewColumnName = column
+				} else if c.Action == AlterTableActionAddColumn {
+					c.ColumnList = append(c.ColumnList, column)
+					if c.ColumnName == "" {
+						c.ColumnName = column
+					}
+				} else if c.Action != AlterTableActionOrderBy {
+					c.ColumnName = column
+				}
+			} else if (*t).Type() == "MySQLColumnDefinitionComponent" {
+				if c.ColumnDefinition == nil {
+					c.ColumnDefinition = (*t).(*MySQLColumnDefinitionComponent)
+				}
+				if c.Action == AlterTableActionAddColumn {
+					c.DefinitionList = append(c.DefinitionList, (*t).(*MySQLColumnDefinitionComponent))
+				}
+			} else if (*t).Type() == "MySQLIdentifierComponent" {
+				name := strings.Trim((*t).Value(), "`")
+				if c.Action == AlterTableActionPartition {
+					continue
+				} else if lastKeyword == "CONSTRAINT" || c.Action == AlterTableActionDropCheck ||
+					c.Action == AlterTableActionAlterCheck || c.Action == AlterTableActionDropForeignKey {
+					c.ConstraintName = name
+				} else if lastKeyword == "TO" {
+					c.NewIndexName = name
+				} else {
+					c.IndexName = name
+				}
+			} else if (*t).Type() == "MySQLIndexColumnNameComponent" {
+				c.KeyPartList = append(c.KeyPartList, (*t).(*MySQLIndexColumnNameComponent))
+			} else if (*t).Type() == "MySQLIndexOptionComponent" {
+				c.Invisible = c.Invisible || (*t).(*MySQLIndexOptionComponent).Invisible
+			} else if (*t).Type() == "MySQLCheckConstraintComponent" {
+				c.Check = (*t).(*MySQLCheckConstraintComponent)
+				c.ConstraintName = c.Check.Name
+			} else if (*t).Type() == "MySQLReferenceDefinitionComponent" {
+				c.Reference = (*t).(*MySQLReferenceDefinitionComponent)
+			} else if (*t).Type() == "MySQLTableNameComponent" {
+				c.NewDatabase = (*t).(*MySQLTableNameComponent).Database
+				c.NewTable = (*t).(*MySQLTableNameComponent).Table
+			} else if (*t).Type() == "MySQLCharsetNameComponent" {
+				c.Charset = (*t).(*MySQLCharsetNameComponent).Charset
+			} else if (*t).Type() == "MySQLCollationNameComponent" {
+				c.Collation = (*t).(*MySQLCollationNameComponent).Collation
+			} else if ((*t).Type() == "MySQLStringToken" || (*t).Type() == "MySQLNumericToken") && lastKeyword == "DEFAULT" {
+				c.Default = (*t).Value()
+			} else if (*t).Type() == "MySQLKeywordToken" {
+				keyword := strings.ToUpper((*t).Value())
+				if keyword == "FIRST" {
+					c.First = true
+				} else if keyword == "DEFAULT" && lastKeyword == "DROP" {
+					c.DropDefault = true
+				} else if keyword == "INVISIBLE" {
+					c.Invisible = true
+				} else if keyword == "ENFORCED" && lastKeyword == "NOT" {
+					c.Enforced = false
+				} else if keyword == "PARTITIONING" && lastKeyword == "REMOVE" {
+					c.RemovePartitioning = true
+				} else if c.Action == AlterTableActionAddIndex {
+					c.IndexKind = getIndexKind(keyword, c.IndexKind)
+				}
+				lastKeyword = keyword
+			}
+		}
+		if c.Action == AlterTableActionDropPrimaryKey {
+			c.IndexKind = IndexKindPrimary
+			c.IndexName = "PRIMARY"
+		}
 		tokenList.Reset(endPos)
 		return c, tokenList
 	}
 }
+
+// getAlterTableAction 根据前两个有效对象确定修改表的操作类型
+func getAlterTableAction(objectList []*MySQLObject) AlterTableAction {
+	validList := make([]*MySQLObject, 0)
+	for _, t := range objectList {
+		if (*t).Type() != "MySQLSpaceToken" && (*t).Type() != "MySQLCommentToken" {
+			validList = append(validList, t)
+		}
+	}
+	if len(validList) == 0 {
+		return AlterTableActionUnknown
+	}
+	if (*validList[0]).Type() == "MySQLTableOptionListComponent" {
+		return AlterTableActionTableOption
+	}
+	first := strings.ToUpper((*validList[0]).Value())
+	secondType := ""
+	second := ""
+	if len(validList) > 1 {
+		secondType = (*validList[1]).Type()
+		second = strings.ToUpper((*validList[1]).Value())
+	}
+	switch first {
+	case "ADD":
+		if second == "PARTITION" {
+			return AlterTableActionPartition
+		} else if secondType == "MySQLCheckConstraintComponent" {
+			return AlterTableActionAddCheck
+		} else if second == "COLUMN" || second == "(" || secondType == "MySQLColumnNameComponent" {
+			return AlterTableActionAddColumn
+		}
+		return AlterTableActionAddIndex
+	case "ALTER":
+		if second == "INDEX" {
+			return AlterTableActionAlterIndex
+		} else if second == "CHECK" || second == "CONSTRAINT" {
+			return AlterTableActionAlterCheck
+		}
+		return AlterTableActionAlterColumn
+	case "CHANGE":
+		return AlterTableActionChangeColumn
+	case "MODIFY":
+		return AlterTableActionModifyColumn
+	case "DEFAULT", "CHARACTER", "CHARSET":
+		return AlterTableActionCharset
+	case "CONVERT":
+		return AlterTableActionConvertCharset
+	case "DISABLE":
+		return AlterTableActionDisableKeys
+	case "ENABLE":
+		return AlterTableActionEnableKeys
+	case "DISCARD":
+		return AlterTableActionDiscardTablespace
+	case "IMPORT":
+		return AlterTableActionImportTablespace
+	case "DROP":
+		if second == "INDEX" || second == "KEY" {
+			return AlterTableActionDropIndex
+		} else if second == "PRIMARY" {
+			return AlterTableActionDropPrimaryKey
+		} else if second == "FOREIGN" {
+			return AlterTableActionDropForeignKey
+		} else if second == "CHECK" || second == "CONSTRAINT" {
+			return AlterTableActionDropCheck
+		} else if second == "PARTITION" {
+			return AlterTableActionPartition
+		}
+		return AlterTableActionDropColumn
+	case "FORCE":
+		return AlterTableActionForce
+	case "ORDER":
+		return AlterTableActionOrderBy
+	case "RENAME":
+		if second == "COLUMN" {
+			return AlterTableActionRenameColumn
+		} else if second == "INDEX" || second == "KEY" {
+			return AlterTableActionRenameIndex
+		}
+		return AlterTableActionRenameTable
+	case "TRUNCATE", "ANALYZE", "CHECK", "OPTIMIZE", "REBUILD", "REPAIR", "COALESCE", "REORGANIZE", "REMOVE":
+		return AlterTableActionPartition
+	}
+	return AlterTableActionUnknown
+}
@@ -0,0 +1,155 @@
+package mysqlparser_go
+
+import (
+	"strings"
+)
+
+// LineageEdge 血缘图中由来源列指向目标列的一条边
+type LineageEdge struct {
+	Source *ColumnAccess
+	Target *ColumnAccess
+	Direct bool // 目标列的值直接取自来源列，未经函数或表达式计算
+}
+
+// ColumnLineage 列级血缘图，节点为库表列，同一列只对应一个节点
+type ColumnLineage struct {
+	NodeList []*ColumnAccess
+	EdgeList []*LineageEdge
+}
+
+// GetColumnLineage 分析INSERT/REPLACE ... SELECT及CREATE TABLE ... AS SELECT中目标列与来源列的对应关系
+// 来源经由别名、派生表、子查询、UNION各分支及函数追溯到实体表的列，常量列只有目标节点
+// INSERT未指定列时按位置对应目标表的列，catalog为nil时以查询输出的列名作为目标列
+// TABLE tbl作为来源时等同于SELECT * FROM tbl，ON DUPLICATE KEY UPDATE中写入的列的来源为其值读取的列
+func GetColumnLineage(s MySQLStatement, catalog *Catalog) (*ColumnLineage, error) {
+	l := &ColumnLineage{
+		NodeList: make([]*ColumnAccess, 0),
+		EdgeList: make([]*LineageEdge, 0),
+	}
+	r := newColumnResolver(catalog)
+	r.resolveStatement(s)
+	if r.outputList == nil && len(r.updateList) == 0 {
+		return l, r.err
+	}
+
+	var database, table string
+	var columnList []*MySQLColumnNameComponent
+	switch s.Type() {
+	case "InsertStatement":
+		database, table = s.(*InsertStatement).DatabaseList[0], s.(*InsertStatement).TableList[0]
+		columnList = s.(*InsertStatement).ColumnList
+	case "ReplaceStatement":
+		database, table = s.(*ReplaceStatement).DatabaseList[0], s.(*ReplaceStatement).TableList[0]
+		columnList = s.(*ReplaceStatement).ColumnList
+	case "CreateTableStatement":
+		database, table = s.(*CreateTableStatement).DatabaseList[0], s.(*CreateTableStatement).TableList[0]
+	}
+	database = accessDatabase(catalog, database)
+	targetList := make([]string, 0)
+	if len(columnList) > 0 {
+		for _, column := range columnList {
+			targetList = append(targetList, column.Column)
+		}
+	} else if catalog != nil && s.Type() != "CreateTableStatement" && catalog.GetTable(database, table) != nil {
+		for _, column := range catalog.GetTable(database, table).ColumnList {
+			targetList = append(targetList, column.Name)
+		}
+	} else {
+		for _, output := range r.outputList {
+			targetList = append(targetList, output.name)
+		}
+	}
+
+	for i, output := range r.outputList {
+		if i >= len(targetList) {
+			break
+		}
+		target := l.addNode(&ColumnAccess{
+			Database: database,
+			Table:    table,
+			Column:   targetList[i],
+		})
+		l.addEdgeList(output, target)
+	}
+	for _, update := range r.updateList {
+		target := l.addNode(&ColumnAccess{
+			Database: database,
+			Table:    table,
+			Column:   update.name,
+		})
+		l.addEdgeList(update, target)
+	}
+	return l, r.err
+}
+
+// addEdgeList 添加由输出列的各来源指向目标列的边，已有的边不重复添加
+func (l *ColumnLineage) addEdgeList(output *queryColumn, target *ColumnAccess) {
+	for _, source := range output.sourceList {
+		node := l.addNode(source)
+		if l.hasEdge(node, target) {
+			continue
+		}
+		l.EdgeList = append(l.EdgeList, &LineageEdge{
+			Source: node,
+			Target: target,
+			Direct: output.direct && len(output.sourceList) == 1,
+		})
+	}
+}
+
+func (l *ColumnLineage) hasEdge(source *ColumnAccess, target *ColumnAccess) bool {
+	for _, edge := range l.EdgeList {
+		if edge.Source == source && edge.Target == target {
+			return true
+		}
+	}
+	return false
+}
+
+// addNode 获取列对应的节点，不存在时新增
+func (l *ColumnLineage) addNode(access *ColumnAccess) *ColumnAccess {
+	if node := l.GetNode(access.Database, access.Table, access.Column); node != nil {
+		return node
+	}
+	node := &ColumnAccess{
+		Database: access.Database,
+		Table:    access.Table,
+		Column:   access.Column,
+	}
+	l.NodeList = append(l.NodeList, node)
+	return node
+}
+
+// GetNode 按库表列名查找节点，列名不区分大小写
+func (l *ColumnLineage) GetNode(database string, table string, column string) *ColumnAccess {
+	for _, node := range l.NodeList {
+		if node.Database == database && node.Table == table && strings.EqualFold(node.Column, column) {
+			return node
+		}
+	}
+	return nil
+}
+
+// GetSourceList 获取直接流入目标列的来源列
+func (l *ColumnLineage) GetSourceList(database string, table string, column string) []*ColumnAccess {
+	sourceList := make([]*ColumnAccess, 0)
+	target := l.GetNode(database, table, column)
+	for _, edge := range l.EdgeList {
+		if edge.Target == target {
+			sourceList = append(sourceList, edge.Source)
+		}
+	}
+	return sourceList
+}
+
+// GetTargetList 获取来源列直接流入的目标列
+func (l *ColumnLineage) GetTargetList(database string, table string, column string) []*ColumnAccess {
+	targetList := make([]*ColumnAccess, 0)
+	source := l.GetNode(database, table, column)
+	for _, edge := range l.EdgeList {
+		if edge.Source == source {
+			targetList = append(targetList, edge.Target)
+		}
+	}
+	return targetList
+}
@@ -0,0 +1,70 @@
+package mysqlparser_go
+
+import (
+	"strings"
+	"testing"
+)
+
+func formatColumnLineage(lineage *ColumnLineage) string {
+	resultList := make([]string, 0)
+	for _, node := range lineage.NodeList {
+		sourceList := lineage.GetSourceList(node.Database, node.Table, node.Column)
+		if len(sourceList) == 0 && len(lineage.GetTargetList(node.Database, node.Table, node.Column)) > 0 {
+			continue
+		}
+		result := node.Table + "." + node.Column + "<"
+		for i, source := range sourceList {
+			if i > 0 {
+				result += ","
+			}
+			result += source.Table + "." + source.Column
+		}
+		resultList = append(resultList, result)
+	}
+	return strings.Join(resultList, " ")
+}
+
+func Test_ColumnLineage(t *testing.T) {
+	c := NewCatalog()
+	err := c.ApplySQL("CREATE DATABASE db1; USE db1; " +
+		"CREATE TABLE a (id INT, x INT, y INT); " +
+		"CREATE TABLE b (id INT, a_id INT, z INT); " +
+		"CREATE TABLE r (id INT, total INT, label VARCHAR(32))")
+	if err != nil {
+		t.Fatalf("Error: %+v", err)
+	}
+	sqlList := map[string]string{
+		"INSERT INTO r (id, total) SELECT a.id, x + y FROM a":                                                            "r.id<a.id r.total<a.x,a.y",
+		"INSERT INTO r SELECT t.id, t.s, 'fixed' FROM (SELECT a_id AS id, SUM(z) AS s FROM b GROUP BY a_id) AS t":        "r.id<b.a_id r.total<b.z r.label<",
+		"INSERT INTO r (id, total) SELECT id, x FROM a UNION ALL SELECT a_id, z FROM b":                                  "r.id<a.id,b.a_id r.total<a.x,b.z",
+		"INSERT INTO r (id, total) SELECT id, (SELECT MAX(z) FROM b WHERE b.a_id = a.id) FROM a":                         "r.id<a.id r.total<b.z",
+		"CREATE TABLE s AS SELECT a.id, CONCAT(a.x, b.z) AS xz FROM a JOIN b ON a.id = b.a_id":                           "s.id<a.id s.xz<a.x,b.z",
+		"INSERT INTO r VALUES (1, 2, 'x')":                                                                               "",
+		"INSERT INTO r TABLE a":                                                                                          "r.id<a.id r.total<a.x r.label<a.y",
+		"INSERT INTO r (id, total) SELECT id, x FROM a ON DUPLICATE KEY UPDATE total = VALUES(total) + a.y, label = 'u'": "r.id<a.id r.total<a.x,r.total,a.y r.label<",
+		"INSERT INTO r (id, total) SELECT * FROM (SELECT a_id, z FROM b) AS t ON DUPLICATE KEY UPDATE total = t.z":       "r.id<b.a_id r.total<b.z",
+		"INSERT INTO r (id) VALUES (1) ON DUPLICATE KEY UPDATE label = CONCAT(label, 'x')":                               "r.label<r.label",
+	}
+	for sql, expected := range sqlList {
+		statementList, err := Parse(sql)
+		if err != nil {
+			t.Errorf("%s: Error: %+v", sql, err)
+			continue
+		}
+		lineage, err := GetColumnLineage(statementList[0], c)
+		if err != nil {
+			t.Errorf("%s: Error: %+v", sql, err)
+			continue
+		}
+		if formatColumnLineage(lineage) != expected {
+			t.Errorf("%s:\nRespect: %s\nGot:     %s", sql, expected, formatColumnLineage(lineage))
+		}
+	}
+
+	statementList, _ := Parse("INSERT INTO r (id, total) SELECT a.id, a.x * 2 FROM a")
+	lineage, _ := GetColumnLineage(statementList[0], nil)
+	if len(lineage.EdgeList) != 2 || !lineage.EdgeList[0].Direct || lineage.EdgeList[1].Direct ||
+		lineage.EdgeList[1].Target != lineage.GetNode("", "r", "total") {
+		t.Errorf("Got unexpected edge list: %+v", lineage.EdgeList)
+	}
+}
@@ -0,0 +1,540 @@
+package mysqlparser_go
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+type LintSeverity int32
+
+const (
+	LintSeverityInfo    LintSeverity = 0
+	LintSeverityWarning LintSeverity = 1
+	LintSeverityError   LintSeverity = 2
+)
+
+// LintIssue 检查发现的问题
+type LintIssue struct {
+	RuleID         string
+	Severity       LintSeverity
+	Message        string
+	StatementIndex int // 所在语句的序号，从0开始
+	Offset         int // 在SQL中的字节偏移
+	Line           int // 所在行，从1开始
+	Column         int // 所在列，从1开始，按字节计算
+}
+
+// LintRule 检查规则，Check对每条语句调用一次，通过LintContext报告问题
+type LintRule struct {
+	ID          string
+	Severity    LintSeverity
+	Description string
+	Check       func(ctx *LintContext)
+}
+
+// LintContext 规则检查单条语句时的上下文
+type LintContext struct {
+	Statement      MySQLStatement
+	rule           *LintRule
+	statementIndex int
+	startOffset    int
+	offsetMap      map[interface{}]int
+	issueList      []*LintIssue
+}
+
+// Linter 按启用的规则检查SQL
+// SQL中的注释`-- lint:ignore`忽略所在语句的全部问题，`-- lint:ignore rule-a, rule-b`只忽略指定规则；
+// 注释紧跟在上一条语句结尾的同一行、且下一条语句另起一行时作用于上一条语句
+type Linter struct {
+	ruleList    []*LintRule
+	disabledMap map[string]bool
+}
+
+var lintIgnoreRegex = regexp.MustCompile(`(?i)lint:ignore\b([^\r\n*]*)`)
+
+// NewLinter 创建包含全部内置规则的检查器
+func NewLinter() *Linter {
+	l := &Linter{
+		ruleList:    make([]*LintRule, 0),
+		disabledMap: make(map[string]bool),
+	}
+	for _, rule := range builtinLintRuleList {
+		l.AddRule(rule)
+	}
+	return l
+}
+
+// AddRule 添加规则，ID相同时替换原规则
+func (l *Linter) AddRule(rule *LintRule) {
+	for i, r := range l.ruleList {
+		if r.ID == rule.ID {
+			l.ruleList[i] = rule
+			return
+		}
+	}
+	l.ruleList = append(l.ruleList, rule)
+}
+
+// GetRuleList 获取已添加的规则
+func (l *Linter) GetRuleList() []*LintRule {
+	return l.ruleList
+}
+
+// EnableRule 启用规则
+func (l *Linter) EnableRule(id string) {
+	delete(l.disabledMap, id)
+}
+
+// DisableRule 停用规则
+func (l *Linter) DisableRule(id string) {
+	l.disabledMap[id] = true
+}
+
+// IsRuleEnabled 判断规则是否启用
+func (l *Linter) IsRuleEnabled(id string) bool {
+	return !l.disabledMap[id]
+}
+
+// lintIgnore 语句中lint:ignore注释的作用范围
+type lintIgnore struct {
+	all    bool
+	ruleID map[string]bool
+}
+
+func (i *lintIgnore) add(ruleList string) {
+	ruleList = strings.TrimSpace(ruleList)
+	if ruleList == "" {
+		i.all = true
+		return
+	}
+	for _, id := range strings.FieldsFunc(ruleList, func(r rune) bool {
+		return r == ',' || r == ' ' || r == '\t'
+	}) {
+		i.ruleID[id] = true
+	}
+}
+
+func (i *lintIgnore) ignored(id string) bool {
+	return i.all || i.ruleID[id]
+}
+
+// Lint 解析SQL并逐条语句应用启用的规则，按语句及位置顺序返回问题
+func (l *Linter) Lint(sql string) ([]*LintIssue, error) {
+	tokenList, err := NewMySQLTokenList(sql, nil)
+	if err != nil {
+		return nil, err
+	}
+	offsetMap := make(map[interface{}]int)
+	offset := 0
+	for _, token := range tokenList.tokenList {
+		offsetMap[*token] = offset
+		offset += len((*token).Value())
+	}
+
+	issueList := make([]*LintIssue, 0)
+	ignoreList := make([]*lintIgnore, 0)
+	lastEnd := -1
+	for _, t := range tokenList.Divide() {
+		ignore := &lintIgnore{ruleID: make(map[string]bool)}
+		leading := true
+		for _, token := range t.tokenList {
+			if (*token).Type() != "MySQLCommentToken" && (*token).Type() != "MySQLSpaceToken" {
+				leading = false
+				continue
+			}
+			match := lintIgnoreRegex.FindStringSubmatch((*token).Value())
+			if match == nil {
+				continue
+			}
+			// 与上一条语句的结尾在同一行、且之后换行的注释作用于上一条语句
+			if leading && len(ignoreList) > 0 && !strings.ContainsAny(sql[lastEnd:offsetMap[*token]], "\r\n") &&
+				!isSameLineStatement(sql, offsetMap, token, t) {
+				ignoreList[len(ignoreList)-1].add(match[1])
+			} else {
+				ignore.add(match[1])
+			}
+		}
+		if len(t.GetNextValidToken(1)) == 0 {
+			continue
+		}
+		s := parseSingleSQL(t)
+		if s == nil {
+			return nil, errors.New(fmt.Sprintf("Syntax error on  %+v", t))
+		}
+		lastToken := t.tokenList[len(t.tokenList)-1]
+		lastEnd = offsetMap[*lastToken] + len((*lastToken).Value())
+		for _, rule := range l.ruleList {
+			if !l.IsRuleEnabled(rule.ID) {
+				continue
+			}
+			ctx := &LintContext{
+				Statement:      s,
+				rule:           rule,
+				statementIndex: len(ignoreList),
+				startOffset:    offsetMap[*t.GetNextValidToken(1)[0]],
+				offsetMap:      offsetMap,
+				issueList:      make([]*LintIssue, 0),
+			}
+			rule.Check(ctx)
+			issueList = append(issueList, ctx.issueList...)
+		}
+		ignoreList = append(ignoreList, ignore)
+	}
+
+	resultList := make([]*LintIssue, 0)
+	for _, issue := range issueList {
+		if ignoreList[issue.StatementIndex].ignored(issue.RuleID) {
+			continue
+		}
+		issue.Line = strings.Count(sql[:issue.Offset], "\n") + 1
+		issue.Column = issue.Offset - strings.LastIndex(sql[:issue.Offset], "\n")
+		resultList = append(resultList, issue)
+	}
+	sortLintIssueList(resultList)
+	return resultList, nil
+}
+
+// isSameLineStatement 判断注释之后的语句是否与注释在同一行开始
+func isSameLineStatement(sql string, offsetMap map[interface{}]int, comment *MySQLToken, t MySQLTokenList) bool {
+	validList := t.GetNextValidToken(1)
+	if len(validList) == 0 {
+		return false
+	}
+	return !strings.ContainsAny(sql[offsetMap[*comment]:offsetMap[*validList[0]]], "\r\n")
+}
+
+// sortLintIssueList 按语句及位置排序，位置相同时保持规则顺序
+func sortLintIssueList(issueList []*LintIssue) {
+	for i := 1; i < len(issueList); i++ {
+		for j := i; j > 0; j-- {
+			a, b := issueList[j-1], issueList[j]
+			if a.StatementIndex < b.StatementIndex || (a.StatementIndex == b.StatementIndex && a.Offset <= b.Offset) {
+				break
+			}
+			issueList[j-1], issueList[j] = b, a
+		}
+	}
+}
+
+// Report 报告问题，位置为对象的第一个token，对象为nil时为语句开头
+func (c *LintContext) Report(t *MySQLObject, format string, args ...interface{}) {
+	offset := c.startOffset
+	if t != nil {
+		if objectOffset, ok := c.getOffset(t); ok {
+			offset = objectOffset
+		}
+	}
+	c.issueList = append(c.issueList, &LintIssue{
+		RuleID:         c.rule.ID,
+		Severity:       c.rule.Severity,
+		Message:        fmt.Sprintf(format, args...),
+		StatementIndex: c.statementIndex,
+		Offset:         offset,
+	})
+}
+
+// getOffset 获取对象第一个token的偏移
+func (c *LintContext) getOffset(t *MySQLObject) (int, bool) {
+	if offset, ok := c.offsetMap[*t]; ok {
+		return offset, true
+	}
+	for _, child := range getObjectList(t) {
+		if (*child).Type() == "MySQLSpaceToken" || (*child).Type() == "MySQLCommentToken" {
+			continue
+		}
+		if offset, ok := c.getOffset(child); ok {
+			return offset, true
+		}
+	}
+	return 0, false
+}
+
+var (
+	// lintMoneyColumnRegex 以_分隔的列名中有一段为金额相关的词，如price、total_amount、fees
+	lintMoneyColumnRegex = regexp.MustCompile(`(?i)(^|_)(price|amount|money|cost|balance|fee|salary|payment|charge)s?(_|$)`)
+
+	builtinLintRuleList = []*LintRule{
+		{
+			ID:          "missing-where",
+			Severity:    LintSeverityError,
+			Description: "UPDATE/DELETE without WHERE affects every row",
+			Check:       checkMissingWhere,
+		},
+		{
+			ID:          "select-star",
+			Severity:    LintSeverityWarning,
+			Description: "SELECT * depends on the current table definition",
+			Check:       checkSelectStar,
+		},
+		{
+			ID:          "order-by-rand",
+			Severity:    LintSeverityWarning,
+			Description: "ORDER BY RAND() sorts the whole result set",
+			Check:       checkOrderByRand,
+		},
+		{
+			ID:          "like-leading-wildcard",
+			Severity:    LintSeverityWarning,
+			Description: "LIKE pattern starting with a wildcard cannot use an index",
+			Check:       checkLikeLeadingWildcard,
+		},
+		{
+			ID:          "implicit-cross-join",
+			Severity:    LintSeverityWarning,
+			Description: "comma join or JOIN without ON/USING produces a cross join",
+			Check:       checkImplicitCrossJoin,
+		},
+		{
+			ID:          "limit-without-order-by",
+			Severity:    LintSeverityWarning,
+			Description: "LIMIT without ORDER BY returns nondeterministic rows",
+			Check:       checkLimitWithoutOrderBy,
+		},
+		{
+			ID:          "missing-charset",
+			Severity:    LintSeverityInfo,
+			Description: "CREATE DATABASE/TABLE without explicit charset depends on server defaults",
+			Check:       checkMissingCharset,
+		},
+		{
+			ID:          "missing-primary-key",
+			Severity:    LintSeverityWarning,
+			Description: "CREATE TABLE without primary key",
+			Check:       checkMissingPrimaryKey,
+		},
+		{
+			ID:          "float-money-column",
+			Severity:    LintSeverityError,
+			Description: "FLOAT/DOUBLE for money columns loses precision, use DECIMAL",
+			Check:       checkFloatMoneyColumn,
+		},
+	}
+)
+
+func checkMissingWhere(ctx *LintContext) {
+	if ctx.Statement.Type() == "UpdateStatement" && ctx.Statement.(*UpdateStatement).Where == nil {
+		ctx.Report(nil, "UPDATE without WHERE")
+	} else if ctx.Statement.Type() == "DeleteStatement" && ctx.Statement.(*DeleteStatement).Where == nil {
+		ctx.Report(nil, "DELETE without WHERE")
+	}
+}
+
+// checkSelectStar EXISTS子查询中的*不报告
+func checkSelectStar(ctx *LintContext) {
+	existsMap := make(map[*SubQueryComponent]bool)
+	walkStatement(ctx.Statement, func(t *MySQLObject) bool {
+		if (*t).Type() == "SubQueryComponent" && existsMap[(*t).(*SubQueryComponent)] {
+			return false
+		} else if (*t).Type() == "MySQLExpressionComponent" {
+			validList := getValidObjectList((*t).(*MySQLExpressionComponent).ObjectList)
+			for i := 1; i < len(validList); i++ {
+				if isKeywordToken(validList[i-1], "EXISTS") && (*validList[i]).Type() == "SubQueryComponent" {
+					existsMap[(*validList[i]).(*SubQueryComponent)] = true
+				}
+			}
+		} else if (*t).Type() == "SelectStatement" {
+			for _, field := range (*t).(*SelectStatement).FieldList {
+				if _, table, column, isColumn := getExpressionColumnName(field); isColumn && column == "*" {
+					obj := MySQLObject(field)
+					if table != "" {
+						ctx.Report(&obj, "SELECT %s.* should list columns explicitly", table)
+					} else {
+						ctx.Report(&obj, "SELECT * should list columns explicitly")
+					}
+				}
+			}
+		}
+		return true
+	})
+}
+
+// checkOrderByRand 检查语句层级的ORDER BY子句，窗口定义中的ORDER BY不报告
+func checkOrderByRand(ctx *LintContext) {
+	walkStatement(ctx.Statement, func(t *MySQLObject) bool {
+		if !InArray((*t).Type(), []string{"SelectStatement", "UnionStatement", "UpdateStatement", "DeleteStatement"}) {
+			return true
+		}
+		inOrder := false
+		for _, child := range getObjectList(t) {
+			if (*child).Type() == "MySQLKeywordToken" {
+				if (*child).Value() == "ORDER" {
+					inOrder = true
+				} else if (*child).Value() != "BY" {
+					inOrder = false
+				}
+			} else if inOrder {
+				walkObjectList([]*MySQLObject{child}, func(orderT *MySQLObject) bool {
+					if isKeywordToken(orderT, "RAND") {
+						ctx.Report(orderT, "ORDER BY RAND() sorts all rows, pick random rows another way")
+					}
+					return (*orderT).Type() != "SubQueryComponent"
+				})
+			}
+		}
+		return true
+	})
+}
+
+func checkLikeLeadingWildcard(ctx *LintContext) {
+	walkStatement(ctx.Statement, func(t *MySQLObject) bool {
+		validList := getValidObjectList(getObjectList(t))
+		for i := 1; i < len(validList); i++ {
+			if isKeywordToken(validList[i-1], "LIKE") && (*validList[i]).Type() == "MySQLStringToken" {
+				pattern := unquoteString((*validList[i]).Value())
+				if strings.HasPrefix(pattern, "%") || strings.HasPrefix(pattern, "_") {
+					ctx.Report(validList[i], "LIKE pattern %s starts with a wildcard", (*validList[i]).Value())
+				}
+			}
+		}
+		return true
+	})
+}
+
+// checkImplicitCrossJoin 检查逗号连接及未指定ON/USING的JOIN，显式CROSS JOIN和NATURAL JOIN不报告
+func checkImplicitCrossJoin(ctx *LintContext) {
+	walkStatement(ctx.Statement, func(t *MySQLObject) bool {
+		if (*t).Type() == "TableReferenceListComponent" {
+			for _, child := range (*t).(*TableReferenceListComponent).ObjectList {
+				if (*child).Type() == "MySQLDelimiterToken" && (*child).Value() == "," {
+					ctx.Report(child, "comma join without condition, use JOIN ... ON")
+				}
+			}
+		} else if (*t).Type() == "TableReferenceComponent" {
+			var join *MySQLObject
+			explicit := false
+			factorSeen := false
+			for _, child := range getValidObjectList((*t).(*TableReferenceComponent).ObjectList) {
+				if isKeywordToken(child, "ON", "USING") {
+					join = nil
+				} else if isKeywordToken(child, "INNER", "CROSS", "LEFT", "RIGHT", "NATURAL", "JOIN", "STRAIGHT_JOIN") {
+					if join != nil && factorSeen {
+						ctx.Report(join, "JOIN without ON or USING, use CROSS JOIN if intended")
+						join = nil
+					}
+					if isKeywordToken(child, "CROSS", "NATURAL") {
+						explicit = true
+					} else if isKeywordToken(child, "JOIN", "STRAIGHT_JOIN") {
+						if !explicit {
+							join = child
+						}
+						explicit = false
+						factorSeen = false
+					}
+				} else if (*child).Type() == "TableFactorComponent" {
+					factorSeen = true
+				}
+			}
+			if join != nil && factorSeen {
+				ctx.Report(join, "JOIN without ON or USING, use CROSS JOIN if intended")
+			}
+		}
+		return true
+	})
+}
+
+func checkLimitWithoutOrderBy(ctx *LintContext) {
+	for _, limit := range getLimitWithoutOrderBy(ctx.Statement) {
+		ctx.Report(limit, "LIMIT without ORDER BY returns nondeterministic rows")
+	}
+}
+
+// getLimitWithoutOrderBy 获取语句中没有ORDER BY的LIMIT，只检查带FROM的查询及UPDATE/DELETE
+func getLimitWithoutOrderBy(s MySQLStatement) []*MySQLObject {
+	limitList := make([]*MySQLObject, 0)
+	walkStatement(s, func(t *MySQLObject) bool {
+		if !InArray((*t).Type(), []string{"SelectStatement", "UpdateStatement", "DeleteStatement"}) {
+			return true
+		}
+		var limit *MySQLObject
+		hasOrder, hasTable := false, (*t).Type() != "SelectStatement"
+		for _, child := range getObjectList(t) {
+			if isKeywordToken(child, "ORDER") {
+				hasOrder = true
+			} else if isKeywordToken(child, "LIMIT") {
+				limit = child
+			} else if (*child).Type() == "TableReferenceListComponent" {
+				hasTable = true
+			}
+		}
+		if limit != nil && !hasOrder && hasTable {
+			limitList = append(limitList, limit)
+		}
+		return true
+	})
+	return limitList
+}
+
+// checkMissingCharset CREATE TABLE ... LIKE沿用原表的字符集，不报告
+func checkMissingCharset(ctx *LintContext) {
+	if ctx.Statement.Type() == "CreateDatabaseStatement" {
+		for _, t := range ctx.Statement.(*CreateDatabaseStatement).ObjectList {
+			if (*t).Type() == "MySQLDatabaseOptionComponent" {
+				return
+			}
+		}
+		ctx.Report(nil, "CREATE DATABASE %s without explicit CHARSET", ctx.Statement.(*CreateDatabaseStatement).Database)
+	} else if ctx.Statement.Type() == "CreateTableStatement" {
+		s := ctx.Statement.(*CreateTableStatement)
+		if s.FromTable != "" {
+			return
+		}
+		for _, option := range s.TableOptionList {
+			if option.Name == "CHARSET" || option.Name == "COLLATE" {
+				return
+			}
+		}
+		ctx.Report(nil, "CREATE TABLE %s without explicit CHARSET", s.TableList[0])
+	}
+}
+
+func checkMissingPrimaryKey(ctx *LintContext) {
+	if ctx.Statement.Type() != "CreateTableStatement" {
+		return
+	}
+	s := ctx.Statement.(*CreateTableStatement)
+	if s.FromTable != "" {
+		return
+	}
+	for _, definition := range s.DefinitionList {
+		if definition.IndexKind == IndexKindPrimary ||
+			(definition.ColumnDefinition != nil && definition.ColumnDefinition.PrimaryKey) {
+			return
+		}
+	}
+	ctx.Report(nil, "CREATE TABLE %s without PRIMARY KEY", s.TableList[0])
+}
+
+// checkFloatMoneyColumn 按列名判断是否为金额列
+func checkFloatMoneyColumn(ctx *LintContext) {
+	check := func(name string, definition *MySQLColumnDefinitionComponent) {
+		dataType := strings.ToUpper(definition.DataType)
+		if lintMoneyColumnRegex.MatchString(name) && (strings.HasPrefix(dataType, "FLOAT") ||
+			strings.HasPrefix(dataType, "DOUBLE") || strings.HasPrefix(dataType, "REAL")) {
+			obj := MySQLObject(definition)
+			ctx.Report(&obj, "money column %s uses %s, use DECIMAL", name, definition.DataType)
+		}
+	}
+	if ctx.Statement.Type() == "CreateTableStatement" {
+		for _, definition := range ctx.Statement.(*CreateTableStatement).DefinitionList {
+			if definition.ColumnDefinition != nil {
+				check(definition.ColumnName, definition.ColumnDefinition)
+			}
+		}
+	} else if ctx.Statement.Type() == "AlterTableStatement" {
+		for _, spec := range ctx.Statement.(*AlterTableStatement).SpecificationList {
+			if spec.Action == AlterTableActionAddColumn && len(spec.DefinitionList) == len(spec.ColumnList) {
+				for i, definition := range spec.DefinitionList {
+					check(spec.ColumnList[i], definition)
+				}
+			} else if spec.ColumnDefinition != nil {
+				name := spec.ColumnName
+				if spec.NewColumnName != "" {
+					name = spec.NewColumnName
+				}
+				check(name, spec.ColumnDefinition)
+			}
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package mysqlparser_go
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func formatLintIssueList(issueList []*LintIssue) string {
+	resultList := make([]string, 0)
+	for _, issue := range issueList {
+		resultList = append(resultList, fmt.Sprintf("%s@%d:%d:%d", issue.RuleID, issue.StatementIndex, issue.Line, issue.Column))
+	}
+	return strings.Join(resultList, " ")
+}
+
+func Test_Lint(t *testing.T) {
+	l := NewLinter()
+	l.DisableRule("missing-charset")
+	sqlList := map[string]string{
+		"UPDATE a SET x = 1":                                                    "missing-where@0:1:1",
+		"DELETE FROM a WHERE id = 1":                                            "",
+		"SELECT * FROM a WHERE EXISTS (SELECT * FROM b)":                        "select-star@0:1:8",
+		"SELECT a.* FROM a ORDER BY RAND() LIMIT 1":                             "select-star@0:1:8 order-by-rand@0:1:28",
+		"SELECT id FROM a WHERE name LIKE '%x' OR name LIKE 'x%'":               "like-leading-wildcard@0:1:34",
+		"SELECT id FROM a, b":                                                   "implicit-cross-join@0:1:17",
+		"SELECT id FROM a JOIN b":                                               "implicit-cross-join@0:1:18",
+		"SELECT id FROM a CROSS JOIN b JOIN c USING (id) NATURAL JOIN d":        "",
+		"SELECT id FROM a WHERE id IN (SELECT id FROM b LIMIT 1) ORDER BY id":   "limit-without-order-by@0:1:48",
+		"SELECT 1 LIMIT 1":                                                      "",
+		"CREATE TABLE t (id INT, price FLOAT)":                                  "missing-primary-key@0:1:1 float-money-column@0:1:31",
+		"CREATE TABLE t (id INT, PRIMARY KEY (id), fee DECIMAL(10, 2))":         "",
+		"ALTER TABLE t ADD COLUMN total_amount DOUBLE, MODIFY fee REAL":         "float-money-column@0:1:39 float-money-column@0:1:58",
+		"ALTER TABLE t ADD coffee FLOAT, ADD feedback FLOAT, ADD costume FLOAT": "",
+		"ALTER TABLE t ADD Unit_Prices FLOAT":                                   "float-money-column@0:1:31",
+		"SELECT * FROM a; -- lint:ignore select-star\nSELECT * FROM b":          "select-star@1:2:8",
+		"-- lint:ignore select-star\nSELECT * FROM a;\nUPDATE b SET x = 1":      "missing-where@1:3:1",
+		"SELECT id FROM a ORDER BY id; /* lint:ignore */ SELECT * FROM b":       "",
+	}
+	for sql, expected := range sqlList {
+		issueList, err := l.Lint(sql)
+		if err != nil {
+			t.Errorf("%s: Error: %+v", sql, err)
+			continue
+		}
+		if formatLintIssueList(issueList) != expected {
+			t.Errorf("%s: Respect: %s, Got: %s", sql, expected, formatLintIssueList(issueList))
+		}
+	}
+
+	l.EnableRule("missing-charset")
+	issueList, _ := l.Lint("CREATE DATABASE d; CREATE DATABASE e DEFAULT CHARACTER SET utf8mb4")
+	if formatLintIssueList(issueList) != "missing-charset@0:1:1" {
+		t.Errorf("Respect: missing-charset@0:1:1, Got: %s", formatLintIssueList(issueList))
+	}
+	l.AddRule(&LintRule{
+		ID:       "no-truncate",
+		Severity: LintSeverityError,
+		Check: func(ctx *LintContext) {
+			if ctx.Statement.Type() == "TruncateTableStatement" {
+				ctx.Report(nil, "TRUNCATE is not allowed")
+			}
+		},
+	})
+	issueList, _ = l.Lint("TRUNCATE TABLE a")
+	if formatLintIssueList(issueList) != "no-truncate@0:1:1" {
+		t.Errorf("Respect: no-truncate@0:1:1, Got: %s", formatLintIssueList(issueList))
+	}
+	if _, err := l.Lint("SELECT FROM"); err == nil {
+		t.Errorf("Respect syntax error")
+	}
+}
@@ -0,0 +1,494 @@
+package mysqlparser_go
+
+import (
+	"strconv"
+	"strings"
+)
+
+// ErrAlterOperationNotSupportedReason 指定的ALGORITHM或LOCK不被支持
+const ErrAlterOperationNotSupportedReason = 1846
+
+// DDLAlgorithm Online DDL算法，数值越大代价越高
+type DDLAlgorithm int32
+
+const (
+	DDLAlgorithmInstant DDLAlgorithm = 0
+	DDLAlgorithmInplace DDLAlgorithm = 1
+	DDLAlgorithmCopy    DDLAlgorithm = 2
+)
+
+// DDLLock Online DDL执行期间的锁级别，数值越大限制越多
+type DDLLock int32
+
+const (
+	DDLLockNone      DDLLock = 0
+	DDLLockShared    DDLLock = 1
+	DDLLockExclusive DDLLock = 2
+)
+
+var (
+	ddlAlgorithmName = []string{"INSTANT", "INPLACE", "COPY"}
+	ddlLockName      = []string{"NONE", "SHARED", "EXCLUSIVE"}
+
+	// charsetMaxLength 字符集中单个字符的最大字节数，未列出的按utf8mb4计算
+	charsetMaxLength = map[string]int{
+		"ascii": 1, "binary": 1, "latin1": 1, "latin2": 1, "cp1250": 1, "cp1251": 1,
+		"big5": 2, "gb2312": 2, "gbk": 2, "sjis": 2, "cp932": 2, "euckr": 2, "ucs2": 2,
+		"utf8": 3, "utf8mb3": 3, "ujis": 3, "eucjpms": 3,
+		"utf8mb4": 4, "utf16": 4, "utf16le": 4, "utf32": 4, "gb18030": 4,
+	}
+)
+
+// OnlineDDLOperation 单个修改操作支持的最优算法及该算法下允许的最低锁级别
+type OnlineDDLOperation struct {
+	Specification *MySQLAlterTableSpecificationComponent // 分区选项PARTITION BY时为nil
+	Description   string
+	Algorithm     DDLAlgorithm
+	Lock          DDLLock
+	Rebuild       bool
+	Reason        string // 无法使用更快算法的原因
+	// 以INSTANT执行的操作与其它操作合并为INPLACE执行时是否重建表，如ADD COLUMN
+	inplaceRebuild bool
+}
+
+// OnlineDDLAssessment ALTER TABLE语句的整体评估结果
+// Algorithm和Lock为各操作中代价最高者，语句指定了更高的ALGORITHM或LOCK时以指定的为准；
+// 指定的ALGORITHM或LOCK低于所需时Error记录MySQL将返回的错误
+type OnlineDDLAssessment struct {
+	Database      string
+	Table         string
+	OperationList []*OnlineDDLOperation
+	Algorithm     DDLAlgorithm
+	Lock          DDLLock
+	Rebuild       bool
+	Error         *CatalogError
+}
+
+// AssessOnlineDDL 按MySQL 8.0(8.0.29及以上)的Online DDL规则评估ALTER TABLE各操作的算法和锁
+// table为修改前的表结构，为nil时依赖原列定义的操作(如MODIFY COLUMN)按需要复制表评估
+func AssessOnlineDDL(s *AlterTableStatement, table *CatalogTable) *OnlineDDLAssessment {
+	a := &OnlineDDLAssessment{
+		Database:      s.Database,
+		Table:         s.Table,
+		OperationList: make([]*OnlineDDLOperation, 0),
+	}
+	var reason string
+	for _, spec := range s.SpecificationList {
+		operation := assessAlterSpecification(spec, table)
+		if spec.Action == AlterTableActionDropPrimaryKey && hasAddPrimaryKey(s.SpecificationList) {
+			operation = newOnlineDDLOperation(spec, DDLAlgorithmInplace, DDLLockNone, true,
+				"Replacing the primary key rebuilds the table")
+		}
+		a.OperationList = append(a.OperationList, operation)
+		if operation.Algorithm > a.Algorithm {
+			a.Algorithm = operation.Algorithm
+			reason = operation.Reason
+		}
+		if operation.Lock > a.Lock {
+			a.Lock = operation.Lock
+		}
+	}
+	if s.Partition != nil {
+		operation := &OnlineDDLOperation{
+			Description: strings.TrimSpace(s.Partition.Value()),
+			Algorithm:   DDLAlgorithmCopy,
+			Lock:        DDLLockShared,
+			Rebuild:     true,
+			Reason:      "Partition specific operations do not yet support LOCK/ALGORITHM",
+		}
+		a.OperationList = append(a.OperationList, operation)
+		a.Algorithm, a.Lock = DDLAlgorithmCopy, DDLLockShared
+		reason = operation.Reason
+	}
+
+	if requested := indexOfName(ddlAlgorithmName, s.Algorithm); requested >= 0 {
+		if DDLAlgorithm(requested) < a.Algorithm {
+			a.Error = newCatalogError(ErrAlterOperationNotSupportedReason, "ALGORITHM=%s is not supported. "+
+				"Reason: %s. Try ALGORITHM=%s.", s.Algorithm, reason, ddlAlgorithmName[a.Algorithm])
+		} else {
+			a.Algorithm = DDLAlgorithm(requested)
+		}
+	}
+	if a.Algorithm == DDLAlgorithmCopy && a.Lock < DDLLockShared {
+		a.Lock = DDLLockShared
+		reason = "COPY algorithm requires a lock"
+	}
+	if requested := indexOfName(ddlLockName, s.Lock); requested >= 0 && a.Error == nil {
+		if DDLLock(requested) < a.Lock {
+			if reason == "" {
+				reason = "the operation does not permit concurrent DML"
+			}
+			a.Error = newCatalogError(ErrAlterOperationNotSupportedReason, "LOCK=%s is not supported. "+
+				"Reason: %s. Try LOCK=%s.", s.Lock, reason, ddlLockName[a.Lock])
+		} else {
+			a.Lock = DDLLock(requested)
+		}
+	}
+
+	for _, operation := range a.OperationList {
+		a.Rebuild = a.Rebuild || operation.Rebuild ||
+			(a.Algorithm != DDLAlgorithmInstant && operation.inplaceRebuild)
+	}
+	a.Rebuild = a.Rebuild || a.Algorithm == DDLAlgorithmCopy
+	return a
+}
+
+func newOnlineDDLOperation(spec *MySQLAlterTableSpecificationComponent, algorithm DDLAlgorithm, lock DDLLock,
+	rebuild bool, reason string) *OnlineDDLOperation {
+	return &OnlineDDLOperation{
+		Specification: spec,
+		Description:   strings.TrimSpace(spec.Value()),
+		Algorithm:     algorithm,
+		Lock:          lock,
+		Rebuild:       rebuild,
+		Reason:        reason,
+	}
+}
+
+// assessAlterSpecification 评估单个修改操作，不考虑同一语句中的其它操作
+func assessAlterSpecification(spec *MySQLAlterTableSpecificationComponent, table *CatalogTable) *OnlineDDLOperation {
+	switch spec.Action {
+	case AlterTableActionAddColumn:
+		return assessAddColumn(spec, table)
+	case AlterTableActionDropColumn:
+		if table != nil {
+			if column := table.GetColumn(spec.ColumnName); column != nil && column.Generated != "" && column.Stored {
+				return newOnlineDDLOperation(spec, DDLAlgorithmInplace, DDLLockNone, true,
+					"Cannot drop stored generated column INSTANT")
+			}
+		}
+		if reason := instantColumnUnsupported(table); reason != "" {
+			return newOnlineDDLOperation(spec, DDLAlgorithmInplace, DDLLockNone, true, reason)
+		}
+		operation := newOnlineDDLOperation(spec, DDLAlgorithmInstant, DDLLockNone, false, "")
+		operation.inplaceRebuild = true
+		return operation
+	case AlterTableActionChangeColumn, AlterTableActionModifyColumn:
+		return assessModifyColumn(spec, table)
+	case AlterTableActionAlterColumn, AlterTableActionRenameColumn, AlterTableActionRenameIndex,
+		AlterTableActionAlterIndex, AlterTableActionRenameTable, AlterTableActionDropCheck:
+		return newOnlineDDLOperation(spec, DDLAlgorithmInstant, DDLLockNone, false, "")
+	case AlterTableActionAlterCheck:
+		if spec.Enforced {
+			return newOnlineDDLOperation(spec, DDLAlgorithmCopy, DDLLockShared, true,
+				"Enforcing a check constraint requires validating all rows")
+		}
+		return newOnlineDDLOperation(spec, DDLAlgorithmInstant, DDLLockNone, false, "")
+	case AlterTableActionAddCheck:
+		return newOnlineDDLOperation(spec, DDLAlgorithmCopy, DDLLockShared, true,
+			"Adding a check constraint requires validating all rows")
+	case AlterTableActionAddIndex:
+		return assessAddIndex(spec, table)
+	case AlterTableActionDropIndex, AlterTableActionDropForeignKey, AlterTableActionDisableKeys,
+		AlterTableActionEnableKeys:
+		return newOnlineDDLOperation(spec, DDLAlgorithmInplace, DDLLockNone, false, "Index operations are INPLACE")
+	case AlterTableActionDropPrimaryKey:
+		return newOnlineDDLOperation(spec, DDLAlgorithmCopy, DDLLockShared, true,
+			"Dropping a primary key is not allowed without also adding a new primary key")
+	case AlterTableActionTableOption:
+		return assessTableOption(spec, table)
+	case AlterTableActionCharset:
+		return newOnlineDDLOperation(spec, DDLAlgorithmInplace, DDLLockShared, true,
+			"Changing the default character set does not permit concurrent DML")
+	case AlterTableActionConvertCharset:
+		return newOnlineDDLOperation(spec, DDLAlgorithmCopy, DDLLockShared, true,
+			"Cannot convert the character set of existing columns INPLACE")
+	case AlterTableActionForce:
+		return newOnlineDDLOperation(spec, DDLAlgorithmInplace, DDLLockNone, true, "FORCE rebuilds the table")
+	case AlterTableActionOrderBy:
+		return newOnlineDDLOperation(spec, DDLAlgorithmCopy, DDLLockShared, true,
+			"ORDER BY requires copying rows in the new order")
+	case AlterTableActionDiscardTablespace, AlterTableActionImportTablespace:
+		return newOnlineDDLOperation(spec, DDLAlgorithmInplace, DDLLockExclusive, false,
+			"Tablespace operations do not permit concurrent access")
+	case AlterTableActionPartition:
+		return assessPartition(spec)
+	}
+	return newOnlineDDLOperation(spec, DDLAlgorithmCopy, DDLLockShared, true, "Unknown operation")
+}
+
+// instantColumnUnsupported 判断表是否不支持以INSTANT添加或删除列，返回原因
+func instantColumnUnsupported(table *CatalogTable) string {
+	if table == nil {
+		return ""
+	}
+	if strings.EqualFold(table.GetOption("ROW_FORMAT"), "COMPRESSED") {
+		return "INSTANT ADD/DROP COLUMN is not supported for tables with ROW_FORMAT=COMPRESSED"
+	}
+	for _, index := range table.IndexList {
+		if index.Kind == IndexKindFulltext {
+			return "INSTANT ADD/DROP COLUMN is not supported for tables with a FULLTEXT index"
+		}
+	}
+	return ""
+}
+
+func assessAddColumn(spec *MySQLAlterTableSpecificationComponent, table *CatalogTable) *OnlineDDLOperation {
+	for _, definition := range spec.DefinitionList {
+		if definition.Generated != nil && definition.Stored {
+			return newOnlineDDLOperation(spec, DDLAlgorithmCopy, DDLLockShared, true,
+				"Cannot add a stored generated column INPLACE")
+		}
+		if definition.AutoIncrement {
+			return newOnlineDDLOperation(spec, DDLAlgorithmInplace, DDLLockShared, true,
+				"Adding an auto-increment column requires a lock")
+		}
+		if definition.PrimaryKey || definition.UniqueKey {
+			return newOnlineDDLOperation(spec, DDLAlgorithmInplace, DDLLockNone, true,
+				"Cannot add a column with an index INSTANT")
+		}
+	}
+	if reason := instantColumnUnsupported(table); reason != "" {
+		return newOnlineDDLOperation(spec, DDLAlgorithmInplace, DDLLockNone, true, reason)
+	}
+	operation := newOnlineDDLOperation(spec, DDLAlgorithmInstant, DDLLockNone, false, "")
+	operation.inplaceRebuild = true
+	return operation
+}
+
+// assessModifyColumn 对比原列定义评估CHANGE/MODIFY COLUMN
+// 只改名或默认值可INSTANT，扩展VARCHAR长度且长度字节数不变时无需重建表，在ENUM/SET末尾追加成员且存储大小不变时可INSTANT
+func assessModifyColumn(spec *MySQLAlterTableSpecificationComponent, table *CatalogTable) *OnlineDDLOperation {
+	var column *CatalogColumn
+	if table != nil {
+		column = table.GetColumn(spec.ColumnName)
+	}
+	if column == nil {
+		return newOnlineDDLOperation(spec, DDLAlgorithmCopy, DDLLockShared, true,
+			"Cannot compare with the unknown original column definition")
+	}
+	definition := spec.ColumnDefinition
+	if column.Generated != "" && column.Stored || definition.Generated != nil && definition.Stored {
+		return newOnlineDDLOperation(spec, DDLAlgorithmCopy, DDLLockShared, true,
+			"Cannot change a stored generated column INPLACE")
+	}
+	if definition.PrimaryKey {
+		return newOnlineDDLOperation(spec, DDLAlgorithmInplace, DDLLockNone, true,
+			"Adding a primary key rebuilds the table")
+	}
+	if !strings.EqualFold(strings.Join(strings.Fields(column.DataType), " "),
+		strings.Join(strings.Fields(definition.DataType), " ")) {
+		return assessChangeColumnType(spec, table, column)
+	}
+	if column.Nullable == definition.NotNull {
+		return newOnlineDDLOperation(spec, DDLAlgorithmInplace, DDLLockNone, true,
+			"Changing the nullability of a column rebuilds the table")
+	}
+	if spec.First || spec.After != "" {
+		return newOnlineDDLOperation(spec, DDLAlgorithmInplace, DDLLockNone, true,
+			"Reordering columns rebuilds the table")
+	}
+	if column.AutoIncrement != definition.AutoIncrement || column.Comment != definition.Comment ||
+		column.OnUpdate != definition.OnUpdate || column.Invisible != definition.Invisible {
+		return newOnlineDDLOperation(spec, DDLAlgorithmInplace, DDLLockNone, false,
+			"Only renaming a column or changing its default value is INSTANT")
+	}
+	return newOnlineDDLOperation(spec, DDLAlgorithmInstant, DDLLockNone, false, "")
+}
+
+// assessChangeColumnType 评估修改列的数据类型
+func assessChangeColumnType(spec *MySQLAlterTableSpecificationComponent, table *CatalogTable,
+	column *CatalogColumn) *OnlineDDLOperation {
+	oldType, oldArgList, oldCharset := parseDataType(column.DataType)
+	newType, newArgList, newCharset := parseDataType(spec.ColumnDefinition.DataType)
+	if oldType == newType && strings.EqualFold(oldCharset, newCharset) && spec.ColumnDefinition.NotNull == !column.Nullable {
+		if (newType == "VARCHAR" || newType == "VARBINARY") && len(oldArgList) == 1 && len(newArgList) == 1 {
+			oldLength, oldErr := strconv.Atoi(oldArgList[0])
+			newLength, newErr := strconv.Atoi(newArgList[0])
+			if oldErr == nil && newErr == nil && newLength >= oldLength {
+				charLength := 1
+				if newType == "VARCHAR" {
+					charset := newCharset
+					if charset == "" {
+						charset = table.GetOption("CHARSET")
+					}
+					charLength = getCharsetMaxLength(charset)
+				}
+				if (oldLength*charLength <= 255) == (newLength*charLength <= 255) {
+					return newOnlineDDLOperation(spec, DDLAlgorithmInplace, DDLLockNone, false,
+						"Extending VARCHAR size is INPLACE")
+				}
+				return newOnlineDDLOperation(spec, DDLAlgorithmCopy, DDLLockShared, true,
+					"Cannot change the number of VARCHAR length bytes INPLACE")
+			}
+		}
+		if (newType == "ENUM" || newType == "SET") && len(newArgList) > len(oldArgList) &&
+			strings.Join(oldArgList, ",") == strings.Join(newArgList[:len(oldArgList)], ",") &&
+			getEnumStorageSize(newType, len(oldArgList)) == getEnumStorageSize(newType, len(newArgList)) {
+			return newOnlineDDLOperation(spec, DDLAlgorithmInstant, DDLLockNone, false, "")
+		}
+	}
+	return newOnlineDDLOperation(spec, DDLAlgorithmCopy, DDLLockShared, true, "Cannot change column type INPLACE")
+}
+
+func assessAddIndex(spec *MySQLAlterTableSpecificationComponent, table *CatalogTable) *OnlineDDLOperation {
+	switch spec.IndexKind {
+	case IndexKindPrimary:
+		return newOnlineDDLOperation(spec, DDLAlgorithmInplace, DDLLockNone, true,
+			"Adding a primary key rebuilds the table")
+	case IndexKindFulltext:
+		// 第一个全文索引需要添加隐藏的FTS_DOC_ID列并重建表
+		rebuild := true
+		if table != nil {
+			for _, index := range table.IndexList {
+				if index.Kind == IndexKindFulltext {
+					rebuild = false
+				}
+			}
+		}
+		return newOnlineDDLOperation(spec, DDLAlgorithmInplace, DDLLockShared, rebuild,
+			"Adding a FULLTEXT index requires a lock")
+	case IndexKindSpatial:
+		return newOnlineDDLOperation(spec, DDLAlgorithmInplace, DDLLockShared, false,
+			"Adding a SPATIAL index requires a lock")
+	case IndexKindForeign:
+		return newOnlineDDLOperation(spec, DDLAlgorithmCopy, DDLLockShared, true,
+			"Adding a foreign key is only INPLACE when foreign_key_checks is disabled")
+	}
+	return newOnlineDDLOperation(spec, DDLAlgorithmInplace, DDLLockNone, false, "Adding an index is INPLACE")
+}
+
+// assessTableOption 各表选项中代价最高者
+func assessTableOption(spec *MySQLAlterTableSpecificationComponent, table *CatalogTable) *OnlineDDLOperation {
+	operation := newOnlineDDLOperation(spec, DDLAlgorithmInstant, DDLLockNone, false, "")
+	for _, option := range spec.TableOptionList.OptionList {
+		algorithm, lock, rebuild, reason := DDLAlgorithmInplace, DDLLockNone, false, ""
+		switch option.Name {
+		case "AUTO_INCREMENT", "STATS_PERSISTENT", "STATS_AUTO_RECALC", "STATS_SAMPLE_PAGES", "COMMENT":
+			reason = "Changing table option " + option.Name + " is INPLACE"
+		case "ENGINE":
+			rebuild, reason = true, "Changing table option ENGINE rebuilds the table"
+			if !strings.EqualFold(option.OptionValue, "INNODB") ||
+				(table != nil && table.GetOption("ENGINE") != "" && !strings.EqualFold(table.GetOption("ENGINE"), "INNODB")) {
+				algorithm, lock, reason = DDLAlgorithmCopy, DDLLockShared, "Cannot change the storage engine INPLACE"
+			}
+		case "CHARSET", "COLLATE":
+			lock, rebuild = DDLLockShared, true
+			reason = "Changing the default character set does not permit concurrent DML"
+		case "ENCRYPTION":
+			algorithm, lock, rebuild = DDLAlgorithmCopy, DDLLockShared, true
+			reason = "Cannot change table encryption INPLACE"
+		default:
+			rebuild = true
+			reason = "Changing table option " + option.Name + " rebuilds the table"
+		}
+		if algorithm > operation.Algorithm {
+			operation.Algorithm = algorithm
+			operation.Reason = reason
+		}
+		if lock > operation.Lock {
+			operation.Lock = lock
+		}
+		operation.Rebuild = operation.Rebuild || rebuild
+	}
+	return operation
+}
+
+// assessPartition 分区管理操作，REBUILD/COALESCE/REORGANIZE需要复制分区中的数据
+func assessPartition(spec *MySQLAlterTableSpecificationComponent) *OnlineDDLOperation {
+	if spec.RemovePartitioning {
+		return newOnlineDDLOperation(spec, DDLAlgorithmCopy, DDLLockShared, true,
+			"Removing partitioning requires copying the table")
+	}
+	validList := getValidObjectList(spec.ObjectList)
+	if len(validList) > 0 && isKeywordToken(validList[0], "REBUILD", "COALESCE", "REORGANIZE") {
+		return newOnlineDDLOperation(spec, DDLAlgorithmInplace, DDLLockShared, true,
+			"Partition specific operations do not permit concurrent DML")
+	}
+	return newOnlineDDLOperation(spec, DDLAlgorithmInplace, DDLLockShared, false,
+		"Partition specific operations do not permit concurrent DML")
+}
+
+// hasAddPrimaryKey 判断语句中是否同时添加了主键
+func hasAddPrimaryKey(specList []*MySQLAlterTableSpecificationComponent) bool {
+	for _, spec := range specList {
+		if spec.Action == AlterTableActionAddIndex && spec.IndexKind == IndexKindPrimary {
+			return true
+		}
+		if spec.ColumnDefinition != nil && spec.ColumnDefinition.PrimaryKey {
+			return true
+		}
+	}
+	return false
+}
+
+// parseDataType 拆分数据类型的类型名、括号中的参数和字符集，类型名为大写，字符串参数保留引号
+func parseDataType(dataType string) (string, []string, string) {
+	tokenList, err := NewMySQLTokenList(dataType, nil)
+	if err != nil {
+		return strings.ToUpper(dataType), nil, ""
+	}
+	validList := make([]*MySQLToken, 0)
+	for _, token := range tokenList.tokenList {
+		if (*token).Type() != "MySQLSpaceToken" && (*token).Type() != "MySQLCommentToken" {
+			validList = append(validList, token)
+		}
+	}
+	if len(validList) == 0 {
+		return "", nil, ""
+	}
+	name := strings.ToUpper((*validList[0]).Value())
+	argList := make([]string, 0)
+	charset := ""
+	depth := 0
+	arg := ""
+	for i := 1; i < len(validList); i++ {
+		value := (*validList[i]).Value()
+		if value == "(" {
+			depth++
+			if depth == 1 {
+				continue
+			}
+		} else if value == ")" {
+			depth--
+			if depth == 0 {
+				argList = append(argList, arg)
+				arg = ""
+				continue
+			}
+		} else if value == "," && depth == 1 {
+			argList = append(argList, arg)
+			arg = ""
+			continue
+		}
+		if depth > 0 {
+			arg += value
+		} else if (strings.ToUpper(value) == "CHARSET" || strings.ToUpper(value) == "SET") && i+1 < len(validList) {
+			charset = strings.ToLower((*validList[i+1]).Value())
+		}
+	}
+	return name, argList, charset
+}
+
+// getCharsetMaxLength 获取字符集单个字符的最大字节数，字符集为空时按utf8mb4计算
+func getCharsetMaxLength(charset string) int {
+	if length, ok := charsetMaxLength[strings.ToLower(charset)]; ok {
+		return length
+	}
+	return 4
+}
+
+// getEnumStorageSize ENUM或SET在指定成员数时的存储字节数
+func getEnumStorageSize(dataType string, count int) int {
+	if dataType == "ENUM" {
+		if count <= 255 {
+			return 1
+		}
+		return 2
+	}
+	size := (count + 7) / 8
+	if size > 4 {
+		return 8
+	}
+	return size
+}
+
+// indexOfName 获取名称在列表中的位置，不区分大小写，不存在时返回-1
+func indexOfName(nameList []string, name string) int {
+	for i, n := range nameList {
+		if strings.EqualFold(n, name) {
+			return i
+		}
+	}
+	return -1
+}
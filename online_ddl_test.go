@@ -0,0 +1,61 @@
+package mysqlparser_go
+
+import (
+	"fmt"
+	"testing"
+)
+
+func formatOnlineDDLAssessment(a *OnlineDDLAssessment) string {
+	result := fmt.Sprintf("%s %s rebuild=%v", ddlAlgorithmName[a.Algorithm], ddlLockName[a.Lock], a.Rebuild)
+	if a.Error != nil {
+		result += fmt.Sprintf(" error=%d", a.Error.Code)
+	}
+	return result
+}
+
+func Test_OnlineDDL(t *testing.T) {
+	c := NewCatalog()
+	err := c.ApplySQL("CREATE DATABASE db1; USE db1; " +
+		"CREATE TABLE t (id INT PRIMARY KEY, name VARCHAR(20), st ENUM('a', 'b'), amount INT NOT NULL, " +
+		"g INT AS (id + 1) STORED) DEFAULT CHARSET=utf8mb4; " +
+		"CREATE TABLE f (id INT PRIMARY KEY, body TEXT, FULLTEXT KEY ft (body))")
+	if err != nil {
+		t.Fatalf("Error: %+v", err)
+	}
+	sqlList := map[string][2]string{
+		"ALTER TABLE t ADD COLUMN c INT":                                       {"INSTANT NONE rebuild=false", "INSTANT NONE rebuild=false"},
+		"ALTER TABLE f ADD COLUMN c INT":                                       {"INPLACE NONE rebuild=true", "INSTANT NONE rebuild=false"},
+		"ALTER TABLE t ADD COLUMN c INT, ADD INDEX idx_c (c)":                  {"INPLACE NONE rebuild=true", "INPLACE NONE rebuild=true"},
+		"ALTER TABLE t DROP COLUMN g":                                          {"INPLACE NONE rebuild=true", "INSTANT NONE rebuild=false"},
+		"ALTER TABLE t MODIFY name VARCHAR(60)":                                {"INPLACE NONE rebuild=false", "COPY SHARED rebuild=true"},
+		"ALTER TABLE t MODIFY name VARCHAR(64)":                                {"COPY SHARED rebuild=true", "COPY SHARED rebuild=true"},
+		"ALTER TABLE t MODIFY name VARCHAR(20) NOT NULL":                       {"INPLACE NONE rebuild=true", "COPY SHARED rebuild=true"},
+		"ALTER TABLE t CHANGE name nick VARCHAR(20)":                           {"INSTANT NONE rebuild=false", "COPY SHARED rebuild=true"},
+		"ALTER TABLE t MODIFY name VARCHAR(20) AFTER amount":                   {"INPLACE NONE rebuild=true", "COPY SHARED rebuild=true"},
+		"ALTER TABLE t MODIFY st ENUM('a', 'b', 'c')":                          {"INSTANT NONE rebuild=false", "COPY SHARED rebuild=true"},
+		"ALTER TABLE t MODIFY st ENUM('b', 'a', 'c')":                          {"COPY SHARED rebuild=true", "COPY SHARED rebuild=true"},
+		"ALTER TABLE t ALTER COLUMN amount SET DEFAULT 0, RENAME INDEX a TO b": {"INSTANT NONE rebuild=false", "INSTANT NONE rebuild=false"},
+		"ALTER TABLE f ADD FULLTEXT INDEX ft2 (body)":                          {"INPLACE SHARED rebuild=false", "INPLACE SHARED rebuild=true"},
+		"ALTER TABLE t DROP PRIMARY KEY":                                       {"COPY SHARED rebuild=true", "COPY SHARED rebuild=true"},
+		"ALTER TABLE t DROP PRIMARY KEY, ADD PRIMARY KEY (id, amount)":         {"INPLACE NONE rebuild=true", "INPLACE NONE rebuild=true"},
+		"ALTER TABLE t ENGINE=InnoDB, AUTO_INCREMENT=100":                      {"INPLACE NONE rebuild=true", "INPLACE NONE rebuild=true"},
+		"ALTER TABLE t PARTITION BY HASH(id) PARTITIONS 4":                     {"COPY SHARED rebuild=true", "COPY SHARED rebuild=true"},
+		"ALTER TABLE t ADD COLUMN c INT, ALGORITHM=INPLACE":                    {"INPLACE NONE rebuild=true", "INPLACE NONE rebuild=true"},
+		"ALTER TABLE t ADD INDEX (name), ALGORITHM=INSTANT":                    {"INPLACE NONE rebuild=false error=1846", "INPLACE NONE rebuild=false error=1846"},
+		"ALTER TABLE t ADD COLUMN c INT, ALGORITHM=COPY, LOCK=NONE":            {"COPY SHARED rebuild=true error=1846", "COPY SHARED rebuild=true error=1846"},
+		"ALTER TABLE t ADD INDEX (name), LOCK = SHARED":                        {"INPLACE SHARED rebuild=false", "INPLACE SHARED rebuild=false"},
+	}
+	for sql, expected := range sqlList {
+		statementList, err := Parse(sql)
+		if err != nil {
+			t.Errorf("%s: Error: %+v", sql, err)
+			continue
+		}
+		s := statementList[0].(*AlterTableStatement)
+		for i, table := range []*CatalogTable{c.GetTable("db1", s.Table), nil} {
+			if result := formatOnlineDDLAssessment(AssessOnlineDDL(s, table)); result != expected[i] {
+				t.Errorf("%s: Respect: %s, Got: %s", sql, expected[i], result)
+			}
+		}
+	}
+}
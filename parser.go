@@ -7,35 +7,41 @@ import (
 
 func parseSingleSQL(tokenList MySQLTokenList) MySQLStatement {
 	tokenStarts := tokenList.GetNextValidToken(2)
-	if len(tokenStarts) != 2 {
+	if len(tokenStarts) == 0 {
 		return nil
 	}
+	secondValue := ""
+	if len(tokenStarts) == 2 {
+		secondValue = (*tokenStarts[1]).Value()
+	}
 	var s MySQLStatement
 	switch (*tokenStarts[0]).Value() {
 	case "CREATE":
-		if InArray((*tokenStarts[1]).Value(), []string{"DATABASE", "SCHEMA"}) {
+		if InArray(secondValue, []string{"DATABASE", "SCHEMA"}) {
 			s, tokenList = NewCreateDatabaseStatement(tokenList, verbose)
-		} else if InArray((*tokenStarts[1]).Value(), []string{"TEMPORARY", "TABLE"}) {
+		} else if InArray(secondValue, []string{"TEMPORARY", "TABLE"}) {
 			s, tokenList = NewCreateTableStatement(tokenList, verbose)
-		} else if InArray((*tokenStarts[1]).Value(),
+		} else if InArray(secondValue,
 			[]string{"ONLINE", "OFFLINE", "UNIQUE", "FULLTEXT", "SPATIAL", "INDEX"}) {
 			s, tokenList = NewCreateIndexStatement(tokenList, verbose)
 		}
 	case "ALTER":
-		if InArray((*tokenStarts[1]).Value(), []string{"DATABASE", "SCHEMA"}) {
+		if InArray(secondValue, []string{"DATABASE", "SCHEMA"}) {
 			s, tokenList = NewAlterDatabaseStatement(tokenList, verbose)
-		} else if InArray((*tokenStarts[1]).Value(),
+		} else if InArray(secondValue,
 			[]string{"ONLINE", "OFFLINE", "IGNORE", "TABLE"}) {
 			s, tokenList = NewAlterTableStatement(tokenList, verbose)
 		}
 	case "DROP":
-		if InArray((*tokenStarts[1]).Value(), []string{"DATABASE", "SCHEMA"}) {
+		if InArray(secondValue, []string{"DATABASE", "SCHEMA"}) {
 			s, tokenList = NewDropDatabaseStatement(tokenList, verbose)
-		} else if InArray((*tokenStarts[1]).Value(), []string{"TEMPORARY", "TABLE"}) {
+		} else if InArray(secondValue, []string{"TEMPORARY", "TABLE"}) {
 			s, tokenList = NewDropTableStatement(tokenList, verbose)
-		} else if InArray((*tokenStarts[1]).Value(),
+		} else if InArray(secondValue,
 			[]string{"ONLINE", "OFFLINE", "INDEX"}) {
 			s, tokenList = NewDropIndexStatement(tokenList, verbose)
+		} else if secondValue == "PREPARE" {
+			s, tokenList = NewDeallocatePrepareStatement(tokenList, verbose)
 		}
 	case "RENAME":
 		s, tokenList = NewRenameTableStatement(tokenList, verbose)
@@ -48,6 +54,20 @@ func parseSingleSQL(tokenList MySQLTokenList) MySQLStatement {
 		if s == nil {
 			s, tokenList = NewSelectStatement(tokenList, verbose)
 		}
+	case "TABLE":
+		if tokenList.HasToken("MySQLKeywordToken", "UNION") {
+			s, tokenList = NewUnionStatement(tokenList, verbose)
+		}
+		if s == nil {
+			s, tokenList = NewTableStatement(tokenList, verbose)
+		}
+	case "VALUES":
+		if tokenList.HasToken("MySQLKeywordToken", "UNION") {
+			s, tokenList = NewUnionStatement(tokenList, verbose)
+		}
+		if s == nil {
+			s, tokenList = NewValuesStatement(tokenList, verbose)
+		}
 	case "INSERT":
 		s, tokenList = NewInsertStatement(tokenList, verbose)
 	case "REPLACE":
@@ -64,6 +84,67 @@ func parseSingleSQL(tokenList MySQLTokenList) MySQLStatement {
 		s, tokenList = NewExplainStatement(tokenList, verbose)
 	case "USE":
 		s, tokenList = NewUseStatement(tokenList, verbose)
+	case "ANALYZE":
+		s, tokenList = NewAnalyzeTableStatement(tokenList, verbose)
+	case "CHECK":
+		s, tokenList = NewCheckTableStatement(tokenList, verbose)
+	case "CHECKSUM":
+		s, tokenList = NewChecksumTableStatement(tokenList, verbose)
+	case "OPTIMIZE":
+		s, tokenList = NewOptimizeTableStatement(tokenList, verbose)
+	case "REPAIR":
+		s, tokenList = NewRepairTableStatement(tokenList, verbose)
+	case "FLUSH":
+		s, tokenList = NewFlushStatement(tokenList, verbose)
+	case "KILL":
+		s, tokenList = NewKillStatement(tokenList, verbose)
+	case "PURGE":
+		s, tokenList = NewPurgeBinaryLogsStatement(tokenList, verbose)
+	case "RESET":
+		if InArray(secondValue, []string{"SLAVE", "REPLICA"}) {
+			s, tokenList = NewResetSlaveStatement(tokenList, verbose)
+		} else {
+			s, tokenList = NewResetStatement(tokenList, verbose)
+		}
+	case "SHUTDOWN":
+		s, tokenList = NewShutdownStatement(tokenList, verbose)
+	case "CHANGE":
+		if secondValue == "MASTER" {
+			s, tokenList = NewChangeMasterStatement(tokenList, verbose)
+		} else if secondValue == "REPLICATION" {
+			s, tokenList = NewChangeMasterStatement(tokenList, verbose)
+			if s == nil {
+				s, tokenList = NewChangeReplicationFilterStatement(tokenList, verbose)
+			}
+		}
+	case "START":
+		if InArray(secondValue, []string{"SLAVE", "REPLICA"}) {
+			s, tokenList = NewStartSlaveStatement(tokenList, verbose)
+		} else if secondValue == "TRANSACTION" {
+			s, tokenList = NewTransactionStatement(tokenList, verbose)
+		}
+	case "STOP":
+		if InArray(secondValue, []string{"SLAVE", "REPLICA"}) {
+			s, tokenList = NewStopSlaveStatement(tokenList, verbose)
+		}
+	case "CALL":
+		s, tokenList = NewCallStatement(tokenList, verbose)
+	case "DO":
+		s, tokenList = NewDoStatement(tokenList, verbose)
+	case "HANDLER":
+		s, tokenList = NewHandlerStatement(tokenList, verbose)
+	case "XA":
+		s, tokenList = NewXAStatement(tokenList, verbose)
+	case "PREPARE":
+		s, tokenList = NewPrepareStatement(tokenList, verbose)
+	case "EXECUTE":
+		s, tokenList = NewExecuteStatement(tokenList, verbose)
+	case "DEALLOCATE":
+		s, tokenList = NewDeallocatePrepareStatement(tokenList, verbose)
+	case "BEGIN", "COMMIT", "ROLLBACK", "SAVEPOINT", "RELEASE":
+		s, tokenList = NewTransactionStatement(tokenList, verbose)
+	case "LOCK", "UNLOCK":
+		s, tokenList = NewLockTablesStatement(tokenList, verbose)
 	default:
 		return nil
 	}
@@ -83,6 +164,10 @@ func Parse(sql string) ([]MySQLStatement, error) {
 
 	sqlList := make([]MySQLStatement, 0)
 	for _, t := range sqlTokenList {
+		if len(t.GetNextValidToken(1)) == 0 {
+			// 只含注释的部分，如最后一条语句之后的注释
+			continue
+		}
 		s := parseSingleSQL(t)
 		if s != nil {
 			sqlList = append(sqlList, s)
@@ -95,3 +180,29 @@ func Parse(sql string) ([]MySQLStatement, error) {
 
 	return sqlList, nil
 }
+
+// GetParamMarkerPosList 获取SQL中参数占位符?的字节偏移
+func GetParamMarkerPosList(sql string) ([]int, error) {
+	tokenList, err := NewMySQLTokenList(sql, verbose)
+	if err != nil {
+		return nil, err
+	}
+	posList := make([]int, 0)
+	pos := 0
+	for _, token := range tokenList.tokenList {
+		if (*token).Type() == "MySQLParamMarkerToken" {
+			posList = append(posList, pos)
+		}
+		pos += len((*token).Value())
+	}
+	return posList, nil
+}
+
+// GetParamMarkerCount 获取SQL中参数占位符?的数量
+func GetParamMarkerCount(sql string) (int, error) {
+	posList, err := GetParamMarkerPosList(sql)
+	if err != nil {
+		return 0, err
+	}
+	return len(posList), nil
+}
@@ -2,6 +2,7 @@ package mysqlparser_go
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 )
 
@@ -9,6 +10,109 @@ func Test_Parser(t *testing.T) {
 	sqlmap := map[string]bool{
 		"UPDATE DB_Ad_43.Tbl_AdGroup_1 SET `FUserStatus`=10,`FLastModTime`=1661181610,`FColdStartAudienceIdSet`='',`FExpandTargetingRule`='',`FLastModByDeveloperAppId`=1110260112,`FLastModByUserId`=25699284 WHERE FAId=6356055783;INSERT INTO DB_Ad_43.Tbl_DiTraceLog_1 (`FSeqID`,`FUId`,`FDbName`,`FTableName`,`FOperationType`,`FOperation`,`FLogStatus`,`FCreatedTime`,`FLastModTime`,`FDiReturnCode`,`FDiEventNo`,`FDiErrInfo`) VALUES (13290762304,24453143,'DB_Ad_43','DB_Ad_43.Tbl_AdGroup_1',1,'{\\\\\\\"operation_type\\\\\\\":1,\\\\\\\"table\\\\\\\":\\\\\\\"DB_Ad_.Tbl_AdGroup_\\\\\\\",\\\\\\\"row\\\\\\\":{\\\\\\\"names\\\\\\\":[\\\\\\\"FUserStatus\\\\\\\",\\\\\\\"FLastModTime\\\\\\\",\\\\\\\"FColdStartAudienceIdSet\\\\\\\",\\\\\\\"FExpandTargetingRule\\\\\\\",\\\\\\\"FLastModByDeveloperAppId\\\\\\\",\\\\\\\"FLastModByUserId\\\\\\\"],\\\\\\\"values\\\\\\\":[{\\\\\\\"uint_value\\\\\\\":10,\\\\\\\"type\\\\\\\":2},{\\\\\\\"uint_value\\\\\\\":1661181610,\\\\\\\"type\\\\\\\":2},{\\\\\\\"type\\\\\\\":4},{\\\\\\\"type\\\\\\\":4},{\\\\\\\"uint_value\\\\\\\":1110260112,\\\\\\\"type\\\\\\\":2},{\\\\\\\"int_value\\\\\\\":25699284,\\\\\\\"type\\\\\\\":1}],\\\\\\\"old_values\\\\\\\":[{\\\\\\\"uint_value\\\\\\\":1,\\\\\\\"type\\\\\\\":2},{\\\\\\\"uint_value\\\\\\\":1661177504,\\\\\\\"type\\\\\\\":2},{\\\\\\\"type\\\\\\\":4},{\\\\\\\"type\\\\\\\":4},{\\\\\\\"uint_value\\\\\\\":1110741802,\\\\\\\"type\\\\\\\":2},{\\\\\\\"type\\\\\\\":1}]},\\\\\\\"where_args\\\\\\\":{\\\\\\\"condition\\\\\\\":\\\\\\\"FAId=?\\\\\\\",\\\\\\\"condition_args\\\\\\\":[{\\\\\\\"int_value\\\\\\\":6356055783,\\\\\\\"type\\\\\\\":1}]},\\\\\\\"divide_key\\\\\\\":24453143,\\\\\\\"primary_keys\\\\\\\":[\\\\\\\"FAId\\\\\\\"],\\\\\\\"context\\\\\\\":{\\\\\\\"protocol_type\\\\\\\":3,\\\\\\\"user_command\\\\\\\":3,\\\\\\\"operation_client\\\\\\\":1,\\\\\\\"operator_role\\\\\\\":1,\\\\\\\"operation_action\\\\\\\":2,\\\\\\\"frontend_operator\\\\\\\":\\\\\\\"1704907017\\\\\\\",\\\\\\\"frontend_operator_type\\\\\\\":1,\\\\\\\"frontend_operation_object\\\\\\\":3,\\\\\\\"trace_id\\\\\\\":\\\\\\\"b8719df6-8bb8-4999-e863-a7b19ed0462e\\\\\\\",\\\\\\\"operator_name\\\\\\\":\\\\\\\"1704907017\\\\\\\",\\\\\\\"operator_type\\\\\\\":\\\\\\\"qq\\\\\\\",\\\\\\\"operator_platform\\\\\\\":\\\\\\\"1002\\\\\\\"},\\\\\\\"route_key\\\\\\\":\\\\\\\"FUId\\\\\\\",\\\\\\\"route_key_values\\\\\\\":[{\\\\\\\"uint_value\\\\\\\":24453143,\\\\\\\"type\\\\\\\":2}]}',255,1661181610,1661181610,1,0,'')": true,
 		"UPDATE DB_Ad_43.Tbl_AdGroup_1 SET `FUserStatus`=10,`FLastModTime`=1661181610,`FColdStartAudienceIdSet`='',`FExpandTargetingRule`='',`FLastModByDeveloperAppId`=1110260112,`FLastModByUserId`=25699284 WHERE FAId=6356055783;INSERT INTO DB_Ad_43.Tbl_DiTraceLog_1 (`FSeqID`,`FUId`,`FDbName`,`FTableName`,`FOperationType`,`FOperation`,`FLogStatus`,`FCreatedTime`,`FLastModTime`,`FDiReturnCode`,`FDiEventNo`,`FDiErrInfo`) VALUES (13290762304,24453143,'DB_Ad_43','DB_Ad_43.Tbl_AdGroup_1',1,'{\\\\\\\"operation_type\\\\\\\":1,\\\\\\\"table\\\\\\\":\\\\\\\"DB_Ad_.Tbl_AdGroup_\\\\\\\",\\\\\\\"row\\\\\\\":{\\\\\\\"names\\\\\\\":[\\\\\\\"FUserStatus\\\\\\\",\\\\\\\"FLastModTime\\\\\\\",\\\\\\\"FColdStartAudienceIdSet\\\\\\\",\\\\\\\"FExpandTargetingRule\\\\\\\",\\\\\\\"FLastModByDeveloperAppId\\\\\\\",\\\\\\\"FLastModByUserId\\\\\\\"],\\\\\\\"values\\\\\\\":[{\\\\\\\"uint_value\\\\\\\":10,\\\\\\\"type\\\\\\\":2},{\\\\\\\"uint_value\\\\\\\":1661181610,\\\\\\\"type\\\\\\\":2},{\\\\\\\"type\\\\\\\":4},{\\\\\\\"type\\\\\\\":4},{\\\\\\\"uint_value\\\\\\\":1110260112,\\\\\\\"type\\\\\\\":2},{\\\\\\\"int_value\\\\\\\":25699284,\\\\\\\"type\\\\\\\":1}],\\\\\\\"old_values\\\\\\\":[{\\\\\\\"uint_value\\\\\\\":1,\\\\\\\"type\\\\\\\":2},{\\\\\\\"uint_value\\\\\\\":1661177504,\\\\\\\"type\\\\\\\":2},{\\\\\\\"type\\\\\\\":4},{\\\\\\\"type\\\\\\\":4},{\\\\\\\"uint_value\\\\\\\":1110741802,\\\\\\\"type\\\\\\\":2},{\\\\\\\"type\\\\\\\":1}]},\\\\\\\"where_args\\\\\\\":{\\\\\\\"condition\\\\\\\":\\\\\\\"FAId=?\\\\\\\",\\\\\\\"condition_args\\\\\\\":[{\\\\\\\"int_value\\\\\\\":6356055783,\\\\\\\"type\\\\\\\":1}]},\\\\\\\"divide_key\\\\\\\":24453143,\\\\\\\"primary_keys\\\\\\\":[\\\\\\\"FAId\\\\\\\"],\\\\\\\"context\\\\\\\":{\\\\\\\"protocol_type\\\\\\\":3,\\\\\\\"user_command\\\\\\\":3,\\\\\\\"operation_client\\\\\\\":1,\\\\\\\"operator_role\\\\\\\":1,\\\\\\\"operation_action\\\\\\\":2,\\\\\\\"frontend_operator\\\\\\\":\\\\\\\"1704907017\\\\\\\",\\\\\\\"frontend_operator_type\\\\\\\":1,\\\\\\\"frontend_operation_object\\\\\\\":3,\\\\\\\"trace_id\\\\\\\":\\\\\\\"b8719df6-8bb8-4999-e863-a7b19ed0462e\\\\\\\",\\\\\\\"operator_name\\\\\\\":\\\\\\\"1704907017\\\\\\\",\\\\\\\"operator_type\\\\\\\":\\\\\\\"qq\\\\\\\",\\\\\\\"operator_platform\\\\\\\":\\\\\\\"1002\\\\\\\"},\\\\\\\"route_key\\\\\\\":\\\\\\\"FUId\\\\\\\",\\\\\\\"route_key_values\\\\\\\":[{\\\\\\\"uint_value\\\\\\\":24453143,\\\\\\\"type\\\\\\\":2}]}',255,1661181610,1661181610,":        false,
+		"SELECT a, ROW_NUMBER() OVER (PARTITION BY b ORDER BY c ROWS BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW) AS rn FROM t":                                                                                                                                     true,
+		"SELECT SUM(x) OVER w, LAG(x, 1) OVER (w ORDER BY y) FROM t WINDOW w AS (PARTITION BY z) ORDER BY x LIMIT 3":                                                                                                                                                true,
+		"SELECT NTILE(4) OVER (ORDER BY d RANGE BETWEEN INTERVAL 1 DAY PRECEDING AND UNBOUNDED FOLLOWING) FROM t":                                                                                                                                                   true,
+		"SELECT c->'$.a', c->>'$.b', JSON_EXTRACT(c, '$.x') FROM t WHERE JSON_CONTAINS(c, '1', '$.ids')":                                                                                                                                                            true,
+		"SELECT jt.* FROM t, JSON_TABLE(t.c, '$[*]' COLUMNS (rid FOR ORDINALITY, v VARCHAR(100) PATH '$.v' DEFAULT '\"x\"' ON EMPTY NULL ON ERROR, NESTED PATH '$.n[*]' COLUMNS (n INT PATH '$'))) AS jt":                                                           true,
+		"CREATE TABLE t (id INT, doc JSON NOT NULL, PRIMARY KEY (id))":                                                                                                                                                                                              true,
+		"CREATE TABLE t (a INT, b INT, c INT GENERATED ALWAYS AS (a + b) VIRTUAL NOT NULL, d INT AS (a * 2) STORED, e INT INVISIBLE, f INT CONSTRAINT f_pos CHECK (f > 0) NOT ENFORCED, INDEX idx_ab (a DESC, (b + 1)) INVISIBLE, CONSTRAINT t_chk CHECK (a <> b))": true,
+		"ALTER TABLE t ADD COLUMN g INT AS (a + 1) STORED AFTER a, ADD CONSTRAINT c1 CHECK (a > 0), ALTER CHECK c1 NOT ENFORCED, ALTER INDEX idx_ab VISIBLE, DROP CHECK c1, RENAME COLUMN a TO aa":                                                                  true,
+		"ALTER TABLE t ALTER COLUMN a SET DEFAULT 0, ALGORITHM=INSTANT, LOCK DEFAULT":                                                                                                                                                                               true,
+		"CREATE INDEX i ON t ((a + b) DESC, c) INVISIBLE":                                                                                                                                                                                                           true,
+		"INSERT INTO t SET a = 1, b = 'x' AS new ON DUPLICATE KEY UPDATE a = new.a":                                                                                                                                                                                 true,
+		"REPLACE DELAYED INTO t PARTITION (p1) (a, b) VALUES (1, 2), (3, 4)":                                                                                                                                                                                        true,
+		"DELETE FROM t1 AS a WHERE a.id = 1 ORDER BY a.id DESC LIMIT 10":                                                                                                                                                                                            true,
+		"DELETE t1, t2 FROM t1 INNER JOIN t2 ON t1.id = t2.id WHERE t1.id = 1":                                                                                                                                                                                      true,
+		"DELETE FROM t1.*, t2.* USING t1 INNER JOIN t2 ON t1.id = t2.id WHERE t1.x = 1":                                                                                                                                                                             true,
+		"UPDATE t1 AS a JOIN t2 AS b ON a.id = b.id SET a.x = b.x, a.y = 1 WHERE b.z IN (SELECT z FROM t3) ORDER BY a.id, b.id DESC LIMIT 5":                                                                                                                        true,
+		"SELECT * FROM t1 JOIN t2 ON t1.id = t2.id WHERE t1.a = 1 FOR UPDATE OF t1 NOWAIT FOR SHARE OF t2 SKIP LOCKED":                                                                                                                                              true,
+		"SELECT * FROM t WHERE id = 1 LOCK IN SHARE MODE":                                                                                                                                                                                                           true,
+		"SELECT a FROM t ORDER BY a LIMIT 1 FOR UPDATE INTO @a":                                                                                                                                                                                                     true,
+		"(SELECT a FROM t1) UNION (SELECT a FROM t2) ORDER BY a LIMIT 10 FOR SHARE":                                                                                                                                                                                 true,
+		"SELECT * FROM t WHERE a = ? AND b IN (?, ?) ORDER BY c LIMIT ?, ?":                                                                                                                                                                                         true,
+		"UPDATE t SET a = ? WHERE id = ? LIMIT ?":                                                                                                                                                                                                                   true,
+		"INSERT INTO t (a, b) VALUES (?, ?)":                                                                                                                                                                                                                        true,
+		"PREPARE stmt1 FROM 'SELECT * FROM t WHERE id = ?'":                                                                                                                                                                                                         true,
+		"PREPARE stmt2 FROM @sql":                                                      true,
+		"EXECUTE stmt1 USING @a, @b":                                                   true,
+		"DEALLOCATE PREPARE stmt1":                                                     true,
+		"DROP PREPARE stmt2":                                                           true,
+		"ANALYZE NO_WRITE_TO_BINLOG TABLE db.t1, t2":                                   true,
+		"ANALYZE TABLE t UPDATE HISTOGRAM ON a, b WITH 16 BUCKETS":                     true,
+		"ANALYZE LOCAL TABLE t DROP HISTOGRAM ON a":                                    true,
+		"OPTIMIZE LOCAL TABLE t1, t2":                                                  true,
+		"CHECK TABLE t1, t2 FOR UPGRADE QUICK":                                         true,
+		"REPAIR NO_WRITE_TO_BINLOG TABLE t QUICK EXTENDED USE_FRM":                     true,
+		"CHECKSUM TABLE t1, db.t2 EXTENDED":                                            true,
+		"FLUSH PRIVILEGES":                                                             true,
+		"FLUSH NO_WRITE_TO_BINLOG BINARY LOGS, RELAY LOGS FOR CHANNEL c1, QUERY CACHE": true,
+		"FLUSH TABLES WITH READ LOCK":                                                  true,
+		"FLUSH TABLES db.t1, t2 FOR EXPORT":                                            true,
+		"KILL 123":                                                                     true,
+		"KILL QUERY 456":                                                               true,
+		"RESET MASTER TO 100, QUERY CACHE":                                             true,
+		"RESET PERSIST IF EXISTS max_connections":                                      true,
+		"PURGE BINARY LOGS TO 'mysql-bin.000010'":                                      true,
+		"PURGE MASTER LOGS BEFORE '2024-01-01 00:00:00'":                               true,
+		"SHUTDOWN": true,
+		"CHANGE MASTER TO MASTER_HOST = 'db1', MASTER_PORT = 3306, MASTER_AUTO_POSITION = 1":        true,
+		"CHANGE REPLICATION SOURCE TO SOURCE_HOST='db1', IGNORE_SERVER_IDS = (1, 2) FOR CHANNEL c1": true,
+		"CHANGE REPLICATION FILTER REPLICATE_DO_DB = (d1, d2), REPLICATE_REWRITE_DB = ((a, b))":     true,
+		"CHANGE REPLICATION FILTER REPLICATE_WILD_DO_TABLE = ('db.t%'), REPLICATE_IGNORE_DB = ()":   true,
+		"START SLAVE": true,
+		"START REPLICA SQL_THREAD UNTIL SQL_AFTER_MTS_GAPS": true,
+		"STOP SLAVE IO_THREAD, SQL_THREAD FOR CHANNEL c1":   true,
+		"CALL p()": true,
+		"CALL db.p(1, @a, (SELECT MAX(id) FROM t))": true,
+		"CALL p":                         true,
+		"DO SLEEP(1), RELEASE_LOCK('l')": true,
+		"HANDLER t OPEN":                 true,
+		"HANDLER db.t OPEN AS h":         true,
+		"HANDLER h READ FIRST":           true,
+		"HANDLER h READ idx >= (1, 'a') WHERE b > 1 LIMIT 5, 10":               true,
+		"HANDLER h READ idx NEXT LIMIT 1":                                      true,
+		"HANDLER h CLOSE":                                                      true,
+		"TABLE t":                                                              true,
+		"TABLE db.t ORDER BY a DESC LIMIT 10 OFFSET 5":                         true,
+		"VALUES ROW(1, 2), ROW(3, 4) ORDER BY column_0":                        true,
+		"TABLE t1 UNION ALL TABLE t2":                                          true,
+		"SELECT a FROM t1 UNION VALUES ROW(1)":                                 true,
+		"INSERT INTO t1 TABLE t2":                                              true,
+		"INSERT INTO t1 (a, b) VALUES ROW(1, 2), ROW(3, 4)":                    true,
+		"VALUES (1, 2)":                                                        false,
+		"SET @a := 1, @@GLOBAL.max_connections = 100":                          true,
+		"SET autocommit = ON":                                                  true,
+		"SET PERSIST_ONLY innodb_log_file_size = 1024":                         true,
+		"SET NAMES 'utf8mb4' COLLATE 'utf8mb4_unicode_ci'":                     true,
+		"SET CHARACTER SET DEFAULT":                                            true,
+		"SET GLOBAL TRANSACTION ISOLATION LEVEL READ COMMITTED":                true,
+		"SET TRANSACTION READ ONLY, ISOLATION LEVEL SERIALIZABLE":              true,
+		"SET PASSWORD = 'secret'":                                              true,
+		"SET PASSWORD FOR 'bob'@'%' = 'x' REPLACE 'y' RETAIN CURRENT PASSWORD": true,
+		"SET PASSWORD FOR CURRENT_USER() TO RANDOM":                            true,
+		"XA START 'trx1'":                                                      true,
+		"XA BEGIN 'trx1', 'branch1', 1 JOIN":                                   true,
+		"XA END X'7478', 'b' SUSPEND FOR MIGRATE":                              true,
+		"XA PREPARE 'trx1'":                                                    true,
+		"XA COMMIT 'trx1' ONE PHASE":                                           true,
+		"XA ROLLBACK 'trx1'":                                                   true,
+		"XA RECOVER CONVERT XID":                                               true,
+		"XA COMMIT":                                                            false,
+		"RESET REPLICA ALL":                                                    true,
+		"SELECT 1; -- trailing comment":                                        true,
+		"START TRANSACTION WITH CONSISTENT SNAPSHOT, READ ONLY":                true,
+		"BEGIN WORK":                     true,
+		"COMMIT AND NO CHAIN NO RELEASE": true,
+		"ROLLBACK WORK TO SAVEPOINT sp1": true,
+		"RELEASE SAVEPOINT sp1":          true,
+		"SAVEPOINT":                      false,
+		"LOCK TABLES t1 READ LOCAL, db.t2 AS x LOW_PRIORITY WRITE": true,
+		"UNLOCK TABLES": true,
+		"SELECT a, b INTO @x, @y FROM t1 WHERE id = 1":            true,
+		"ALTER TABLE t ADD c INT, ALGORITHM=inplace, LOCK=SHARED": true,
+		"ALTER TABLE t ADD c INT, ALGORITHM=FOO":                  false,
+		"ALTER TABLE t ADD c INT, LOCK=INPLACE":                   false,
 	}
 	for sql, result := range sqlmap {
 		statementList, err := Parse(sql)
@@ -26,3 +130,652 @@ func Test_Parser(t *testing.T) {
 		}
 	}
 }
+
+func Test_Table_Option_Reference(t *testing.T) {
+	sqlList := []string{
+		"CREATE TABLE c (id INT, pid INT, FOREIGN KEY (pid) REFERENCES p (id) ON DELETE CASCADE) ROW_FORMAT=DYNAMIC",
+		"CREATE TABLE c (id INT) ENGINE=INNODB ROW_FORMAT COMPRESSED COMMENT 'it''s'",
+		"ALTER TABLE c ROW_FORMAT=DEFAULT",
+	}
+	for _, sql := range sqlList {
+		statementList, err := Parse(sql)
+		if err != nil {
+			t.Errorf("%s: Error: %+v", sql, err)
+		} else if len(statementList) != 1 || statementList[0].Value() != sql {
+			t.Errorf("Respect: %s, Got: %+v", sql, statementList)
+		}
+	}
+}
+
+func Test_Select_Window(t *testing.T) {
+	statementList, err := Parse("SELECT RANK() OVER w FROM t WINDOW w AS (PARTITION BY a ORDER BY b DESC), w2 AS (w ROWS 2 PRECEDING)")
+	if err != nil {
+		t.Fatalf("Error: %+v", err)
+	}
+	s, ok := statementList[0].(*SelectStatement)
+	if !ok {
+		t.Fatalf("Respect: SelectStatement, Got: %s", statementList[0].Type())
+	}
+	if len(s.WindowList) != 2 {
+		t.Fatalf("Respect: 2 windows, Got: %d", len(s.WindowList))
+	}
+	if s.WindowList[0].Name != "w" || len(s.WindowList[0].Spec.PartitionList) != 1 || s.WindowList[0].Spec.OrderList == nil {
+		t.Errorf("Got unexpected window: %s", s.WindowList[0].Value())
+	}
+	frame := s.WindowList[1].Spec.Frame
+	if s.WindowList[1].Spec.Name != "w" || frame == nil || frame.Unit != "ROWS" || frame.End != nil {
+		t.Errorf("Got unexpected window: %s", s.WindowList[1].Value())
+	}
+}
+
+func Test_Insert_Values(t *testing.T) {
+	statementList, err := Parse("INSERT IGNORE INTO db.t PARTITION (p0) (a, b) VALUES (1, DEFAULT), (2, 'x') AS new ON DUPLICATE KEY UPDATE b = new.b")
+	if err != nil {
+		t.Fatalf("Error: %+v", err)
+	}
+	s, ok := statementList[0].(*InsertStatement)
+	if !ok {
+		t.Fatalf("Respect: InsertStatement, Got: %s", statementList[0].Type())
+	}
+	if !s.Ignore || len(s.PartitionList) != 1 || len(s.ColumnList) != 2 || s.RowAlias == "" {
+		t.Errorf("Got unexpected insert: %+v", s)
+	}
+	if len(s.ValuesList) != 2 || len(s.ValuesList[1]) != 2 || s.ValuesList[1][1].Value() != "'x'" {
+		t.Errorf("Got unexpected values: %+v", s.ValuesList)
+	}
+	if len(s.DuplicateUpdateList) != 1 || s.DuplicateUpdateList[0].Column.Column != "b" {
+		t.Errorf("Got unexpected update list: %+v", s.DuplicateUpdateList)
+	}
+}
+
+func Test_Update_Delete(t *testing.T) {
+	statementList, err := Parse("UPDATE db.t1 AS a JOIN t2 b ON a.id = b.id SET a.x = b.x WHERE b.z IN (SELECT z FROM t3) ORDER BY a.id DESC LIMIT 5")
+	if err != nil {
+		t.Fatalf("Error: %+v", err)
+	}
+	u, ok := statementList[0].(*UpdateStatement)
+	if !ok {
+		t.Fatalf("Respect: UpdateStatement, Got: %s", statementList[0].Type())
+	}
+	if len(u.TargetTables) != 1 || u.TargetTables[0].Database != "db" || u.TargetTables[0].Table != "t1" {
+		t.Errorf("Got unexpected target tables: %+v", u.TargetTables)
+	}
+	if len(u.ReadTables) != 2 || u.ReadTables[0].Table != "t2" || u.ReadTables[1].Table != "t3" {
+		t.Errorf("Got unexpected read tables: %+v", u.ReadTables)
+	}
+	if len(u.Assignments) != 1 || u.Where == nil || u.OrderBy == nil || u.Limit != "5" {
+		t.Errorf("Got unexpected update: %+v", u)
+	}
+
+	statementList, err = Parse("DELETE a FROM t1 AS a JOIN t2 ON a.id = t2.id WHERE t2.x = 1")
+	if err != nil {
+		t.Fatalf("Error: %+v", err)
+	}
+	d, ok := statementList[0].(*DeleteStatement)
+	if !ok {
+		t.Fatalf("Respect: DeleteStatement, Got: %s", statementList[0].Type())
+	}
+	if len(d.TargetTables) != 1 || d.TargetTables[0].Table != "t1" {
+		t.Errorf("Got unexpected target tables: %+v", d.TargetTables)
+	}
+	if len(d.ReadTables) != 1 || d.ReadTables[0].Table != "t2" || d.Where == nil {
+		t.Errorf("Got unexpected delete: %+v", d)
+	}
+
+	statementList, err = Parse("DELETE FROM t1 WHERE id IN (SELECT id FROM t2) ORDER BY id LIMIT 10")
+	if err != nil {
+		t.Fatalf("Error: %+v", err)
+	}
+	d, ok = statementList[0].(*DeleteStatement)
+	if !ok {
+		t.Fatalf("Respect: DeleteStatement, Got: %s", statementList[0].Type())
+	}
+	if len(d.TargetTables) != 1 || d.TargetTables[0].Table != "t1" || len(d.ReadTables) != 1 {
+		t.Errorf("Got unexpected tables: %+v, %+v", d.TargetTables, d.ReadTables)
+	}
+	if d.Where == nil || d.OrderBy == nil || d.Limit != "10" {
+		t.Errorf("Got unexpected delete: %+v", d)
+	}
+}
+
+func Test_Select_Locking(t *testing.T) {
+	statementList, err := Parse("SELECT * FROM t1 JOIN t2 ON t1.id = t2.id FOR SHARE OF t2 FOR UPDATE OF t1 SKIP LOCKED")
+	if err != nil {
+		t.Fatalf("Error: %+v", err)
+	}
+	s, ok := statementList[0].(*SelectStatement)
+	if !ok {
+		t.Fatalf("Respect: SelectStatement, Got: %s", statementList[0].Type())
+	}
+	if s.LockMode.LockType != LockTypeUpdate || s.LockMode.WaitPolicy != LockWaitSkipLocked || len(s.LockMode.TableList) != 2 {
+		t.Errorf("Got unexpected lock mode: %+v", s.LockMode)
+	}
+
+	statementList, err = Parse("SELECT a FROM t1 UNION SELECT a FROM t2 LOCK IN SHARE MODE")
+	if err != nil {
+		t.Fatalf("Error: %+v", err)
+	}
+	u, ok := statementList[0].(*UnionStatement)
+	if !ok {
+		t.Fatalf("Respect: UnionStatement, Got: %s", statementList[0].Type())
+	}
+	if u.LockMode.LockType != LockTypeShare || u.LockMode.WaitPolicy != LockWaitDefault {
+		t.Errorf("Got unexpected lock mode: %+v", u.LockMode)
+	}
+
+	statementList, err = Parse("(SELECT a FROM t1) UNION (SELECT a FROM t2) FOR UPDATE NOWAIT")
+	if err != nil {
+		t.Fatalf("Error: %+v", err)
+	}
+	u, ok = statementList[0].(*UnionStatement)
+	if !ok {
+		t.Fatalf("Respect: UnionStatement, Got: %s", statementList[0].Type())
+	}
+	if u.LockMode.LockType != LockTypeUpdate || u.LockMode.WaitPolicy != LockWaitNowait {
+		t.Errorf("Got unexpected lock mode: %+v", u.LockMode)
+	}
+}
+
+func Test_Prepare(t *testing.T) {
+	statementList, err := Parse("PREPARE stmt1 FROM 'SELECT * FROM t WHERE name = \\'a?\\' AND id = ?'; EXECUTE stmt1 USING @id; DEALLOCATE PREPARE stmt1")
+	if err != nil {
+		t.Fatalf("Error: %+v", err)
+	}
+	if len(statementList) != 3 {
+		t.Fatalf("Respect: 3 statements, Got: %d", len(statementList))
+	}
+	p, ok := statementList[0].(*PrepareStatement)
+	if !ok {
+		t.Fatalf("Respect: PrepareStatement, Got: %s", statementList[0].Type())
+	}
+	if p.Name != "stmt1" || p.SQL != "SELECT * FROM t WHERE name = 'a?' AND id = ?" {
+		t.Errorf("Got unexpected prepare: %s, %s", p.Name, p.SQL)
+	}
+	if p.Statement == nil || p.Statement.Type() != "SelectStatement" {
+		t.Errorf("Got unexpected prepared statement: %+v", p.Statement)
+	}
+	e, ok := statementList[1].(*ExecuteStatement)
+	if !ok || e.Name != "stmt1" || len(e.VariableList) != 1 || e.VariableList[0] != "@id" {
+		t.Errorf("Got unexpected execute: %+v", statementList[1])
+	}
+	d, ok := statementList[2].(*DeallocatePrepareStatement)
+	if !ok || d.Name != "stmt1" {
+		t.Errorf("Got unexpected deallocate: %+v", statementList[2])
+	}
+
+	posList, err := GetParamMarkerPosList(p.SQL)
+	if err != nil {
+		t.Fatalf("Error: %+v", err)
+	}
+	if len(posList) != 1 || p.SQL[posList[0]] != '?' || posList[0] != len(p.SQL)-1 {
+		t.Errorf("Got unexpected param marker positions: %+v", posList)
+	}
+	count, err := GetParamMarkerCount("SELECT ? FROM t WHERE a = ? -- ?\n LIMIT ?")
+	if err != nil || count != 3 {
+		t.Errorf("Respect: 3 param markers, Got: %d, %+v", count, err)
+	}
+
+	statementList, err = Parse("PREPARE stmt2 FROM 'SELEC 1'; PREPARE stmt3 FROM 'SELECT 1; SELECT 2'; PREPARE stmt4 FROM @sql")
+	if err != nil || len(statementList) != 3 {
+		t.Fatalf("Error: %+v", err)
+	}
+	p, ok = statementList[0].(*PrepareStatement)
+	if !ok || p.SQL != "SELEC 1" || p.Statement != nil || p.Error == nil {
+		t.Errorf("Got unexpected prepare with invalid statement: %+v", statementList[0])
+	}
+	p, ok = statementList[1].(*PrepareStatement)
+	if !ok || p.Statement != nil || p.Error == nil {
+		t.Errorf("Got unexpected prepare with multiple statements: %+v", statementList[1])
+	}
+	p, ok = statementList[2].(*PrepareStatement)
+	if !ok || p.Variable != "@sql" || p.Statement != nil || p.Error != nil {
+		t.Errorf("Got unexpected prepare from variable: %+v", statementList[2])
+	}
+}
+
+func Test_Table_Maintenance(t *testing.T) {
+	statementList, err := Parse("ANALYZE LOCAL TABLE db.t UPDATE HISTOGRAM ON a, `b` WITH 16 BUCKETS; CHECK TABLE t1, t2 FOR UPGRADE MEDIUM; REPAIR TABLE t QUICK USE_FRM")
+	if err != nil {
+		t.Fatalf("Error: %+v", err)
+	}
+	if len(statementList) != 3 {
+		t.Fatalf("Respect: 3 statements, Got: %d", len(statementList))
+	}
+	a, ok := statementList[0].(*AnalyzeTableStatement)
+	if !ok {
+		t.Fatalf("Respect: AnalyzeTableStatement, Got: %s", statementList[0].Type())
+	}
+	if !a.NoWriteToBinlog || len(a.TableList) != 1 || a.DatabaseList[0] != "db" || a.HistogramAction != "UPDATE" ||
+		len(a.HistogramColumnList) != 2 || a.HistogramColumnList[1] != "b" || a.Buckets != "16" {
+		t.Errorf("Got unexpected analyze: %+v", a)
+	}
+	c, ok := statementList[1].(*CheckTableStatement)
+	if !ok || len(c.TableList) != 2 || len(c.OptionList) != 2 || c.OptionList[0] != "FOR UPGRADE" {
+		t.Errorf("Got unexpected check: %+v", statementList[1])
+	}
+	r, ok := statementList[2].(*RepairTableStatement)
+	if !ok || !r.Quick || r.Extended || !r.UseFrm {
+		t.Errorf("Got unexpected repair: %+v", statementList[2])
+	}
+}
+
+func Test_Administration(t *testing.T) {
+	statementList, err := Parse("FLUSH LOCAL TABLES t1, db.t2 WITH READ LOCK; FLUSH BINARY LOGS, PRIVILEGES; KILL CONNECTION 42; PURGE BINARY LOGS TO 'mysql-bin.000003'; RESET MASTER; SHUTDOWN")
+	if err != nil {
+		t.Fatalf("Error: %+v", err)
+	}
+	if len(statementList) != 6 {
+		t.Fatalf("Respect: 6 statements, Got: %d", len(statementList))
+	}
+	f, ok := statementList[0].(*FlushStatement)
+	if !ok || !f.NoWriteToBinlog || !f.WithReadLock || len(f.TableList) != 2 || f.DatabaseList[1] != "db" {
+		t.Errorf("Got unexpected flush: %+v", statementList[0])
+	}
+	f, ok = statementList[1].(*FlushStatement)
+	if !ok || len(f.OptionList) != 2 || f.OptionList[0] != "BINARY LOGS" || f.OptionList[1] != "PRIVILEGES" {
+		t.Errorf("Got unexpected flush: %+v", statementList[1])
+	}
+	k, ok := statementList[2].(*KillStatement)
+	if !ok || k.Query || k.ProcessId != "42" {
+		t.Errorf("Got unexpected kill: %+v", statementList[2])
+	}
+	p, ok := statementList[3].(*PurgeBinaryLogsStatement)
+	if !ok || p.LogName != "mysql-bin.000003" {
+		t.Errorf("Got unexpected purge: %+v", statementList[3])
+	}
+	r, ok := statementList[4].(*ResetStatement)
+	if !ok || len(r.OptionList) != 1 || r.OptionList[0] != "MASTER" {
+		t.Errorf("Got unexpected reset: %+v", statementList[4])
+	}
+	if _, ok := statementList[5].(*ShutdownStatement); !ok {
+		t.Errorf("Respect: ShutdownStatement, Got: %s", statementList[5].Type())
+	}
+}
+
+func Test_Replication(t *testing.T) {
+	statementList, err := Parse("CHANGE MASTER TO MASTER_HOST='db1', MASTER_USER='repl', MASTER_PASSWORD='secret', MASTER_LOG_FILE='mysql-bin.000003', MASTER_LOG_POS=4 FOR CHANNEL c1; " +
+		"CHANGE REPLICATION FILTER REPLICATE_DO_TABLE = (db.t1, t2), REPLICATE_REWRITE_DB = ((a, b), (c, d)); " +
+		"START SLAVE IO_THREAD, SQL_THREAD UNTIL MASTER_LOG_FILE = 'mysql-bin.000010', MASTER_LOG_POS = 100 USER='repl' PASSWORD='secret'; " +
+		"STOP REPLICA SQL_THREAD; RESET SLAVE ALL FOR CHANNEL c1")
+	if err != nil {
+		t.Fatalf("Error: %+v", err)
+	}
+	if len(statementList) != 5 {
+		t.Fatalf("Respect: 5 statements, Got: %d", len(statementList))
+	}
+	c, ok := statementList[0].(*ChangeMasterStatement)
+	if !ok || c.OptionMap["MASTER_HOST"] != "db1" || c.OptionMap["MASTER_LOG_POS"] != "4" || c.Channel != "c1" {
+		t.Errorf("Got unexpected change master: %+v", statementList[0])
+	}
+	if password, ok := c.GetOption("SOURCE_PASSWORD"); !ok || password != "secret" {
+		t.Errorf("Respect: secret, Got: %s", password)
+	}
+	if strings.Contains(c.Value(), "secret") || !strings.Contains(c.Value(), "MASTER_PASSWORD='***'") {
+		t.Errorf("Got unredacted value: %s", c.Value())
+	}
+	f, ok := statementList[1].(*ChangeReplicationFilterStatement)
+	if !ok || len(f.FilterList) != 2 || f.FilterList[0].Name != "REPLICATE_DO_TABLE" ||
+		len(f.FilterList[0].ValueList) != 2 || f.FilterList[0].ValueList[0] != "db.t1" ||
+		len(f.FilterList[1].RewriteList) != 2 || f.FilterList[1].RewriteList[1] != [2]string{"c", "d"} {
+		t.Errorf("Got unexpected change replication filter: %+v", statementList[1])
+	}
+	s, ok := statementList[2].(*StartSlaveStatement)
+	if !ok || len(s.ThreadList) != 2 || s.UntilMap["MASTER_LOG_POS"] != "100" || s.User != "repl" || s.Password != "secret" {
+		t.Errorf("Got unexpected start slave: %+v", statementList[2])
+	}
+	if strings.Contains(s.Value(), "secret") {
+		t.Errorf("Got unredacted value: %s", s.Value())
+	}
+	p, ok := statementList[3].(*StopSlaveStatement)
+	if !ok || len(p.ThreadList) != 1 || p.ThreadList[0] != "SQL_THREAD" {
+		t.Errorf("Got unexpected stop slave: %+v", statementList[3])
+	}
+	r, ok := statementList[4].(*ResetSlaveStatement)
+	if !ok || !r.All || r.Channel != "c1" {
+		t.Errorf("Got unexpected reset slave: %+v", statementList[4])
+	}
+}
+
+func Test_Replication_Channel(t *testing.T) {
+	sqlList := []string{
+		"CHANGE MASTER TO MASTER_HOST='h' FOR CHANNEL 'c1'",
+		"CHANGE REPLICATION FILTER REPLICATE_DO_DB = (d1) FOR CHANNEL \"c1\"",
+		"FLUSH RELAY LOGS FOR CHANNEL 'c1'",
+		"RESET SLAVE ALL FOR CHANNEL 'c1'",
+		"START REPLICA FOR CHANNEL 'c1'",
+		"STOP SLAVE IO_THREAD FOR CHANNEL `c1`",
+	}
+	for _, sql := range sqlList {
+		statementList, err := Parse(sql)
+		if err != nil || len(statementList) != 1 {
+			t.Errorf("%s: Error: %+v", sql, err)
+			continue
+		}
+		channel := ""
+		switch s := statementList[0].(type) {
+		case *ChangeMasterStatement:
+			channel = s.Channel
+		case *ChangeReplicationFilterStatement:
+			channel = s.Channel
+		case *FlushStatement:
+			channel = s.Channel
+		case *ResetSlaveStatement:
+			channel = s.Channel
+		case *StartSlaveStatement:
+			channel = s.Channel
+		case *StopSlaveStatement:
+			channel = s.Channel
+		}
+		if channel != "c1" || statementList[0].Value() != sql {
+			t.Errorf("%s: Got unexpected channel %s: %+v", sql, channel, statementList[0])
+		}
+	}
+}
+
+func Test_XA(t *testing.T) {
+	statementList, err := Parse("XA START 'gtrid1', 'bqual1', 3 RESUME; XA END 'gtrid1' SUSPEND; XA COMMIT 'gtrid1', 'bqual1' ONE PHASE; XA RECOVER")
+	if err != nil {
+		t.Fatalf("Error: %+v", err)
+	}
+	if len(statementList) != 4 {
+		t.Fatalf("Respect: 4 statements, Got: %d", len(statementList))
+	}
+	x, ok := statementList[0].(*XAStatement)
+	if !ok || x.Action != "START" || !x.Resume || x.Xid == nil ||
+		x.Xid.Gtrid != "gtrid1" || x.Xid.Bqual != "bqual1" || x.Xid.FormatId != "3" {
+		t.Errorf("Got unexpected xa start: %+v", statementList[0])
+	}
+	x, ok = statementList[1].(*XAStatement)
+	if !ok || x.Action != "END" || !x.Suspend || x.ForMigrate || x.Xid.Bqual != "" {
+		t.Errorf("Got unexpected xa end: %+v", statementList[1])
+	}
+	x, ok = statementList[2].(*XAStatement)
+	if !ok || x.Action != "COMMIT" || !x.OnePhase || x.Xid.Bqual != "bqual1" {
+		t.Errorf("Got unexpected xa commit: %+v", statementList[2])
+	}
+	x, ok = statementList[3].(*XAStatement)
+	if !ok || x.Action != "RECOVER" || x.Xid != nil || x.ConvertXid {
+		t.Errorf("Got unexpected xa recover: %+v", statementList[3])
+	}
+
+	sqlList := []string{"XA START 'tx', 'b'", "XA START X'7478', x'62'", "XA START 0x7478, 0x62", "XA START 0x747, 'b'"}
+	gtridList := []string{"tx", "tx", "tx", "\x07\x47"}
+	for i, sql := range sqlList {
+		statementList, err := Parse(sql)
+		if err != nil || len(statementList) != 1 {
+			t.Errorf("%s: Error: %+v", sql, err)
+			continue
+		}
+		x, ok := statementList[0].(*XAStatement)
+		if !ok || x.Xid == nil || x.Xid.Gtrid != gtridList[i] || x.Xid.Bqual != "b" || x.Value() != sql {
+			t.Errorf("%s: Got unexpected xid: %+v", sql, statementList[0])
+		}
+	}
+}
+
+func Test_Call_Do_Handler(t *testing.T) {
+	statementList, err := Parse("CALL db.p(1, a + 2, (SELECT id FROM t1)); DO (SELECT COUNT(*) FROM db.t2), 1; HANDLER db.t3 OPEN AS h; HANDLER h READ `idx` <= (1, 2) WHERE c = 1 LIMIT 10; HANDLER h READ NEXT")
+	if err != nil {
+		t.Fatalf("Error: %+v", err)
+	}
+	if len(statementList) != 5 {
+		t.Fatalf("Respect: 5 statements, Got: %d", len(statementList))
+	}
+	c, ok := statementList[0].(*CallStatement)
+	if !ok || c.Database != "db" || c.Procedure != "p" || len(c.ArgumentList) != 3 || len(c.TableList) != 1 || c.TableList[0] != "t1" {
+		t.Errorf("Got unexpected call: %+v", statementList[0])
+	}
+	d, ok := statementList[1].(*DoStatement)
+	if !ok || len(d.ExpressionList) != 2 || len(d.TableList) != 1 || d.DatabaseList[0] != "db" {
+		t.Errorf("Got unexpected do: %+v", statementList[1])
+	}
+	h, ok := statementList[2].(*HandlerStatement)
+	if !ok || h.Action != "OPEN" || h.Database != "db" || h.Table != "t3" || h.Alias != "h" {
+		t.Errorf("Got unexpected handler open: %+v", statementList[2])
+	}
+	h, ok = statementList[3].(*HandlerStatement)
+	if !ok || h.Action != "READ" || h.Table != "h" || h.Index != "idx" || h.Operator != "<=" ||
+		len(h.ValueList) != 2 || h.Where == nil || h.Limit != "10" {
+		t.Errorf("Got unexpected handler read: %+v", statementList[3])
+	}
+	h, ok = statementList[4].(*HandlerStatement)
+	if !ok || h.Action != "READ" || h.Index != "" || h.Position != "NEXT" {
+		t.Errorf("Got unexpected handler read: %+v", statementList[4])
+	}
+}
+
+func Test_Table_Values(t *testing.T) {
+	statementList, err := Parse("TABLE db.t1 ORDER BY a LIMIT 3 OFFSET 1; VALUES ROW(1, 'a'), ROW(2, (SELECT MAX(b) FROM t2)) LIMIT 1; TABLE t3 UNION VALUES ROW(1); INSERT INTO t4 TABLE db.t5; INSERT INTO t6 VALUES ROW(1, 2)")
+	if err != nil {
+		t.Fatalf("Error: %+v", err)
+	}
+	if len(statementList) != 5 {
+		t.Fatalf("Respect: 5 statements, Got: %d", len(statementList))
+	}
+	tb, ok := statementList[0].(*TableStatement)
+	if !ok || tb.DatabaseList[0] != "db" || tb.TableList[0] != "t1" || tb.OrderBy == nil || tb.Limit != "3" || tb.Offset != "1" {
+		t.Errorf("Got unexpected table: %+v", statementList[0])
+	}
+	v, ok := statementList[1].(*ValuesStatement)
+	if !ok || len(v.RowList) != 2 || len(v.RowList[1]) != 2 || len(v.TableList) != 1 || v.TableList[0] != "t2" || v.Limit != "1" {
+		t.Errorf("Got unexpected values: %+v", statementList[1])
+	}
+	u, ok := statementList[2].(*UnionStatement)
+	if !ok || len(u.TableList) != 1 || u.TableList[0] != "t3" {
+		t.Errorf("Got unexpected union: %+v", statementList[2])
+	}
+	i, ok := statementList[3].(*InsertStatement)
+	if !ok || i.TableList[0] != "t4" || len(i.FromTableList) != 1 || i.FromDatabaseList[0] != "db" || i.FromTableList[0] != "t5" {
+		t.Errorf("Got unexpected insert: %+v", statementList[3])
+	}
+	i, ok = statementList[4].(*InsertStatement)
+	if !ok || len(i.ValuesList) != 1 || len(i.ValuesList[0]) != 2 {
+		t.Errorf("Got unexpected insert: %+v", statementList[4])
+	}
+}
+
+func Test_Set(t *testing.T) {
+	statementList, err := Parse("SET @a = 1, GLOBAL max_connections = 100, @@session.sql_mode = 'ANSI', PERSIST x = 1, autocommit = 0; " +
+		"SET NAMES utf8mb4 COLLATE utf8mb4_general_ci; SET CHARSET 'latin1'; " +
+		"SET SESSION TRANSACTION ISOLATION LEVEL REPEATABLE READ, READ WRITE; SET PASSWORD FOR 'bob'@'localhost' = 'secret'")
+	if err != nil {
+		t.Fatalf("Error: %+v", err)
+	}
+	if len(statementList) != 5 {
+		t.Fatalf("Respect: 5 statements, Got: %d", len(statementList))
+	}
+	s, ok := statementList[0].(*SetStatement)
+	if !ok || s.Kind != SetKindVariable || len(s.AssignmentList) != 5 {
+		t.Fatalf("Got unexpected set: %+v", statementList[0])
+	}
+	scopeList := []VariableScope{VariableScopeUser, VariableScopeGlobal, VariableScopeSession, VariableScopePersist, VariableScopeDefault}
+	nameList := []string{"a", "max_connections", "sql_mode", "x", "autocommit"}
+	valueList := []string{"1", "100", "'ANSI'", "1", "0"}
+	for index, assignment := range s.AssignmentList {
+		if assignment.Scope != scopeList[index] || assignment.Name != nameList[index] || assignment.ValueText != valueList[index] {
+			t.Errorf("Got unexpected assignment: %+v", assignment)
+		}
+	}
+	s, ok = statementList[1].(*SetStatement)
+	if !ok || s.Kind != SetKindNames || s.Charset != "utf8mb4" || s.Collation != "utf8mb4_general_ci" {
+		t.Errorf("Got unexpected set names: %+v", statementList[1])
+	}
+	s, ok = statementList[2].(*SetStatement)
+	if !ok || s.Kind != SetKindCharacterSet || s.Charset != "latin1" || s.DefaultCharset {
+		t.Errorf("Got unexpected set charset: %+v", statementList[2])
+	}
+	s, ok = statementList[3].(*SetStatement)
+	if !ok || s.Kind != SetKindTransaction || s.TransactionScope != VariableScopeSession ||
+		s.IsolationLevel != "REPEATABLE READ" || s.AccessMode != "READ WRITE" {
+		t.Errorf("Got unexpected set transaction: %+v", statementList[3])
+	}
+	s, ok = statementList[4].(*SetStatement)
+	if !ok || s.Kind != SetKindPassword || s.User != "bob" || s.Host != "localhost" || s.Password != "secret" {
+		t.Errorf("Got unexpected set password: %+v", statementList[4])
+	}
+
+	sqlList := map[string]string{
+		"SET sql_mode='a', NAMES utf8":                      "sql_mode='a' NAMES=utf8",
+		"SET NAMES 'utf8mb4' COLLATE utf8mb4_bin, @x = 1":   "NAMES=utf8mb4 x=1",
+		"SET @x = 1, CHARACTER SET DEFAULT, autocommit = 1": "x=1 CHARACTER SET=DEFAULT autocommit=1",
+		"SET CHARSET latin1, SESSION sql_mode = 'ANSI'":     "CHARACTER SET=latin1 sql_mode='ANSI'",
+	}
+	for sql, expected := range sqlList {
+		statementList, err := Parse(sql)
+		if err != nil || len(statementList) != 1 {
+			t.Errorf("%s: Error: %+v", sql, err)
+			continue
+		}
+		s, ok := statementList[0].(*SetStatement)
+		if !ok || s.Kind != SetKindVariable || s.Value() != sql {
+			t.Errorf("%s: Got unexpected set: %+v", sql, statementList[0])
+			continue
+		}
+		resultList := make([]string, 0)
+		for _, assignment := range s.AssignmentList {
+			resultList = append(resultList, assignment.Name+"="+assignment.ValueText)
+		}
+		if strings.Join(resultList, " ") != expected {
+			t.Errorf("%s: Respect: %s, Got: %s", sql, expected, strings.Join(resultList, " "))
+		}
+	}
+	statementList, err = Parse("SET NAMES 'utf8mb4' COLLATE utf8mb4_bin, @x = 1; SET NAMES DEFAULT")
+	if err != nil || len(statementList) != 2 {
+		t.Fatalf("Error: %+v", err)
+	}
+	s, ok = statementList[0].(*SetStatement)
+	if !ok || s.Charset != "utf8mb4" || s.Collation != "utf8mb4_bin" || s.AssignmentList[0].Collation != "utf8mb4_bin" {
+		t.Errorf("Got unexpected set names with variable: %+v", statementList[0])
+	}
+	s, ok = statementList[1].(*SetStatement)
+	if !ok || s.Kind != SetKindNames || !s.DefaultCharset || len(s.AssignmentList) != 1 {
+		t.Errorf("Got unexpected set names default: %+v", statementList[1])
+	}
+}
+
+func Test_Show(t *testing.T) {
+	statementList, err := Parse("SHOW FULL COLUMNS FROM t1 FROM db1 LIKE 'id%'; SHOW GLOBAL VARIABLES WHERE Variable_name = 'port'; " +
+		"SHOW CREATE PROCEDURE proc1; SHOW ENGINE InnoDB STATUS; SHOW COUNT(*) WARNINGS; SHOW FULL PROCESSLIST; " +
+		"SHOW TABLE STATUS FROM db2; SHOW MASTER LOGS; SHOW PROFILE CPU FOR QUERY 1")
+	if err != nil {
+		t.Fatalf("Error: %+v", err)
+	}
+	if len(statementList) != 9 {
+		t.Fatalf("Respect: 9 statements, Got: %d", len(statementList))
+	}
+	s, ok := statementList[0].(*ShowStatement)
+	if !ok || s.Kind != ShowKindColumns || !s.Full || s.Database != "db1" || s.Table != "t1" || s.Like != "id%" {
+		t.Errorf("Got unexpected show columns: %+v", statementList[0])
+	}
+	s, ok = statementList[1].(*ShowStatement)
+	if !ok || s.Kind != ShowKindVariables || s.Scope != VariableScopeGlobal || s.Where == nil {
+		t.Errorf("Got unexpected show variables: %+v", statementList[1])
+	}
+	s, ok = statementList[2].(*ShowStatement)
+	if !ok || s.Kind != ShowKindCreateProcedure || s.Object != "proc1" {
+		t.Errorf("Got unexpected show create procedure: %+v", statementList[2])
+	}
+	s, ok = statementList[3].(*ShowStatement)
+	if !ok || s.Kind != ShowKindEngineStatus || !strings.EqualFold(s.Object, "InnoDB") {
+		t.Errorf("Got unexpected show engine: %+v", statementList[3])
+	}
+	s, ok = statementList[4].(*ShowStatement)
+	if !ok || s.Kind != ShowKindWarnings || !s.Count {
+		t.Errorf("Got unexpected show warnings: %+v", statementList[4])
+	}
+	s, ok = statementList[5].(*ShowStatement)
+	if !ok || s.Kind != ShowKindProcesslist || !s.Full {
+		t.Errorf("Got unexpected show processlist: %+v", statementList[5])
+	}
+	s, ok = statementList[6].(*ShowStatement)
+	if !ok || s.Kind != ShowKindTableStatus || s.Database != "db2" {
+		t.Errorf("Got unexpected show table status: %+v", statementList[6])
+	}
+	s, ok = statementList[7].(*ShowStatement)
+	if !ok || s.Kind != ShowKindBinaryLogs {
+		t.Errorf("Got unexpected show logs: %+v", statementList[7])
+	}
+	s, ok = statementList[8].(*ShowStatement)
+	if !ok || s.Kind != ShowKindProfile {
+		t.Errorf("Got unexpected show profile: %+v", statementList[8])
+	}
+}
+
+func Test_Show_Qualified_Name(t *testing.T) {
+	sqlList := map[string]ShowKind{
+		"SHOW CREATE PROCEDURE db.p":  ShowKindCreateProcedure,
+		"SHOW CREATE FUNCTION `db`.p": ShowKindCreateFunction,
+		"SHOW CREATE EVENT db.`p`":    ShowKindCreateEvent,
+		"SHOW CREATE TRIGGER db.p":    ShowKindCreateTrigger,
+		"SHOW CREATE VIEW db . p":     ShowKindCreateView,
+		"SHOW PROCEDURE CODE db.p":    ShowKindProcedureCode,
+		"SHOW FUNCTION CODE `db`.`p`": ShowKindFunctionCode,
+	}
+	for sql, kind := range sqlList {
+		statementList, err := Parse(sql)
+		if err != nil || len(statementList) != 1 {
+			t.Errorf("%s: Error: %+v", sql, err)
+			continue
+		}
+		s, ok := statementList[0].(*ShowStatement)
+		if !ok || s.Kind != kind || s.Database != "db" || s.Object != "p" || s.Table != "" ||
+			len(s.TableList) != 0 || s.Value() != sql {
+			t.Errorf("%s: Got unexpected show statement: %+v", sql, statementList[0])
+		}
+	}
+}
+
+func Test_Transaction_Lock(t *testing.T) {
+	statementList, err := Parse("START TRANSACTION READ WRITE, WITH CONSISTENT SNAPSHOT; ROLLBACK TO sp1; COMMIT WORK AND CHAIN; LOCK TABLE t1 AS a READ, db.t2 WRITE; UNLOCK TABLES")
+	if err != nil {
+		t.Fatalf("Error: %+v", err)
+	}
+	if len(statementList) != 5 {
+		t.Fatalf("Respect: 5 statements, Got: %d", len(statementList))
+	}
+	x, ok := statementList[0].(*TransactionStatement)
+	if !ok || x.Action != "START" || !x.ConsistentSnapshot || x.AccessMode != "READ WRITE" {
+		t.Errorf("Got unexpected start transaction: %+v", statementList[0])
+	}
+	x, ok = statementList[1].(*TransactionStatement)
+	if !ok || x.Action != "ROLLBACK" || x.Savepoint != "sp1" {
+		t.Errorf("Got unexpected rollback: %+v", statementList[1])
+	}
+	x, ok = statementList[2].(*TransactionStatement)
+	if !ok || x.Action != "COMMIT" || !x.Chain || x.Release {
+		t.Errorf("Got unexpected commit: %+v", statementList[2])
+	}
+	l, ok := statementList[3].(*LockTablesStatement)
+	if !ok || l.Unlock || strings.Join(l.DatabaseList, ",") != ",db" || strings.Join(l.TableList, ",") != "t1,t2" ||
+		strings.Join(l.AliasList, ",") != "a," || strings.Join(l.LockTypeList, ",") != "READ,WRITE" {
+		t.Errorf("Got unexpected lock tables: %+v", statementList[3])
+	}
+	l, ok = statementList[4].(*LockTablesStatement)
+	if !ok || !l.Unlock || len(l.TableList) != 0 {
+		t.Errorf("Got unexpected unlock tables: %+v", statementList[4])
+	}
+}
+
+func Test_Select_Into(t *testing.T) {
+	sqlList := map[string]string{
+		"SELECT a, b INTO @x, @y FROM t WHERE id = 1 FOR UPDATE": "t",
+		"SELECT 1 INTO @x":                           "",
+		"SELECT a FROM t INTO OUTFILE '/tmp/a.txt'":  "t",
+		"SELECT a FROM t LIMIT 1 INTO DUMPFILE '/x'": "t",
+		"SELECT a FROM t AS info":                    "t",
+	}
+	for sql, tables := range sqlList {
+		statementList, err := Parse(sql)
+		if err != nil {
+			t.Errorf("%s: Error: %+v", sql, err)
+			continue
+		}
+		s, ok := statementList[0].(*SelectStatement)
+		if !ok || len(statementList) != 1 || s.Value() != sql || strings.Join(s.TableList, ",") != tables {
+			t.Errorf("%s: Got unexpected select: %+v", sql, statementList[0])
+		}
+	}
+	if statementList, err := Parse("SELECT a INTO @x, FROM t"); err == nil && statementList[0].Value() == "SELECT a INTO @x, FROM t" {
+		t.Errorf("Respect incomplete INTO list not to be accepted")
+	}
+}
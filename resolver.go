@@ -0,0 +1,1053 @@
+package mysqlparser_go
+
+import (
+	"strings"
+)
+
+// ColumnReference 语句中的一处列引用及其绑定的来源表
+type ColumnReference struct {
+	Database       string        // 引用中书写的库名
+	Table          string        // 引用中书写的表名或别名
+	Column         string        // 引用中书写的列名，由*展开时为来源表的列名
+	Clause         string        // 引用所在子句，取值与MySQL报错信息一致，如field list、where clause
+	SourceDatabase string        // 来源表所在库，来源为派生表时为空
+	SourceTable    string        // 来源表名，来源为派生表时为派生表别名
+	SourceAlias    string        // 来源表在查询中的名称，即别名或表名
+	SourceColumn   string        // 来源表中的列名，大小写以表定义为准
+	Derived        bool          // 来源为派生表
+	Outer          bool          // 关联子查询中对外层查询的引用
+	SelectAlias    bool          // 引用的是SELECT列表中的别名
+	Wildcard       bool          // 由*或tbl.*展开得到
+	Write          bool          // 引用为写入目标，如UPDATE的SET列、INSERT的列列表
+	Error          *CatalogError // 列不存在或有歧义时的错误
+
+	scope      *resolveScope   // 引用所在的查询
+	sourceList []*ColumnAccess // 引用最终来源的实体表列，经派生表时为派生表对应列的来源
+	direct     bool            // 引用的值直接取自来源列，未经计算
+}
+
+// queryColumn 查询输出的一列及其来源
+type queryColumn struct {
+	name       string
+	sourceList []*ColumnAccess
+	direct     bool
+}
+
+// resolveSource 查询中可被列引用的来源表
+type resolveSource struct {
+	alias      string
+	database   string
+	table      string
+	derived    bool
+	unknown    bool // 来源表结构未知，不检查列是否存在
+	qualified  bool // 只能通过限定名引用
+	columnList []string
+	outputList []*queryColumn // 派生表各列的来源，与columnList对应
+}
+
+// resolveScope 一层查询中可见的来源表
+type resolveScope struct {
+	parent        *resolveScope
+	sourceList    []*resolveSource
+	joinColumnMap map[string][]*resolveSource // USING/NATURAL合并的列，键为小写列名
+	aliasList     []string                    // SELECT列表中的别名
+}
+
+// nonColumnKeyword 表达式中不会作为列名的关键字
+var nonColumnKeyword = []string{"DEFAULT", "FALSE", "NULL", "TRUE", "UNKNOWN"}
+
+// columnResolver 解析过程中的状态，catalog为nil时来源表结构均视为未知
+type columnResolver struct {
+	catalog       *Catalog
+	referenceList []*ColumnReference
+	subQueryMap   map[*SubQueryComponent][]*queryColumn
+	outputList    []*queryColumn // INSERT ... SELECT或CREATE TABLE ... AS SELECT中查询输出的列
+	selectScope   *resolveScope  // INSERT ... SELECT中SELECT的作用域，SELECT为UNION时为nil
+	updateList    []*queryColumn // ON DUPLICATE KEY UPDATE中的赋值，name为写入的列
+	err           error
+}
+
+// ResolveSQL 解析SQL并依次处理其中的语句，DML语句绑定列引用，DDL及USE语句应用到库表结构
+func (c *Catalog) ResolveSQL(sql string) ([]*ColumnReference, error) {
+	statementList, err := Parse(sql)
+	if err != nil {
+		return nil, err
+	}
+	referenceList := make([]*ColumnReference, 0)
+	var firstErr error
+	for _, s := range statementList {
+		statementReferenceList, err := c.Resolve(s)
+		referenceList = append(referenceList, statementReferenceList...)
+		if err == nil {
+			err = c.Apply(s)
+		}
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return referenceList, firstErr
+}
+
+// Resolve 将DML语句中的列引用绑定到来源表，返回全部列引用及遇到的第一个错误
+// 处理表别名、派生表、USING/NATURAL连接和关联子查询，其他语句返回空列表
+func (c *Catalog) Resolve(s MySQLStatement) ([]*ColumnReference, error) {
+	return resolveColumnReference(s, c)
+}
+
+// resolveColumnReference 绑定语句中的列引用，catalog为nil时仅按表名和别名绑定
+func resolveColumnReference(s MySQLStatement, c *Catalog) ([]*ColumnReference, error) {
+	r := newColumnResolver(c)
+	r.resolveStatement(s)
+	return r.referenceList, r.err
+}
+
+func newColumnResolver(c *Catalog) *columnResolver {
+	return &columnResolver{
+		catalog:       c,
+		referenceList: make([]*ColumnReference, 0),
+		subQueryMap:   make(map[*SubQueryComponent][]*queryColumn),
+	}
+}
+
+// resolveStatement 按语句类型绑定列引用
+func (r *columnResolver) resolveStatement(s MySQLStatement) {
+	switch s.Type() {
+	case "SelectStatement", "UnionStatement", "TableStatement":
+		r.resolveQuery(s, nil)
+	case "CreateTableStatement":
+		if s.(*CreateTableStatement).Select != nil {
+			r.outputList = r.resolveQuery(s.(*CreateTableStatement).Select, nil)
+		}
+	case "UpdateStatement":
+		r.resolveUpdate(s.(*UpdateStatement))
+	case "DeleteStatement":
+		r.resolveDelete(s.(*DeleteStatement))
+	case "InsertStatement":
+		insert := s.(*InsertStatement)
+		scope := r.resolveInsert(insert.ObjectList, insert.ColumnList, insert.ValuesList, insert.SetList)
+		if scope != nil && len(insert.DuplicateUpdateList) > 0 {
+			if insert.RowAlias != "" {
+				r.addRowAlias(scope, insert.RowAlias, insert.ColumnAliasList, insert.ColumnList)
+			}
+			r.resolveDuplicateUpdateList(insert.DuplicateUpdateList, scope)
+		}
+	case "ReplaceStatement":
+		replace := s.(*ReplaceStatement)
+		r.resolveInsert(replace.ObjectList, replace.ColumnList, replace.ValuesList, replace.SetList)
+	}
+}
+
+func newResolveScope(parent *resolveScope) *resolveScope {
+	return &resolveScope{
+		parent:        parent,
+		sourceList:    make([]*resolveSource, 0),
+		joinColumnMap: make(map[string][]*resolveSource),
+		aliasList:     make([]string, 0),
+	}
+}
+
+func (s *resolveSource) name() string {
+	if s.alias != "" {
+		return s.alias
+	}
+	return s.table
+}
+
+func (s *resolveSource) hasColumn(column string) bool {
+	for _, c := range s.columnList {
+		if strings.EqualFold(c, column) {
+			return true
+		}
+	}
+	return false
+}
+
+// findSource 按限定名查找来源表，指定库名时只匹配未使用别名的实体表
+func (s *resolveScope) findSource(database string, table string) *resolveSource {
+	for _, source := range s.sourceList {
+		if database == "" && source.name() == table {
+			return source
+		}
+		if database != "" && !source.derived && source.alias == "" && source.database == database &&
+			source.table == table {
+			return source
+		}
+	}
+	return nil
+}
+
+// findColumn 查找包含指定列的来源表，USING/NATURAL合并的列绑定到最左侧的表
+// 无匹配但存在结构未知的来源表时，返回该来源表或在多于一个时返回stop为true
+func (s *resolveScope) findColumn(column string) (source *resolveSource, ambiguous bool, stop bool) {
+	matchList := make([]*resolveSource, 0)
+	unknownList := make([]*resolveSource, 0)
+	for _, source := range s.sourceList {
+		if source.qualified {
+			continue
+		} else if source.unknown {
+			unknownList = append(unknownList, source)
+		} else if source.hasColumn(column) {
+			matchList = append(matchList, source)
+		}
+	}
+	if len(matchList) == 1 {
+		return matchList[0], false, false
+	} else if len(matchList) > 1 {
+		group := s.joinColumnMap[strings.ToLower(column)]
+		for _, match := range matchList {
+			if !containsSource(group, match) {
+				return nil, true, false
+			}
+		}
+		return group[0], false, false
+	} else if len(unknownList) == 1 {
+		return unknownList[0], false, false
+	}
+	return nil, false, len(unknownList) > 1
+}
+
+func containsSource(sourceList []*resolveSource, source *resolveSource) bool {
+	for _, s := range sourceList {
+		if s == source {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *resolveScope) hasAlias(column string) bool {
+	for _, alias := range s.aliasList {
+		if strings.EqualFold(alias, column) {
+			return true
+		}
+	}
+	return false
+}
+
+// joinColumn 合并USING/NATURAL连接两侧的同名列，已合并过的列加入原有的组
+func (s *resolveScope) joinColumn(column string, left *resolveSource, right *resolveSource) {
+	key := strings.ToLower(column)
+	group := s.joinColumnMap[key]
+	for _, source := range []*resolveSource{left, right} {
+		if !containsSource(group, source) {
+			group = append(group, source)
+		}
+	}
+	s.joinColumnMap[key] = group
+}
+
+// addError 记录第一个错误
+func (r *columnResolver) addError(err error) {
+	if r.err == nil {
+		r.err = err
+	}
+}
+
+// resolveQuery 解析SELECT或UNION语句，返回其输出的列
+// UNION的列名取自第一个查询，各列的来源为所有查询对应位置的列的来源
+// UNION的ORDER BY(包括最后一个不带括号的查询之后的)引用UNION输出的列
+func (r *columnResolver) resolveQuery(s MySQLStatement, parent *resolveScope) []*queryColumn {
+	if s.Type() == "SelectStatement" {
+		return r.resolveSelect(s.(*SelectStatement), parent, nil)
+	} else if s.Type() == "TableStatement" {
+		return r.resolveTable(s.(*TableStatement), parent)
+	} else if s.Type() == "UnionStatement" {
+		var columnList []*queryColumn
+		orderList := make([]*MySQLObject, 0)
+		validList := getValidObjectList(s.(*UnionStatement).ObjectList)
+		lastKeyword := ""
+		for i, t := range validList {
+			var selectColumnList []*queryColumn
+			if (*t).Type() == "MySQLKeywordToken" {
+				lastKeyword = (*t).Value()
+				continue
+			} else if (*t).Type() == "SelectStatement" && i == len(validList)-1 {
+				selectColumnList = r.resolveSelect((*t).(*SelectStatement), parent, &orderList)
+			} else if (*t).Type() == "SelectStatement" {
+				selectColumnList = r.resolveSelect((*t).(*SelectStatement), parent, nil)
+			} else if (*t).Type() == "TableStatement" {
+				selectColumnList = r.resolveTable((*t).(*TableStatement), parent)
+			} else if (*t).Type() == "SubQueryComponent" {
+				selectColumnList = r.resolveSubQuery((*t).(*SubQueryComponent), parent)
+			} else if lastKeyword == "BY" && ((*t).Type() == "MySQLExpressionComponent" ||
+				(*t).Type() == "MySQLColumnNameComponent") {
+				orderList = append(orderList, t)
+				continue
+			} else {
+				continue
+			}
+			if columnList == nil {
+				columnList = selectColumnList
+				continue
+			}
+			for i, column := range selectColumnList {
+				if i < len(columnList) {
+					columnList[i] = &queryColumn{
+						name:       columnList[i].name,
+						sourceList: appendColumnAccessList(columnList[i].sourceList, column.sourceList),
+						direct:     columnList[i].direct && column.direct,
+					}
+				}
+			}
+		}
+		if len(orderList) > 0 {
+			r.resolveUnionOrder(orderList, columnList, parent)
+		}
+		return columnList
+	}
+	return nil
+}
+
+// resolveUnionOrder 将UNION的ORDER BY中的列引用绑定到UNION输出的列
+func (r *columnResolver) resolveUnionOrder(orderList []*MySQLObject, columnList []*queryColumn,
+	parent *resolveScope) {
+	scope := newResolveScope(parent)
+	source := &resolveSource{
+		derived:    true,
+		outputList: columnList,
+	}
+	for _, column := range columnList {
+		source.columnList = append(source.columnList, column.name)
+		if column.name == "*" {
+			source.unknown = true
+		}
+	}
+	scope.sourceList = append(scope.sourceList, source)
+	for _, t := range orderList {
+		if (*t).Type() == "MySQLExpressionComponent" {
+			r.resolveExpression((*t).(*MySQLExpressionComponent).ObjectList, scope, "order clause")
+		} else if (*t).Type() == "MySQLColumnNameComponent" {
+			r.resolveColumnName((*t).(*MySQLColumnNameComponent), scope, "order clause")
+		}
+	}
+}
+
+// resolveTable 解析TABLE语句，等同于SELECT * FROM tbl
+func (r *columnResolver) resolveTable(s *TableStatement, parent *resolveScope) []*queryColumn {
+	scope := newResolveScope(parent)
+	r.addTable(s.DatabaseList[0], s.TableList[0], "", scope)
+	columnList := r.expandWildcard("", "", scope)
+	if s.OrderBy != nil {
+		r.resolveExpression(s.OrderBy.ObjectList, scope, "order clause")
+	}
+	return columnList
+}
+
+// resolveSubQuery 解析子查询，parent为子查询可引用的外层查询
+func (r *columnResolver) resolveSubQuery(subQuery *SubQueryComponent, parent *resolveScope) []*queryColumn {
+	for _, t := range subQuery.ObjectList {
+		if (*t).Type() == "SelectStatement" || (*t).Type() == "UnionStatement" {
+			columnList := r.resolveQuery((*t).(MySQLStatement), parent)
+			r.subQueryMap[subQuery] = columnList
+			return columnList
+		}
+	}
+	return nil
+}
+
+// resolveSelect 解析SELECT语句，先处理FROM子句再处理其他子句
+// orderList不为nil时，ORDER BY属于外层的UNION，其中的对象加入orderList而不在本层解析
+func (r *columnResolver) resolveSelect(s *SelectStatement, parent *resolveScope,
+	orderList *[]*MySQLObject) []*queryColumn {
+	return r.resolveSelectInScope(s, newResolveScope(parent), orderList)
+}
+
+// resolveSelectInScope 在给定的作用域中解析SELECT语句
+func (r *columnResolver) resolveSelectInScope(s *SelectStatement, scope *resolveScope,
+	orderList *[]*MySQLObject) []*queryColumn {
+	for _, t := range s.ObjectList {
+		if (*t).Type() == "TableReferenceListComponent" {
+			r.addTableReferenceList((*t).(*TableReferenceListComponent), scope)
+		}
+	}
+	for _, alias := range s.AliasList {
+		if alias != "" {
+			scope.aliasList = append(scope.aliasList, alias)
+		}
+	}
+	columnList := make([]*queryColumn, 0)
+	lastKeyword := ""
+	fieldIndex := 0
+	for _, t := range s.ObjectList {
+		if (*t).Type() == "MySQLKeywordToken" && InArray((*t).Value(),
+			[]string{"SELECT", "FROM", "WHERE", "GROUP", "HAVING", "WINDOW", "ORDER", "LIMIT", "INTO", "FOR", "LOCK"}) {
+			lastKeyword = (*t).Value()
+		} else if (*t).Type() == "MySQLExpressionComponent" {
+			expression := (*t).(*MySQLExpressionComponent)
+			if lastKeyword == "SELECT" {
+				columnList = append(columnList, r.resolveField(expression, s.AliasList[fieldIndex], scope)...)
+				fieldIndex++
+			} else if lastKeyword == "WHERE" {
+				r.resolveExpression(expression.ObjectList, scope, "where clause")
+			} else if lastKeyword == "GROUP" {
+				r.resolveExpression(expression.ObjectList, scope, "group statement")
+			} else if lastKeyword == "HAVING" {
+				r.resolveExpression(expression.ObjectList, scope, "having clause")
+			} else if lastKeyword == "ORDER" && orderList != nil {
+				*orderList = append(*orderList, t)
+			} else if lastKeyword == "ORDER" {
+				r.resolveExpression(expression.ObjectList, scope, "order clause")
+			}
+		} else if (*t).Type() == "MySQLColumnNameComponent" {
+			if lastKeyword == "GROUP" {
+				r.resolveColumnName((*t).(*MySQLColumnNameComponent), scope, "group statement")
+			} else if lastKeyword == "ORDER" && orderList != nil {
+				*orderList = append(*orderList, t)
+			} else if lastKeyword == "ORDER" {
+				r.resolveColumnName((*t).(*MySQLColumnNameComponent), scope, "order clause")
+			}
+		} else if (*t).Type() == "MySQLWindowDefinitionComponent" {
+			if (*t).(*MySQLWindowDefinitionComponent).Spec != nil {
+				r.resolveWindowSpec((*t).(*MySQLWindowDefinitionComponent).Spec, scope)
+			}
+		}
+	}
+	return columnList
+}
+
+// resolveField 解析SELECT列表中的一项，返回其输出的列，*及tbl.*展开为来源表的全部列
+// 输出列的来源为表达式中本层查询的列引用及标量子查询输出列的来源
+func (r *columnResolver) resolveField(field *MySQLExpressionComponent, alias string,
+	scope *resolveScope) []*queryColumn {
+	database, table, column, isColumn := getExpressionColumnName(field)
+	if isColumn && column == "*" {
+		return r.expandWildcard(database, table, scope)
+	}
+	start := len(r.referenceList)
+	r.resolveExpression(field.ObjectList, scope, "field list")
+	output := &queryColumn{
+		name:       strings.TrimSpace(field.Value()),
+		sourceList: make([]*ColumnAccess, 0),
+		direct:     isColumn,
+	}
+	if alias != "" {
+		output.name = alias
+	} else if isColumn {
+		output.name = column
+	}
+	for _, reference := range r.referenceList[start:] {
+		if reference.scope == scope && reference.Error == nil && !reference.SelectAlias {
+			output.sourceList = appendColumnAccessList(output.sourceList, reference.sourceList)
+			output.direct = output.direct && reference.direct
+		}
+	}
+	for _, subQuery := range getExpressionSubQueryList(field.ObjectList) {
+		for _, subQueryColumn := range r.subQueryMap[subQuery] {
+			output.sourceList = appendColumnAccessList(output.sourceList, subQueryColumn.sourceList)
+		}
+	}
+	return []*queryColumn{output}
+}
+
+// getExpressionSubQueryList 获取表达式中的子查询，不含子查询内部的子查询
+func getExpressionSubQueryList(objectList []*MySQLObject) []*SubQueryComponent {
+	subQueryList := make([]*SubQueryComponent, 0)
+	for _, t := range objectList {
+		if (*t).Type() == "SubQueryComponent" {
+			subQueryList = append(subQueryList, (*t).(*SubQueryComponent))
+		} else if (*t).Type() == "MySQLExpressionComponent" {
+			subQueryList = append(subQueryList, getExpressionSubQueryList((*t).(*MySQLExpressionComponent).ObjectList)...)
+		}
+	}
+	return subQueryList
+}
+
+// expandWildcard 将*或tbl.*展开为来源表的列引用，结构未知的来源表输出名为*的列
+func (r *columnResolver) expandWildcard(database string, table string, scope *resolveScope) []*queryColumn {
+	sourceList := scope.sourceList
+	if table != "" {
+		source := scope.findSource(database, table)
+		if source == nil {
+			err := newCatalogError(ErrBadTable, "Unknown table '%s'", joinName(database, table))
+			r.referenceList = append(r.referenceList, &ColumnReference{
+				Database: database,
+				Table:    table,
+				Column:   "*",
+				Clause:   "field list",
+				Wildcard: true,
+				Error:    err,
+			})
+			r.addError(err)
+			return nil
+		}
+		sourceList = []*resolveSource{source}
+	}
+	columnList := make([]*queryColumn, 0)
+	for _, source := range sourceList {
+		if source.unknown {
+			reference := &ColumnReference{
+				Database: database,
+				Table:    table,
+				Column:   "*",
+				Clause:   "field list",
+				Wildcard: true,
+				scope:    scope,
+			}
+			reference.bind(source, false)
+			r.referenceList = append(r.referenceList, reference)
+			columnList = append(columnList, &queryColumn{
+				name:       "*",
+				sourceList: reference.sourceList,
+				direct:     true,
+			})
+			continue
+		}
+		for _, column := range source.columnList {
+			// USING/NATURAL合并的列只从最左侧的表输出一次
+			group := scope.joinColumnMap[strings.ToLower(column)]
+			if table == "" && len(group) > 0 && containsSource(group, source) && group[0] != source {
+				continue
+			}
+			reference := &ColumnReference{
+				Database: database,
+				Table:    table,
+				Column:   column,
+				Clause:   "field list",
+				Wildcard: true,
+				scope:    scope,
+			}
+			reference.bind(source, false)
+			r.referenceList = append(r.referenceList, reference)
+			columnList = append(columnList, &queryColumn{
+				name:       column,
+				sourceList: reference.sourceList,
+				direct:     reference.direct,
+			})
+		}
+	}
+	return columnList
+}
+
+// addTableReferenceList 将FROM子句中的表加入作用域
+func (r *columnResolver) addTableReferenceList(referenceList *TableReferenceListComponent, scope *resolveScope) {
+	for _, t := range referenceList.ObjectList {
+		if (*t).Type() == "TableReferenceComponent" {
+			r.addTableReference((*t).(*TableReferenceComponent), scope)
+		}
+	}
+}
+
+// addTableReference 将连接中的表依次加入作用域，ON条件只能引用已加入的表
+func (r *columnResolver) addTableReference(reference *TableReferenceComponent, scope *resolveScope) []*resolveSource {
+	sourceList := make([]*resolveSource, 0)
+	leftList := make([]*resolveSource, 0)
+	rightList := make([]*resolveSource, 0)
+	natural := false
+	for _, t := range reference.ObjectList {
+		if (*t).Type() == "MySQLKeywordToken" && (*t).Value() == "NATURAL" {
+			natural = true
+		} else if (*t).Type() == "TableFactorComponent" {
+			leftList = sourceList
+			rightList = r.addTableFactor((*t).(*TableFactorComponent), scope)
+			if natural {
+				r.joinNatural(leftList, rightList, scope)
+				natural = false
+			}
+			sourceList = append(append(make([]*resolveSource, 0), sourceList...), rightList...)
+		} else if (*t).Type() == "MySQLExpressionComponent" {
+			r.resolveExpression((*t).(*MySQLExpressionComponent).ObjectList, scope, "on clause")
+		} else if (*t).Type() == "MySQLColumnNameListComponent" {
+			for _, column := range (*t).(*MySQLColumnNameListComponent).ColumnList {
+				r.joinUsing(column.Column, leftList, rightList, scope)
+			}
+		}
+	}
+	return sourceList
+}
+
+// addTableFactor 将表因子加入作用域，返回其包含的来源表
+func (r *columnResolver) addTableFactor(factor *TableFactorComponent, scope *resolveScope) []*resolveSource {
+	if factor.TableName != nil {
+		return []*resolveSource{r.addTable(factor.TableName.Database, factor.TableName.Table, factor.Alias, scope)}
+	}
+	for _, t := range factor.ObjectList {
+		if (*t).Type() == "SubQueryComponent" {
+			// 派生表不能引用同层FROM子句中的表
+			outputList := r.resolveSubQuery((*t).(*SubQueryComponent), scope.parent)
+			source := &resolveSource{
+				alias:      factor.Alias,
+				table:      factor.Alias,
+				derived:    true,
+				outputList: outputList,
+			}
+			for _, output := range outputList {
+				source.columnList = append(source.columnList, output.name)
+				if output.name == "*" {
+					source.unknown = true
+				}
+			}
+			scope.sourceList = append(scope.sourceList, source)
+			return []*resolveSource{source}
+		} else if (*t).Type() == "TableReferenceComponent" {
+			return r.addTableReference((*t).(*TableReferenceComponent), scope)
+		} else if (*t).Type() == "MySQLJsonTableComponent" {
+			// JSON_TABLE的表达式可以引用FROM子句中在其之前的表
+			for _, o := range (*t).(*MySQLJsonTableComponent).ObjectList {
+				if (*o).Type() == "MySQLExpressionComponent" {
+					r.resolveExpression((*o).(*MySQLExpressionComponent).ObjectList, scope, "from clause")
+				}
+			}
+			source := &resolveSource{
+				alias:   factor.Alias,
+				table:   factor.Alias,
+				derived: true,
+				unknown: true,
+			}
+			scope.sourceList = append(scope.sourceList, source)
+			return []*resolveSource{source}
+		}
+	}
+	return nil
+}
+
+// addTable 将实体表加入作用域，表不存在时记录错误并不再检查其列
+func (r *columnResolver) addTable(database string, table string, alias string, scope *resolveScope) *resolveSource {
+	source := &resolveSource{
+		alias:    alias,
+		database: database,
+		table:    table,
+	}
+	if r.catalog == nil {
+		source.unknown = true
+		scope.sourceList = append(scope.sourceList, source)
+		return source
+	}
+	if database == "" {
+		source.database = r.catalog.CurrentDatabase
+	}
+	t, err := r.catalog.findTable(database, table)
+	if err != nil {
+		r.addError(err)
+		source.unknown = true
+	} else {
+		source.database = t.Database
+		for _, column := range t.ColumnList {
+			source.columnList = append(source.columnList, column.Name)
+		}
+	}
+	scope.sourceList = append(scope.sourceList, source)
+	return source
+}
+
+// joinUsing 合并USING子句中的列，两侧均须有且仅有一个表包含该列
+func (r *columnResolver) joinUsing(column string, leftList []*resolveSource, rightList []*resolveSource,
+	scope *resolveScope) {
+	left, leftErr := findJoinSource(column, leftList, scope)
+	right, rightErr := findJoinSource(column, rightList, scope)
+	if leftErr == nil && rightErr == nil && (left == nil || right == nil) {
+		leftErr = newCatalogError(ErrBadField, "Unknown column '%s' in 'from clause'", column)
+	}
+	if leftErr != nil || rightErr != nil {
+		err := leftErr
+		if err == nil {
+			err = rightErr
+		}
+		r.referenceList = append(r.referenceList, &ColumnReference{
+			Column: column,
+			Clause: "from clause",
+			Error:  err,
+		})
+		r.addError(err)
+		return
+	}
+	for _, source := range []*resolveSource{left, right} {
+		reference := &ColumnReference{
+			Column: column,
+			Clause: "from clause",
+		}
+		reference.bind(source, false)
+		r.referenceList = append(r.referenceList, reference)
+	}
+	if !left.unknown && !right.unknown {
+		scope.joinColumn(column, left, right)
+	}
+}
+
+// findJoinSource 在连接一侧查找包含指定列的表，该列已被此前的USING/NATURAL合并时使用合并后的列
+func findJoinSource(column string, sourceList []*resolveSource, scope *resolveScope) (*resolveSource, *CatalogError) {
+	var found *resolveSource
+	group := scope.joinColumnMap[strings.ToLower(column)]
+	for _, source := range sourceList {
+		if source.hasColumn(column) {
+			if found != nil && !(containsSource(group, found) && containsSource(group, source)) {
+				return nil, newCatalogError(ErrNonUniq, "Column '%s' in from clause is ambiguous", column)
+			}
+			if found == nil {
+				found = source
+			}
+		}
+	}
+	if found == nil {
+		for _, source := range sourceList {
+			if source.unknown {
+				return source, nil
+			}
+		}
+	}
+	return found, nil
+}
+
+// joinNatural 合并NATURAL连接两侧的同名列
+func (r *columnResolver) joinNatural(leftList []*resolveSource, rightList []*resolveSource, scope *resolveScope) {
+	for _, right := range rightList {
+		for _, column := range right.columnList {
+			for _, left := range leftList {
+				if left.hasColumn(column) {
+					scope.joinColumn(column, left, right)
+					break
+				}
+			}
+		}
+	}
+}
+
+// resolveUpdate 解析UPDATE语句
+func (r *columnResolver) resolveUpdate(s *UpdateStatement) {
+	scope := newResolveScope(nil)
+	for _, t := range s.ObjectList {
+		if (*t).Type() == "TableReferenceListComponent" {
+			r.addTableReferenceList((*t).(*TableReferenceListComponent), scope)
+		}
+	}
+	r.resolveAssignmentList(s.Assignments, scope)
+	if s.Where != nil {
+		r.resolveExpression(s.Where.ObjectList, scope, "where clause")
+	}
+	if s.OrderBy != nil {
+		r.resolveExpression(s.OrderBy.ObjectList, scope, "order clause")
+	}
+}
+
+// resolveDelete 解析DELETE语句，单表删除时表可带别名
+func (r *columnResolver) resolveDelete(s *DeleteStatement) {
+	scope := newResolveScope(nil)
+	var tableName *MySQLTableNameComponent
+	lastKeyword := ""
+	for _, t := range s.ObjectList {
+		if (*t).Type() == "MySQLKeywordToken" {
+			lastKeyword = (*t).Value()
+		} else if (*t).Type() == "TableReferenceListComponent" {
+			r.addTableReferenceList((*t).(*TableReferenceListComponent), scope)
+		} else if (*t).Type() == "MySQLTableNameComponent" && lastKeyword == "FROM" {
+			tableName = (*t).(*MySQLTableNameComponent)
+		} else if (*t).Type() == "MySQLIdentifierComponent" && tableName != nil && len(scope.sourceList) == 0 {
+			r.addTable(tableName.Database, tableName.Table, strings.Trim((*t).Value(), "`"), scope)
+			tableName = nil
+		}
+	}
+	if tableName != nil && len(scope.sourceList) == 0 {
+		r.addTable(tableName.Database, tableName.Table, "", scope)
+	}
+	if s.Where != nil {
+		r.resolveExpression(s.Where.ObjectList, scope, "where clause")
+	}
+	if s.OrderBy != nil {
+		r.resolveExpression(s.OrderBy.ObjectList, scope, "order clause")
+	}
+}
+
+// resolveInsert 解析INSERT或REPLACE语句，返回目标表所在的作用域
+func (r *columnResolver) resolveInsert(objectList []*MySQLObject, columnList []*MySQLColumnNameComponent,
+	valuesList [][]*MySQLExpressionComponent, setList []*MySQLAssignmentExpressionComponent) *resolveScope {
+	scope := newResolveScope(nil)
+	for _, t := range objectList {
+		if (*t).Type() == "MySQLTableNameComponent" && len(scope.sourceList) == 0 {
+			tableName := (*t).(*MySQLTableNameComponent)
+			r.addTable(tableName.Database, tableName.Table, "", scope)
+		} else if (*t).Type() == "SelectStatement" {
+			r.selectScope = newResolveScope(nil)
+			r.outputList = r.resolveSelectInScope((*t).(*SelectStatement), r.selectScope, nil)
+		} else if (*t).Type() == "UnionStatement" || (*t).Type() == "TableStatement" {
+			r.outputList = r.resolveQuery((*t).(MySQLStatement), nil)
+		}
+	}
+	if len(scope.sourceList) == 0 {
+		return nil
+	}
+	for _, column := range columnList {
+		r.resolveTargetColumn(column, scope)
+	}
+	for _, valueList := range valuesList {
+		for _, value := range valueList {
+			r.resolveExpression(value.ObjectList, scope, "field list")
+		}
+	}
+	r.resolveAssignmentList(setList, scope)
+	return scope
+}
+
+// addRowAlias 将INSERT ... AS row_alias定义的行别名加入作用域，列名与插入的列对应
+// 未定义列别名时行别名的列只能通过限定名引用，未加限定的列指向目标表
+func (r *columnResolver) addRowAlias(scope *resolveScope, alias string, columnAliasList []string,
+	columnList []*MySQLColumnNameComponent) {
+	target := scope.sourceList[0]
+	source := &resolveSource{
+		alias:     alias,
+		table:     alias,
+		derived:   true,
+		unknown:   target.unknown && len(columnList) == 0,
+		qualified: len(columnAliasList) == 0,
+	}
+	if len(columnAliasList) > 0 {
+		source.columnList = columnAliasList
+	} else if len(columnList) > 0 {
+		for _, column := range columnList {
+			source.columnList = append(source.columnList, column.Column)
+		}
+	} else {
+		source.columnList = target.columnList
+	}
+	scope.sourceList = append(scope.sourceList, source)
+}
+
+func (r *columnResolver) resolveAssignmentList(assignmentList []*MySQLAssignmentExpressionComponent,
+	scope *resolveScope) {
+	for _, assignment := range assignmentList {
+		if assignment.Column != nil {
+			r.resolveTargetColumn(assignment.Column, scope)
+		}
+		if assignment.Expression != nil {
+			r.resolveExpression(assignment.Expression.ObjectList, scope, "field list")
+		}
+	}
+}
+
+// resolveDuplicateUpdateList 解析ON DUPLICATE KEY UPDATE，写入的列属于目标表
+// 值还可以引用INSERT ... SELECT中SELECT的表，各赋值的值的来源记录到updateList
+func (r *columnResolver) resolveDuplicateUpdateList(assignmentList []*MySQLAssignmentExpressionComponent,
+	scope *resolveScope) {
+	valueScope := scope
+	if r.selectScope != nil {
+		valueScope = newResolveScope(nil)
+		valueScope.sourceList = append(append(valueScope.sourceList, scope.sourceList...),
+			r.selectScope.sourceList...)
+		valueScope.joinColumnMap = r.selectScope.joinColumnMap
+	}
+	for _, assignment := range assignmentList {
+		if assignment.Column != nil {
+			r.resolveTargetColumn(assignment.Column, scope)
+		}
+		if assignment.Column != nil && assignment.Expression != nil {
+			r.updateList = append(r.updateList, r.resolveField(assignment.Expression, assignment.Column.Column,
+				valueScope)...)
+		}
+	}
+}
+
+// resolveWindowSpec 解析窗口定义中的PARTITION BY和ORDER BY
+func (r *columnResolver) resolveWindowSpec(spec *MySQLWindowSpecComponent, scope *resolveScope) {
+	for _, expression := range spec.PartitionList {
+		r.resolveExpression(expression.ObjectList, scope, "window partition by")
+	}
+	if spec.OrderList != nil {
+		r.resolveExpression(spec.OrderList.ObjectList, scope, "window order by")
+	}
+}
+
+// resolveExpression 查找表达式中的列引用并绑定
+// 函数名、COLLATE/USING之后的字符集及紧跟在操作数之后的名称(如INTERVAL单位)不视为列引用，
+// 关键字形式的名称仅在能绑定到列时记录
+func (r *columnResolver) resolveExpression(objectList []*MySQLObject, scope *resolveScope, clause string) {
+	validList := make([]*MySQLObject, 0)
+	for _, t := range objectList {
+		if (*t).Type() != "MySQLSpaceToken" && (*t).Type() != "MySQLCommentToken" {
+			validList = append(validList, t)
+		}
+	}
+	for i := 0; i < len(validList); i++ {
+		t := validList[i]
+		if (*t).Type() == "SubQueryComponent" {
+			r.resolveSubQuery((*t).(*SubQueryComponent), scope)
+		} else if (*t).Type() == "MySQLWindowSpecComponent" {
+			r.resolveWindowSpec((*t).(*MySQLWindowSpecComponent), scope)
+		} else if (*t).Type() == "MySQLExpressionComponent" {
+			r.resolveExpression((*t).(*MySQLExpressionComponent).ObjectList, scope, clause)
+		} else if (*t).Type() == "MySQLOrderOptionComponent" {
+			r.resolveExpression((*t).(*MySQLOrderOptionComponent).ObjectList, scope, clause)
+		} else if (*t).Type() == "MySQLColumnNameComponent" {
+			r.resolveColumnName((*t).(*MySQLColumnNameComponent), scope, clause)
+		} else if isNameToken(t) {
+			partList := []string{getNameValue(t)}
+			j := i
+			for j+2 < len(validList) && (*validList[j+1]).Type() == "MySQLOperatorToken" &&
+				(*validList[j+1]).Value() == "." && isNameToken(validList[j+2]) {
+				partList = append(partList, getNameValue(validList[j+2]))
+				j += 2
+			}
+			isFunction := j+1 < len(validList) && (*validList[j+1]).Type() == "MySQLOperatorToken" &&
+				(*validList[j+1]).Value() == "("
+			isKeyword := len(partList) == 1 && (*t).Type() == "MySQLKeywordToken"
+			if !isFunction && len(partList) <= 3 && !isNotColumnPosition(validList, i) &&
+				!(isKeyword && (InArray((*t).Value(), supportKeyword) ||
+					InArray((*t).Value(), supportInFunctionKeyword) || InArray((*t).Value(), nonColumnKeyword))) {
+				for len(partList) < 3 {
+					partList = append([]string{""}, partList...)
+				}
+				r.resolveColumn(partList[0], partList[1], partList[2], scope, clause, isKeyword)
+			}
+			i = j
+		}
+	}
+}
+
+// isNameToken 判断对象是否可作为列引用中的名称
+func isNameToken(t *MySQLObject) bool {
+	return (*t).Type() == "MySQLUnquotedIdentifierToken" || (*t).Type() == "MySQLQuotedIdentifierToken" ||
+		(*t).Type() == "MySQLKeywordToken"
+}
+
+// getNameValue 获取列引用中名称的原始写法，去掉反引号
+func getNameValue(t *MySQLObject) string {
+	if (*t).Type() == "MySQLKeywordToken" {
+		return (*t).(*MySQLKeywordToken).OriginValue()
+	}
+	return strings.Trim((*t).Value(), "`")
+}
+
+// isNotColumnPosition 判断名称的位置是否不可能是列引用
+func isNotColumnPosition(objectList []*MySQLObject, i int) bool {
+	if i == 0 {
+		return false
+	}
+	prev := objectList[i-1]
+	if (*prev).Type() == "MySQLKeywordToken" && InArray((*prev).Value(), []string{"AS", "COLLATE", "USING", "OVER"}) {
+		return true
+	}
+	return (*prev).Type() == "MySQLNumericToken" || (*prev).Type() == "MySQLStringToken" ||
+		(*prev).Type() == "MySQLUnquotedIdentifierToken" || (*prev).Type() == "MySQLQuotedIdentifierToken" ||
+		((*prev).Type() == "MySQLOperatorToken" && (*prev).Value() == ")")
+}
+
+func (r *columnResolver) resolveColumnName(column *MySQLColumnNameComponent, scope *resolveScope, clause string) {
+	r.resolveColumn(column.Database, column.Table, column.Column, scope, clause, false)
+}
+
+// resolveTargetColumn 绑定被写入的列
+func (r *columnResolver) resolveTargetColumn(column *MySQLColumnNameComponent, scope *resolveScope) {
+	reference := r.resolveColumn(column.Database, column.Table, column.Column, scope, "field list", false)
+	reference.Write = true
+}
+
+// resolveColumn 由内向外逐层查找列的来源表，optional为true时未找到不记录并返回nil
+// ORDER BY优先匹配SELECT列表中的别名，GROUP BY和HAVING优先匹配FROM子句中的列
+func (r *columnResolver) resolveColumn(database string, table string, column string, scope *resolveScope,
+	clause string, optional bool) *ColumnReference {
+	reference := &ColumnReference{
+		Database: database,
+		Table:    table,
+		Column:   column,
+		Clause:   clause,
+		scope:    scope,
+	}
+	resolved := false
+	var bound *resolveSource
+	for current := scope; current != nil && !resolved && reference.Error == nil; current = current.parent {
+		if table != "" {
+			source := current.findSource(database, table)
+			if source == nil {
+				continue
+			}
+			if source.unknown || source.hasColumn(column) {
+				reference.bind(source, current != scope)
+				bound = source
+				resolved = true
+			} else {
+				reference.Error = newCatalogError(ErrBadField, "Unknown column '%s' in '%s'",
+					joinName(database, table, column), clause)
+			}
+			continue
+		}
+		if current == scope && clause == "order clause" && current.hasAlias(column) {
+			reference.SelectAlias = true
+			resolved = true
+			continue
+		}
+		source, ambiguous, stop := current.findColumn(column)
+		if ambiguous {
+			reference.Error = newCatalogError(ErrNonUniq, "Column '%s' in %s is ambiguous", column, clause)
+		} else if source != nil {
+			reference.bind(source, current != scope)
+			bound = source
+			resolved = true
+		} else if stop {
+			resolved = true
+		} else if current == scope && (clause == "group statement" || clause == "having clause") &&
+			current.hasAlias(column) {
+			reference.SelectAlias = true
+			resolved = true
+		}
+	}
+	if resolved && bound == nil && !reference.SelectAlias {
+		reference.sourceList = []*ColumnAccess{{Column: column}}
+	}
+	if !resolved && reference.Error == nil {
+		reference.Error = newCatalogError(ErrBadField, "Unknown column '%s' in '%s'",
+			joinName(database, table, column), clause)
+	}
+	if optional && (reference.Error != nil || (!reference.SelectAlias && bound == nil)) {
+		return nil
+	}
+	r.referenceList = append(r.referenceList, reference)
+	if reference.Error != nil {
+		r.addError(reference.Error)
+	}
+	return reference
+}
+
+// bind 将列引用绑定到来源表
+func (c *ColumnReference) bind(source *resolveSource, outer bool) {
+	c.SourceColumn = c.Column
+	for _, column := range source.columnList {
+		if strings.EqualFold(column, c.Column) {
+			c.SourceColumn = column
+			break
+		}
+	}
+	c.SourceDatabase = source.database
+	c.SourceTable = source.table
+	c.SourceAlias = source.name()
+	c.Derived = source.derived
+	c.Outer = outer
+	if !source.derived {
+		c.sourceList = []*ColumnAccess{{
+			Database: source.database,
+			Table:    source.table,
+			Column:   c.SourceColumn,
+		}}
+		c.direct = true
+		return
+	}
+	c.sourceList = make([]*ColumnAccess, 0)
+	for _, output := range source.outputList {
+		if strings.EqualFold(output.name, c.Column) {
+			c.sourceList = output.sourceList
+			c.direct = output.direct
+			return
+		}
+	}
+	// 派生表中结构未知的表经*输出的列
+	for _, output := range source.outputList {
+		if output.name != "*" {
+			continue
+		}
+		for _, access := range output.sourceList {
+			c.sourceList = appendColumnAccess(c.sourceList, &ColumnAccess{
+				Database: access.Database,
+				Table:    access.Table,
+				Column:   c.Column,
+			})
+		}
+	}
+	c.direct = len(c.sourceList) == 1
+}
+
+// joinName 以.连接非空的名称
+func joinName(nameList ...string) string {
+	partList := make([]string, 0)
+	for _, name := range nameList {
+		if name != "" {
+			partList = append(partList, name)
+		}
+	}
+	return strings.Join(partList, ".")
+}
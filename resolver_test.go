@@ -0,0 +1,82 @@
+package mysqlparser_go
+
+import (
+	"strings"
+	"testing"
+)
+
+func formatColumnReference(reference *ColumnReference) string {
+	if reference.Error != nil {
+		return joinName(reference.Database, reference.Table, reference.Column) + "!" + reference.Error.Error()
+	}
+	target := joinName(reference.SourceDatabase, reference.SourceTable)
+	if reference.SelectAlias {
+		target = "alias"
+	} else if reference.Outer {
+		target = "outer:" + target
+	}
+	column := reference.SourceColumn
+	if reference.SelectAlias {
+		column = reference.Column
+	}
+	return joinName(reference.Table, column) + "=" + target
+}
+
+func Test_Resolve(t *testing.T) {
+	c := NewCatalog()
+	err := c.ApplySQL("CREATE DATABASE db1; USE db1; " +
+		"CREATE TABLE a (id INT, x INT, name VARCHAR(32)); " +
+		"CREATE TABLE b (id INT, x INT, a_id INT); " +
+		"CREATE TABLE c (x INT, k INT)")
+	if err != nil {
+		t.Fatalf("Error: %+v", err)
+	}
+	sqlList := map[string]string{
+		"SELECT id FROM a JOIN b USING (x)":                                                          "x=db1.a x=db1.b id!ERROR 1052: Column 'id' in field list is ambiguous",
+		"SELECT x, b.x FROM a JOIN b USING (x) WHERE a.name = 'n'":                                   "x=db1.a x=db1.b x=db1.a b.x=db1.b a.name=db1.a",
+		"SELECT x FROM a NATURAL JOIN b":                                                             "x=db1.a",
+		"SELECT x FROM a JOIN b USING (x) JOIN c USING (x)":                                          "x=db1.a x=db1.b x=db1.a x=db1.c x=db1.a",
+		"SELECT * FROM a JOIN b USING (x) JOIN c USING (x)":                                          "x=db1.a x=db1.b x=db1.a x=db1.c id=db1.a x=db1.a name=db1.a id=db1.b a_id=db1.b k=db1.c",
+		"SELECT x, k FROM a NATURAL JOIN b JOIN c USING (x)":                                         "x=db1.a x=db1.c x=db1.a k=db1.c",
+		"SELECT t.id, t.total FROM (SELECT a_id AS id, COUNT(*) AS total FROM b GROUP BY a_id) AS t": "a_id=db1.b a_id=db1.b t.id=t t.total=t",
+		"SELECT p.name FROM a AS p WHERE EXISTS (SELECT 1 FROM b WHERE b.a_id = p.id AND k = 1)":     "p.name=db1.a b.a_id=db1.b p.id=outer:db1.a k!ERROR 1054: Unknown column 'k' in 'where clause'",
+		"SELECT x AS y FROM c ORDER BY y, k":                                                         "x=db1.c y=alias k=db1.c",
+		"SELECT db1.a.name, nothing.x FROM a":                                                        "a.name=db1.a nothing.x!ERROR 1054: Unknown column 'nothing.x' in 'field list'",
+		"SELECT * FROM a JOIN b USING (id)":                                                          "id=db1.a id=db1.b id=db1.a x=db1.a name=db1.a x=db1.b a_id=db1.b",
+		"SELECT CAST(name AS CHAR), DATE_ADD(NOW(), INTERVAL x DAY) FROM a":                          "name=db1.a x=db1.a",
+		"UPDATE a AS p JOIN b ON p.id = b.a_id SET p.name = b.x WHERE k = 1":                         "p.id=db1.a b.a_id=db1.b p.name=db1.a b.x=db1.b k!ERROR 1054: Unknown column 'k' in 'where clause'",
+		"DELETE FROM a AS p WHERE p.id = 1 ORDER BY name":                                            "p.id=db1.a name=db1.a",
+		"INSERT INTO c (x, k) SELECT id, x FROM a ON DUPLICATE KEY UPDATE k = VALUES(k) + c.x":       "id=db1.a x=db1.a x=db1.c k=db1.c k=db1.c k=db1.c c.x=db1.c",
+		"SELECT id FROM a UNION SELECT x FROM b ORDER BY id":                                         "id=db1.a x=db1.b id=",
+		"(SELECT id FROM a) UNION ALL (SELECT a_id FROM b) ORDER BY id DESC LIMIT 1":                 "id=db1.a a_id=db1.b id=",
+		"SELECT id FROM a UNION SELECT x FROM b ORDER BY x":                                          "id=db1.a x=db1.b x!ERROR 1054: Unknown column 'x' in 'order clause'",
+		"SELECT id FROM a UNION SELECT x FROM b ORDER BY b.x":                                        "id=db1.a x=db1.b b.x!ERROR 1054: Unknown column 'b.x' in 'order clause'",
+		"SELECT jt.v FROM a, JSON_TABLE(a.name, '$[*]' COLUMNS (v INT PATH '$')) AS jt":              "a.name=db1.a jt.v=jt",
+		"SELECT jt.v FROM a JOIN JSON_TABLE(nothing, '$[*]' COLUMNS (v INT PATH '$')) AS jt ON TRUE": "nothing!ERROR 1054: Unknown column 'nothing' in 'from clause' jt.v=jt",
+		"SELECT u.id FROM unknown_table AS u JOIN a ON u.id = a.id":                                  "u.id=db1.unknown_table a.id=db1.a u.id=db1.unknown_table",
+	}
+	for sql, expected := range sqlList {
+		statementList, err := Parse(sql)
+		if err != nil {
+			t.Errorf("%s: Error: %+v", sql, err)
+			continue
+		}
+		referenceList, _ := c.Resolve(statementList[0])
+		resultList := make([]string, 0)
+		for _, reference := range referenceList {
+			resultList = append(resultList, formatColumnReference(reference))
+		}
+		if strings.Join(resultList, " ") != expected {
+			t.Errorf("%s:\nRespect: %s\nGot:     %s", sql, expected, strings.Join(resultList, " "))
+		}
+	}
+
+	_, err = c.ResolveSQL("CREATE TABLE d (v INT); SELECT v FROM d")
+	if err != nil {
+		t.Errorf("Error: %+v", err)
+	}
+	_, err = c.ResolveSQL("SELECT v FROM nothing")
+	if catalogErr, ok := err.(*CatalogError); !ok || catalogErr.Code != ErrNoSuchTable {
+		t.Errorf("Respect: %d, Got: %v", ErrNoSuchTable, err)
+	}
+}
@@ -0,0 +1,278 @@
+package mysqlparser_go
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	plainIdentifierRegex = regexp.MustCompile(`^[0-9a-zA-Z$_]*[a-zA-Z$_][0-9a-zA-Z$_]*$`)
+)
+
+// tableNameRewriter 按映射函数替换SQL中的表名
+type tableNameRewriter struct {
+	sql          string
+	offsetMap    map[interface{}]int
+	mapping      func(database string, table string) (string, string)
+	aliasList    []string
+	databaseList []string
+	tableList    []string
+	skipMap      map[interface{}]bool
+	rewriteMap   map[int]*sqlRewrite
+}
+
+// sqlRewrite 将SQL中[start, end)的部分替换为text
+type sqlRewrite struct {
+	start int
+	end   int
+	text  string
+}
+
+// namePart 以.分隔的名称中的一段，name为去掉反引号后的原始写法
+type namePart struct {
+	name   string
+	quoted bool
+	start  int
+	end    int
+}
+
+// RewriteTableName 按mapping替换SQL中引用的表名，返回替换后的SQL，可用于将逻辑表名改写为分片的物理表名
+// mapping的参数为SQL中的库名及表名，未指定库名时为空，返回新的库名及表名，返回的库名为空时不带库名
+// 替换表名及以表名限定的列引用(t.col、db.t.col、t.*)，包括子查询、连接、DDL及带索引提示的表；
+// 表别名、字符串、注释及空白保持不变，原名带反引号或新名称需要时加反引号
+func RewriteTableName(sql string, mapping func(database string, table string) (string, string)) (string, error) {
+	tokenList, err := NewMySQLTokenList(sql, nil)
+	if err != nil {
+		return "", err
+	}
+	r := &tableNameRewriter{
+		sql:        sql,
+		offsetMap:  make(map[interface{}]int),
+		mapping:    mapping,
+		skipMap:    make(map[interface{}]bool),
+		rewriteMap: make(map[int]*sqlRewrite),
+	}
+	offset := 0
+	for _, token := range tokenList.tokenList {
+		r.offsetMap[*token] = offset
+		offset += len((*token).Value())
+	}
+	for _, t := range tokenList.Divide() {
+		if len(t.GetNextValidToken(1)) == 0 {
+			continue
+		}
+		s := parseSingleSQL(t)
+		if s == nil {
+			return "", errors.New(fmt.Sprintf("Syntax error on  %+v", t))
+		}
+		r.rewriteStatement(s)
+	}
+
+	// 关键字token的值为大写，未替换的部分取自原SQL
+	var builder strings.Builder
+	end := 0
+	for _, token := range tokenList.tokenList {
+		start := r.offsetMap[*token]
+		if rewrite, ok := r.rewriteMap[start]; ok {
+			builder.WriteString(rewrite.text)
+			end = rewrite.end
+		} else if start >= end {
+			builder.WriteString(sql[start : start+len((*token).Value())])
+		}
+	}
+	return builder.String(), nil
+}
+
+// rewriteStatement 替换语句中的表名，先收集别名及表名，再替换列引用中的限定
+func (r *tableNameRewriter) rewriteStatement(s MySQLStatement) {
+	r.aliasList = make([]string, 0)
+	r.databaseList = make([]string, 0)
+	r.tableList = make([]string, 0)
+	walkStatement(s, func(t *MySQLObject) bool {
+		if (*t).Type() == "TableFactorComponent" && (*t).(*TableFactorComponent).Alias != "" {
+			r.aliasList = append(r.aliasList, (*t).(*TableFactorComponent).Alias)
+		} else if (*t).Type() == "InsertStatement" && (*t).(*InsertStatement).RowAlias != "" {
+			r.aliasList = append(r.aliasList, (*t).(*InsertStatement).RowAlias)
+		} else if (*t).Type() == "DeleteStatement" {
+			// 单表DELETE的表名之后为别名
+			var previous *MySQLObject
+			for _, child := range getValidObjectList(getObjectList(t)) {
+				if (*child).Type() == "MySQLIdentifierComponent" && previous != nil &&
+					(*previous).Type() == "MySQLTableNameComponent" {
+					r.aliasList = append(r.aliasList, strings.Trim((*child).Value(), "`"))
+				}
+				previous = child
+			}
+		}
+		return true
+	})
+	walkStatement(s, func(t *MySQLObject) bool {
+		if (*t).Type() == "DeleteStatement" {
+			// 多表DELETE要删除的表可以是别名
+			for _, child := range getObjectList(t) {
+				if (*child).Type() == "MySQLTableNameComponent" && (*child).(*MySQLTableNameComponent).Database == "" &&
+					r.isAlias((*child).(*MySQLTableNameComponent).Table) {
+					r.skipMap[*child] = true
+				}
+			}
+		} else if (*t).Type() == "MySQLTableNameComponent" {
+			if partList := r.getNamePartList(getValidObjectList(getObjectList(t))); len(partList) > 0 && !r.skipMap[*t] {
+				r.rewriteName(partList, true)
+			}
+			return false
+		}
+		return true
+	})
+	walkStatement(s, func(t *MySQLObject) bool {
+		if (*t).Type() == "MySQLTableNameComponent" {
+			return false
+		}
+		r.rewriteQualifierList(getValidObjectList(getObjectList(t)))
+		return true
+	})
+}
+
+// rewriteQualifierList 替换对象列表中列引用的限定，如t.col、db.t.col及t.*，不含db.func()等函数调用
+// 限定只在是语句中引用的表、而不是别名时替换
+func (r *tableNameRewriter) rewriteQualifierList(validList []*MySQLObject) {
+	for i := 0; i < len(validList); i++ {
+		part := r.getNamePart(validList[i])
+		if part == nil || (i > 0 && isOperatorToken(validList[i-1], ".")) {
+			continue
+		}
+		partList := []*namePart{part}
+		count := 1
+		j := i
+		for j+2 < len(validList) && isOperatorToken(validList[j+1], ".") {
+			if next := r.getNamePart(validList[j+2]); next != nil {
+				partList = append(partList, next)
+			} else if !isOperatorToken(validList[j+2], "*") {
+				break
+			}
+			count++
+			j += 2
+			if isOperatorToken(validList[j], "*") {
+				break
+			}
+		}
+		isFunction := j+1 < len(validList) && isOperatorToken(validList[j+1], "(")
+		if (count == 2 || count == 3) && !isFunction {
+			qualifierList := partList[:count-1]
+			table := qualifierList[len(qualifierList)-1].name
+			if len(qualifierList) == 2 || !r.isAlias(table) {
+				r.rewriteName(qualifierList, false)
+			}
+		}
+		i = j
+	}
+}
+
+// rewriteName 替换库名.表名或表名，isTable为false时为列引用的限定，只替换语句中引用的表
+// 列引用的限定原本不带库名时只写表名
+func (r *tableNameRewriter) rewriteName(partList []*namePart, isTable bool) {
+	if len(partList) > 2 {
+		return
+	}
+	database, table := "", partList[len(partList)-1].name
+	if len(partList) == 2 {
+		database = partList[0].name
+	}
+	if isTable {
+		r.databaseList = append(r.databaseList, database)
+		r.tableList = append(r.tableList, table)
+	} else {
+		found := false
+		for i, referenceTable := range r.tableList {
+			if strings.EqualFold(referenceTable, table) && (database == "" || r.databaseList[i] == "" ||
+				strings.EqualFold(r.databaseList[i], database)) {
+				if database == "" {
+					database = r.databaseList[i]
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			return
+		}
+	}
+	newDatabase, newTable := r.mapping(database, table)
+	if newDatabase == database && newTable == table {
+		return
+	}
+	text := quoteNameIfNeeded(newTable, partList[len(partList)-1].quoted)
+	if newDatabase != "" && (isTable || len(partList) == 2) {
+		text = quoteNameIfNeeded(newDatabase, partList[0].quoted) + "." + text
+	}
+	r.rewriteMap[partList[0].start] = &sqlRewrite{
+		start: partList[0].start,
+		end:   partList[len(partList)-1].end,
+		text:  text,
+	}
+}
+
+// getNamePartList 获取以.分隔的名称的各段，对象列表不是这种形式时返回nil
+func (r *tableNameRewriter) getNamePartList(validList []*MySQLObject) []*namePart {
+	partList := make([]*namePart, 0)
+	for i, t := range validList {
+		if i%2 == 1 {
+			if !isOperatorToken(t, ".") {
+				return nil
+			}
+			continue
+		}
+		part := r.getNamePart(t)
+		if part == nil {
+			return nil
+		}
+		partList = append(partList, part)
+	}
+	return partList
+}
+
+// getNamePart 获取标识符在原SQL中的位置及名称，不是标识符时返回nil
+func (r *tableNameRewriter) getNamePart(t *MySQLObject) *namePart {
+	if (*t).Type() == "MySQLIdentifierComponent" {
+		validList := getValidObjectList(getObjectList(t))
+		if len(validList) != 1 {
+			return nil
+		}
+		t = validList[0]
+	}
+	if !isNameToken(t) {
+		return nil
+	}
+	start, ok := r.offsetMap[*t]
+	if !ok {
+		return nil
+	}
+	part := &namePart{
+		start: start,
+		end:   start + len((*t).Value()),
+	}
+	part.name = r.sql[part.start:part.end]
+	if strings.HasPrefix(part.name, "`") {
+		part.quoted = true
+		part.name = strings.ReplaceAll(part.name[1:len(part.name)-1], "``", "`")
+	}
+	return part
+}
+
+func (r *tableNameRewriter) isAlias(name string) bool {
+	for _, alias := range r.aliasList {
+		if strings.EqualFold(alias, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// quoteNameIfNeeded 原名带反引号、新名称含特殊字符、全为数字或是保留字时加反引号
+func quoteNameIfNeeded(name string, quoted bool) string {
+	if quoted || !plainIdentifierRegex.MatchString(name) || InArray(strings.ToUpper(name), reservedKeywords) {
+		return quoteName(name)
+	}
+	return name
+}
@@ -0,0 +1,45 @@
+package mysqlparser_go
+
+import (
+	"testing"
+)
+
+func Test_RewriteTableName(t *testing.T) {
+	mapping := func(database string, table string) (string, string) {
+		if table == "Tbl_AdGroup" && (database == "" || database == "DB_Ad") {
+			return "DB_Ad_43", "Tbl_AdGroup_1"
+		} else if table == "user" {
+			return "", "user_1"
+		} else if table == "order" {
+			return database, "order 1"
+		}
+		return database, table
+	}
+	sqlList := map[string]string{
+		"SELECT * FROM Tbl_AdGroup WHERE FName = 'Tbl_AdGroup' /* Tbl_AdGroup */":                                                                                   "SELECT * FROM DB_Ad_43.Tbl_AdGroup_1 WHERE FName = 'Tbl_AdGroup' /* Tbl_AdGroup */",
+		"select Tbl_AdGroup.*, DB_Ad.Tbl_AdGroup.FId from DB_Ad.Tbl_AdGroup use index (idx_uid) where Tbl_AdGroup.FUId = 1 order by `Tbl_AdGroup`.FId":              "select Tbl_AdGroup_1.*, DB_Ad_43.Tbl_AdGroup_1.FId from DB_Ad_43.Tbl_AdGroup_1 use index (idx_uid) where Tbl_AdGroup_1.FUId = 1 order by `Tbl_AdGroup_1`.FId",
+		"SELECT g.FId, user.name FROM Tbl_AdGroup AS g JOIN user ON user.id = g.FUId WHERE g.FId IN (SELECT FId FROM Tbl_AdGroup WHERE Tbl_AdGroup.FUId = user.id)": "SELECT g.FId, user_1.name FROM DB_Ad_43.Tbl_AdGroup_1 AS g JOIN user_1 ON user_1.id = g.FUId WHERE g.FId IN (SELECT FId FROM DB_Ad_43.Tbl_AdGroup_1 WHERE Tbl_AdGroup_1.FUId = user_1.id)",
+		"SELECT user.id FROM other AS user":           "SELECT user.id FROM other AS user",
+		"SELECT db.user.id, user.name() FROM db.user": "SELECT user_1.id, user.name() FROM user_1",
+		"INSERT INTO Tbl_AdGroup (Tbl_AdGroup.FId) SELECT id FROM user ON DUPLICATE KEY UPDATE FName = VALUES(Tbl_AdGroup.FName)": "INSERT INTO DB_Ad_43.Tbl_AdGroup_1 (Tbl_AdGroup_1.FId) SELECT id FROM user_1 ON DUPLICATE KEY UPDATE FName = VALUES(Tbl_AdGroup_1.FName)",
+		"UPDATE Tbl_AdGroup SET Tbl_AdGroup.FState = 0 WHERE FId = 1; DELETE FROM user WHERE id = 2":                              "UPDATE DB_Ad_43.Tbl_AdGroup_1 SET Tbl_AdGroup_1.FState = 0 WHERE FId = 1; DELETE FROM user_1 WHERE id = 2",
+		"DELETE g FROM Tbl_AdGroup g JOIN user ON user.id = g.FUId":                                                               "DELETE g FROM DB_Ad_43.Tbl_AdGroup_1 g JOIN user_1 ON user_1.id = g.FUId",
+		"DELETE Tbl_AdGroup FROM Tbl_AdGroup WHERE FId = 1":                                                                       "DELETE DB_Ad_43.Tbl_AdGroup_1 FROM DB_Ad_43.Tbl_AdGroup_1 WHERE FId = 1",
+		"CREATE TABLE Tbl_AdGroup (FId INT, FUId INT, FOREIGN KEY (FUId) REFERENCES user (id))":                                   "CREATE TABLE DB_Ad_43.Tbl_AdGroup_1 (FId INT, FUId INT, FOREIGN KEY (FUId) REFERENCES user_1 (id))",
+		"ALTER TABLE `order` ADD COLUMN c INT; DROP TABLE IF EXISTS user, Tbl_AdGroup":                                            "ALTER TABLE `order 1` ADD COLUMN c INT; DROP TABLE IF EXISTS user_1, DB_Ad_43.Tbl_AdGroup_1",
+		"SELECT 1": "SELECT 1",
+	}
+	for sql, expected := range sqlList {
+		result, err := RewriteTableName(sql, mapping)
+		if err != nil {
+			t.Errorf("%s: Error: %+v", sql, err)
+			continue
+		}
+		if result != expected {
+			t.Errorf("%s:\nRespect: %s\nGot:     %s", sql, expected, result)
+		}
+	}
+	if _, err := RewriteTableName("SELECT FROM", mapping); err == nil {
+		t.Errorf("Respect syntax error")
+	}
+}
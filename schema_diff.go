@@ -0,0 +1,442 @@
+package mysqlparser_go
+
+import (
+	"fmt"
+	"strings"
+)
+
+// diffDatabaseName 对比CREATE TABLE语句时，未指定库名的表所使用的临时库名
+const diffDatabaseName = "__mysqlparser_diff__"
+
+// tableOptionResetMap 表选项被去掉时恢复默认所用的值
+// ENGINE、CHARSET、COLLATE等的默认值取决于服务器及库的设置，不在其中，去掉时不生成子句
+var tableOptionResetMap = map[string]string{
+	"AVG_ROW_LENGTH":     "0",
+	"CHECKSUM":           "0",
+	"COMMENT":            "''",
+	"COMPRESSION":        "'None'",
+	"CONNECTION":         "''",
+	"DELAY_KEY_WRITE":    "0",
+	"INSERT_METHOD":      "NO",
+	"KEY_BLOCK_SIZE":     "0",
+	"MAX_ROWS":           "0",
+	"MIN_ROWS":           "0",
+	"PACK_KEYS":          "DEFAULT",
+	"ROW_FORMAT":         "DEFAULT",
+	"STATS_AUTO_RECALC":  "DEFAULT",
+	"STATS_PERSISTENT":   "DEFAULT",
+	"STATS_SAMPLE_PAGES": "DEFAULT",
+}
+
+// DiffCatalog 生成将from的库表结构变更为to所需的DDL语句
+func DiffCatalog(from *Catalog, to *Catalog) []string {
+	sqlList := make([]string, 0)
+	for _, d := range to.DatabaseList {
+		fromDatabase := from.GetDatabase(d.Name)
+		if fromDatabase == nil {
+			sqlList = append(sqlList, "CREATE DATABASE "+quoteName(d.Name))
+		}
+		for _, t := range d.TableList {
+			var fromTable *CatalogTable
+			if fromDatabase != nil {
+				fromTable = fromDatabase.GetTable(t.Name)
+			}
+			if fromTable == nil {
+				sqlList = append(sqlList, t.CreateTableSQL())
+			} else {
+				sqlList = append(sqlList, DiffTable(fromTable, t)...)
+			}
+		}
+	}
+	for _, d := range from.DatabaseList {
+		toDatabase := to.GetDatabase(d.Name)
+		if toDatabase == nil {
+			sqlList = append(sqlList, "DROP DATABASE "+quoteName(d.Name))
+			continue
+		}
+		for _, t := range d.TableList {
+			if toDatabase.GetTable(t.Name) == nil {
+				sqlList = append(sqlList, "DROP TABLE "+quoteTableName(t))
+			}
+		}
+	}
+	return sqlList
+}
+
+// DiffCreateTable 生成将from语句建出的表变更为to语句建出的表所需的ALTER TABLE语句
+func DiffCreateTable(from *CreateTableStatement, to *CreateTableStatement) ([]string, error) {
+	fromTable, err := newDiffTable(from)
+	if err != nil {
+		return nil, err
+	}
+	toTable, err := newDiffTable(to)
+	if err != nil {
+		return nil, err
+	}
+	return DiffTable(fromTable, toTable), nil
+}
+
+// newDiffTable 在临时库中执行CREATE TABLE语句得到表结构
+func newDiffTable(s *CreateTableStatement) (*CatalogTable, error) {
+	database := s.DatabaseList[0]
+	if database == "" {
+		database = diffDatabaseName
+	}
+	c := NewCatalog()
+	c.DatabaseList = append(c.DatabaseList, &CatalogDatabase{
+		Name:      database,
+		TableList: make([]*CatalogTable, 0),
+	})
+	c.CurrentDatabase = database
+	if err := c.applyCreateTable(s); err != nil {
+		return nil, err
+	}
+	t := c.GetTable(database, s.TableList[0])
+	t.Database = s.DatabaseList[0]
+	return t, nil
+}
+
+// DiffTable 生成将表from变更为表to所需的ALTER TABLE语句
+// 外键的删除单独生成一条语句以便同名外键重建，分区变更也单独生成一条语句
+func DiffTable(from *CatalogTable, to *CatalogTable) []string {
+	sqlList := make([]string, 0)
+	dropForeignKeyList := make([]string, 0)
+	for _, foreignKey := range from.ForeignKeyList {
+		toForeignKey := to.GetForeignKey(foreignKey.Name)
+		if toForeignKey == nil || !foreignKeyEqual(foreignKey, toForeignKey) {
+			dropForeignKeyList = append(dropForeignKeyList, "DROP FOREIGN KEY "+quoteName(foreignKey.Name))
+		}
+	}
+	if len(dropForeignKeyList) > 0 {
+		sqlList = append(sqlList, "ALTER TABLE "+quoteTableName(from)+" "+strings.Join(dropForeignKeyList, ", "))
+	}
+
+	specificationList := make([]string, 0)
+	for _, index := range from.IndexList {
+		toIndex := to.GetIndex(index.Name)
+		if toIndex != nil && indexEqual(index, toIndex) {
+			continue
+		}
+		if index.Kind == IndexKindPrimary {
+			specificationList = append(specificationList, "DROP PRIMARY KEY")
+		} else {
+			specificationList = append(specificationList, "DROP INDEX "+quoteName(index.Name))
+		}
+	}
+	for _, column := range from.ColumnList {
+		if to.GetColumn(column.Name) == nil {
+			specificationList = append(specificationList, "DROP COLUMN "+quoteName(column.Name))
+		}
+	}
+	specificationList = append(specificationList, diffColumnList(from, to)...)
+	for _, index := range to.IndexList {
+		fromIndex := from.GetIndex(index.Name)
+		if fromIndex == nil || !indexEqual(fromIndex, index) {
+			specificationList = append(specificationList, "ADD "+index.definitionSQL())
+		} else if fromIndex.Invisible != index.Invisible {
+			visibility := "VISIBLE"
+			if index.Invisible {
+				visibility = "INVISIBLE"
+			}
+			specificationList = append(specificationList, "ALTER INDEX "+quoteName(index.Name)+" "+visibility)
+		}
+	}
+	for _, foreignKey := range to.ForeignKeyList {
+		fromForeignKey := from.GetForeignKey(foreignKey.Name)
+		if fromForeignKey == nil || !foreignKeyEqual(fromForeignKey, foreignKey) {
+			specificationList = append(specificationList, "ADD "+foreignKey.definitionSQL())
+		}
+	}
+	for _, option := range to.OptionList {
+		// 自增值随数据变化，不作为结构差异
+		if option.Name != "AUTO_INCREMENT" && !strings.EqualFold(from.GetOption(option.Name), option.Value) {
+			specificationList = append(specificationList, option.definitionSQL())
+		}
+	}
+	for _, option := range from.OptionList {
+		if value, ok := tableOptionResetMap[option.Name]; ok && to.GetOption(option.Name) == "" &&
+			!strings.EqualFold(option.Value, value) {
+			specificationList = append(specificationList, option.Name+"="+value)
+		}
+	}
+	if len(specificationList) > 0 {
+		sqlList = append(sqlList, "ALTER TABLE "+quoteTableName(from)+" "+strings.Join(specificationList, ", "))
+	}
+
+	if from.Partition != to.Partition {
+		if to.Partition == "" {
+			sqlList = append(sqlList, "ALTER TABLE "+quoteTableName(from)+" REMOVE PARTITIONING")
+		} else {
+			sqlList = append(sqlList, "ALTER TABLE "+quoteTableName(from)+" "+to.Partition)
+		}
+	}
+	return sqlList
+}
+
+// diffColumnList 生成列的新增和修改子句
+// 以两表共有列的最长公共子序列作为不动的列，其余共有列通过MODIFY ... FIRST/AFTER移动到目标位置
+func diffColumnList(from *CatalogTable, to *CatalogTable) []string {
+	fromNameList := make([]string, 0, len(from.ColumnList))
+	for _, column := range from.ColumnList {
+		if to.GetColumn(column.Name) != nil {
+			fromNameList = append(fromNameList, strings.ToLower(column.Name))
+		}
+	}
+	toNameList := make([]string, 0, len(to.ColumnList))
+	for _, column := range to.ColumnList {
+		if from.GetColumn(column.Name) != nil {
+			toNameList = append(toNameList, strings.ToLower(column.Name))
+		}
+	}
+	stableMap := make(map[string]bool)
+	for _, name := range longestCommonSubsequence(fromNameList, toNameList) {
+		stableMap[name] = true
+	}
+
+	specificationList := make([]string, 0)
+	currentList := fromNameList
+	for i, column := range to.ColumnList {
+		name := strings.ToLower(column.Name)
+		position := " FIRST"
+		if i > 0 {
+			position = " AFTER " + quoteName(to.ColumnList[i-1].Name)
+		}
+		fromColumn := from.GetColumn(column.Name)
+		if fromColumn == nil {
+			if i == len(currentList) && isNamePrefix(currentList, to.ColumnList) {
+				specificationList = append(specificationList, "ADD COLUMN "+column.definitionSQL())
+			} else {
+				specificationList = append(specificationList, "ADD COLUMN "+column.definitionSQL()+position)
+			}
+			currentList = insertName(currentList, name, i)
+		} else if !stableMap[name] {
+			specificationList = append(specificationList, "MODIFY COLUMN "+column.definitionSQL()+position)
+			currentList = insertName(removeName(currentList, name), name, i)
+		} else if !columnEqual(fromColumn, column) {
+			specificationList = append(specificationList, "MODIFY COLUMN "+column.definitionSQL())
+		}
+	}
+	return specificationList
+}
+
+// longestCommonSubsequence 计算两个名称序列的最长公共子序列
+func longestCommonSubsequence(a []string, b []string) []string {
+	lengthList := make([][]int, len(a)+1)
+	for i := range lengthList {
+		lengthList[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lengthList[i][j] = lengthList[i+1][j+1] + 1
+			} else if lengthList[i+1][j] >= lengthList[i][j+1] {
+				lengthList[i][j] = lengthList[i+1][j]
+			} else {
+				lengthList[i][j] = lengthList[i][j+1]
+			}
+		}
+	}
+	result := make([]string, 0, lengthList[0][0])
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		if a[i] == b[j] {
+			result = append(result, a[i])
+			i++
+			j++
+		} else if lengthList[i+1][j] >= lengthList[i][j+1] {
+			i++
+		} else {
+			j++
+		}
+	}
+	return result
+}
+
+// isNamePrefix 判断nameList是否与columnList的前若干列依次相同
+func isNamePrefix(nameList []string, columnList []*CatalogColumn) bool {
+	for i, name := range nameList {
+		if i >= len(columnList) || !strings.EqualFold(name, columnList[i].Name) {
+			return false
+		}
+	}
+	return true
+}
+
+func insertName(nameList []string, name string, position int) []string {
+	if position > len(nameList) {
+		position = len(nameList)
+	}
+	result := make([]string, 0, len(nameList)+1)
+	result = append(result, nameList[:position]...)
+	result = append(result, name)
+	return append(result, nameList[position:]...)
+}
+
+func removeName(nameList []string, name string) []string {
+	result := make([]string, 0, len(nameList))
+	for _, n := range nameList {
+		if n != name {
+			result = append(result, n)
+		}
+	}
+	return result
+}
+
+func columnEqual(a *CatalogColumn, b *CatalogColumn) bool {
+	return strings.EqualFold(a.DataType, b.DataType) && a.Nullable == b.Nullable && a.HasDefault == b.HasDefault &&
+		a.Default == b.Default && strings.EqualFold(a.OnUpdate, b.OnUpdate) && a.AutoIncrement == b.AutoIncrement &&
+		a.Comment == b.Comment && a.Generated == b.Generated && a.Stored == b.Stored && a.Invisible == b.Invisible
+}
+
+func indexEqual(a *CatalogIndex, b *CatalogIndex) bool {
+	if a.Kind != b.Kind || len(a.PartList) != len(b.PartList) {
+		return false
+	}
+	for i := range a.PartList {
+		if !strings.EqualFold(strings.Join(strings.Fields(keyPartSQL(a.PartList[i])), ""),
+			strings.Join(strings.Fields(keyPartSQL(b.PartList[i])), "")) {
+			return false
+		}
+	}
+	return true
+}
+
+func foreignKeyEqual(a *CatalogForeignKey, b *CatalogForeignKey) bool {
+	return strings.EqualFold(strings.Join(a.ColumnList, ","), strings.Join(b.ColumnList, ",")) &&
+		a.ReferenceDatabase == b.ReferenceDatabase && a.ReferenceTable == b.ReferenceTable &&
+		strings.EqualFold(strings.Join(a.ReferenceColumnList, ","), strings.Join(b.ReferenceColumnList, ",")) &&
+		a.OnDelete == b.OnDelete && a.OnUpdate == b.OnUpdate
+}
+
+// CreateTableSQL 生成建表语句
+func (t *CatalogTable) CreateTableSQL() string {
+	definitionList := make([]string, 0, len(t.ColumnList)+len(t.IndexList)+len(t.ForeignKeyList))
+	for _, column := range t.ColumnList {
+		definitionList = append(definitionList, column.definitionSQL())
+	}
+	for _, index := range t.IndexList {
+		definitionList = append(definitionList, index.definitionSQL())
+	}
+	for _, foreignKey := range t.ForeignKeyList {
+		definitionList = append(definitionList, foreignKey.definitionSQL())
+	}
+	sql := "CREATE TABLE "
+	if t.Temporary {
+		sql = "CREATE TEMPORARY TABLE "
+	}
+	sql += quoteTableName(t) + " (\n  " + strings.Join(definitionList, ",\n  ") + "\n)"
+	for _, option := range t.OptionList {
+		sql += " " + option.definitionSQL()
+	}
+	if t.Partition != "" {
+		sql += "\n" + t.Partition
+	}
+	return sql
+}
+
+func (c *CatalogColumn) definitionSQL() string {
+	sql := quoteName(c.Name) + " " + c.DataType
+	if c.Generated != "" {
+		sql += " GENERATED ALWAYS AS (" + c.Generated + ")"
+		if c.Stored {
+			sql += " STORED"
+		} else {
+			sql += " VIRTUAL"
+		}
+	}
+	if !c.Nullable {
+		sql += " NOT NULL"
+	}
+	if c.HasDefault && c.Default != "" {
+		sql += " DEFAULT " + c.Default
+	}
+	if c.OnUpdate != "" {
+		sql += " ON UPDATE " + c.OnUpdate
+	}
+	if c.AutoIncrement {
+		sql += " AUTO_INCREMENT"
+	}
+	if c.Invisible {
+		sql += " INVISIBLE"
+	}
+	if c.Comment != "" {
+		sql += " COMMENT " + quoteString(c.Comment)
+	}
+	return sql
+}
+
+func (i *CatalogIndex) definitionSQL() string {
+	partList := make([]string, 0, len(i.PartList))
+	for _, part := range i.PartList {
+		partList = append(partList, keyPartSQL(part))
+	}
+	sql := ""
+	if i.Kind == IndexKindPrimary {
+		sql = "PRIMARY KEY"
+	} else if i.Kind == IndexKindUnique {
+		sql = "UNIQUE KEY " + quoteName(i.Name)
+	} else if i.Kind == IndexKindFulltext {
+		sql = "FULLTEXT KEY " + quoteName(i.Name)
+	} else if i.Kind == IndexKindSpatial {
+		sql = "SPATIAL KEY " + quoteName(i.Name)
+	} else {
+		sql = "KEY " + quoteName(i.Name)
+	}
+	sql += " (" + strings.Join(partList, ", ") + ")"
+	if i.Invisible {
+		sql += " INVISIBLE"
+	}
+	return sql
+}
+
+func (f *CatalogForeignKey) definitionSQL() string {
+	columnList := make([]string, 0, len(f.ColumnList))
+	for _, column := range f.ColumnList {
+		columnList = append(columnList, quoteName(column))
+	}
+	referenceColumnList := make([]string, 0, len(f.ReferenceColumnList))
+	for _, column := range f.ReferenceColumnList {
+		referenceColumnList = append(referenceColumnList, quoteName(column))
+	}
+	referenceTable := quoteName(f.ReferenceTable)
+	if f.ReferenceDatabase != "" {
+		referenceTable = quoteName(f.ReferenceDatabase) + "." + referenceTable
+	}
+	sql := fmt.Sprintf("CONSTRAINT %s FOREIGN KEY (%s) REFERENCES %s (%s)", quoteName(f.Name),
+		strings.Join(columnList, ", "), referenceTable, strings.Join(referenceColumnList, ", "))
+	if f.OnDelete != "" {
+		sql += " ON DELETE " + f.OnDelete
+	}
+	if f.OnUpdate != "" {
+		sql += " ON UPDATE " + f.OnUpdate
+	}
+	return sql
+}
+
+func (o *CatalogTableOption) definitionSQL() string {
+	return o.Name + "=" + o.Value
+}
+
+// keyPartSQL 为索引列定义中的列名加上反引号
+func keyPartSQL(part string) string {
+	column, suffix := splitKeyPart(part)
+	if column == "" {
+		return suffix
+	}
+	return quoteName(column) + suffix
+}
+
+func quoteName(name string) string {
+	return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+}
+
+func quoteTableName(t *CatalogTable) string {
+	if t.Database == "" {
+		return quoteName(t.Name)
+	}
+	return quoteName(t.Database) + "." + quoteName(t.Name)
+}
+
+func quoteString(str string) string {
+	str = strings.ReplaceAll(str, "\\", "\\\\")
+	return "'" + strings.ReplaceAll(str, "'", "''") + "'"
+}
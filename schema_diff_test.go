@@ -0,0 +1,94 @@
+package mysqlparser_go
+
+import (
+	"strings"
+	"testing"
+)
+
+func Test_DiffCreateTable(t *testing.T) {
+	statementList, err := Parse("CREATE TABLE t1 (id INT NOT NULL, a INT, b VARCHAR(10), c INT, pid INT, " +
+		"PRIMARY KEY (id), KEY idx_b (b(5)), UNIQUE KEY uk_c (c), CONSTRAINT fk1 FOREIGN KEY (pid) REFERENCES p (id)) " +
+		"ENGINE=InnoDB DEFAULT CHARSET=utf8 AUTO_INCREMENT=10; " +
+		"CREATE TABLE t1 (id INT NOT NULL, c INT, b VARCHAR(20) COMMENT 'it''s', n INT DEFAULT 0, pid INT, m INT, " +
+		"PRIMARY KEY (id), KEY idx_b (b(5)), KEY uk_c (c), " +
+		"CONSTRAINT fk1 FOREIGN KEY (pid) REFERENCES p (id) ON DELETE CASCADE) " +
+		"ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 PARTITION BY HASH(id) PARTITIONS 4")
+	if err != nil {
+		t.Fatalf("Error: %+v", err)
+	}
+	sqlList, err := DiffCreateTable(statementList[0].(*CreateTableStatement), statementList[1].(*CreateTableStatement))
+	if err != nil {
+		t.Fatalf("Error: %+v", err)
+	}
+	expectList := []string{
+		"ALTER TABLE `t1` DROP FOREIGN KEY `fk1`",
+		"ALTER TABLE `t1` DROP INDEX `uk_c`, DROP COLUMN `a`, MODIFY COLUMN `b` VARCHAR(20) COMMENT 'it''s' AFTER `c`, " +
+			"ADD COLUMN `n` INT DEFAULT 0 AFTER `b`, ADD COLUMN `m` INT, ADD KEY `uk_c` (`c`), " +
+			"ADD CONSTRAINT `fk1` FOREIGN KEY (`pid`) REFERENCES `p` (`id`) ON DELETE CASCADE, CHARSET=utf8mb4",
+		"ALTER TABLE `t1` PARTITION BY HASH(id) PARTITIONS 4",
+	}
+	if len(sqlList) != len(expectList) {
+		t.Fatalf("Respect: %d statements, Got: %+v", len(expectList), sqlList)
+	}
+	for i, sql := range sqlList {
+		if sql != expectList[i] {
+			t.Errorf("Respect: %s, Got: %s", expectList[i], sql)
+		}
+	}
+
+	sqlList, err = DiffCreateTable(statementList[1].(*CreateTableStatement), statementList[1].(*CreateTableStatement))
+	if err != nil || len(sqlList) != 0 {
+		t.Errorf("Got unexpected diff of same table: %+v %+v", sqlList, err)
+	}
+}
+
+func Test_DiffCatalog(t *testing.T) {
+	from := NewCatalog()
+	err := from.ApplySQL("CREATE DATABASE db1; CREATE DATABASE db2; USE db1; " +
+		"CREATE TABLE t1 (a INT, b INT, c INT, d INT); CREATE TABLE t2 (id INT)")
+	if err != nil {
+		t.Fatalf("Error: %+v", err)
+	}
+	to := NewCatalog()
+	err = to.ApplySQL("CREATE DATABASE db1; CREATE DATABASE db3; USE db1; " +
+		"CREATE TABLE t1 (d INT, a INT, b INT, c INT, INDEX idx_a (a) INVISIBLE); CREATE TABLE db3.t3 (id INT PRIMARY KEY)")
+	if err != nil {
+		t.Fatalf("Error: %+v", err)
+	}
+	sqlList := DiffCatalog(from, to)
+	expectList := []string{
+		"ALTER TABLE `db1`.`t1` MODIFY COLUMN `d` INT FIRST, ADD KEY `idx_a` (`a`) INVISIBLE",
+		"CREATE DATABASE `db3`",
+		"CREATE TABLE `db3`.`t3` (\n  `id` INT NOT NULL,\n  PRIMARY KEY (`id`)\n)",
+		"DROP TABLE `db1`.`t2`",
+		"DROP DATABASE `db2`",
+	}
+	if len(sqlList) != len(expectList) {
+		t.Fatalf("Respect: %d statements, Got: %+v", len(expectList), sqlList)
+	}
+	for i, sql := range sqlList {
+		if sql != expectList[i] {
+			t.Errorf("Respect: %s, Got: %s", expectList[i], sql)
+		}
+	}
+}
+
+func Test_DiffTableOption(t *testing.T) {
+	sqlList := map[string]string{
+		"CREATE TABLE t1 (id INT) COMMENT='x'; CREATE TABLE t1 (id INT)":                                          "ALTER TABLE `t1` COMMENT=''",
+		"CREATE TABLE t1 (id INT) ENGINE=InnoDB ROW_FORMAT=COMPRESSED KEY_BLOCK_SIZE=8; CREATE TABLE t1 (id INT)": "ALTER TABLE `t1` ROW_FORMAT=DEFAULT, KEY_BLOCK_SIZE=0",
+		"CREATE TABLE t1 (id INT) COMMENT='x' AUTO_INCREMENT=5; CREATE TABLE t1 (id INT) COMMENT='y'":             "ALTER TABLE `t1` COMMENT='y'",
+		"CREATE TABLE t1 (id INT) COMMENT=''; CREATE TABLE t1 (id INT)":                                           "",
+	}
+	for sql, expected := range sqlList {
+		statementList, err := Parse(sql)
+		if err != nil {
+			t.Errorf("%s: Error: %+v", sql, err)
+			continue
+		}
+		diffList, err := DiffCreateTable(statementList[0].(*CreateTableStatement), statementList[1].(*CreateTableStatement))
+		if err != nil || strings.Join(diffList, "; ") != expected {
+			t.Errorf("%s: Respect: %s, Got: %+v %+v", sql, expected, diffList, err)
+		}
+	}
+}
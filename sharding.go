@@ -0,0 +1,462 @@
+package mysqlparser_go
+
+import (
+	"strings"
+)
+
+// ShardRoute 语句在一个分片表上的路由结果
+// ValueList为分片键的字面量取值，每项按KeyList的顺序排列，条件矛盾时为空；
+// Scatter为true时无法确定有限的取值，须发往全部分片
+type ShardRoute struct {
+	Database  string
+	Table     string
+	KeyList   []string
+	ValueList [][]string
+	Scatter   bool
+	Reason    string
+}
+
+// shardSource 语句中引用的一个分片表
+type shardSource struct {
+	route *ShardRoute
+	alias string
+}
+
+// shardValueSet 条件对分片键取值的限定，all为true时不限定取值，否则取值为rowList中的各项
+// rowList的每项为分片键到取值的映射，不含全部分片键时只限定了部分分片键
+type shardValueSet struct {
+	all     bool
+	rowList []map[string]string
+}
+
+// GetShardRouteList 按分片键提取DML语句在各分片表上的取值
+// shardKeyMap的键为表名或库名.表名，值为分片键的列名，语句中的表带库名时优先匹配库名.表名
+// WHERE中分片键的=、<=>、IN及其AND/OR组合可以确定取值，INSERT/REPLACE按VALUES的各行或SET确定取值；
+// 没有WHERE、条件不限定全部分片键、取值不是字面量及INSERT ... SELECT时为全分片扫描
+// 同一个表被多次引用时合并其取值，语句未引用分片表时返回空列表
+func GetShardRouteList(s MySQLStatement, shardKeyMap map[string][]string) []*ShardRoute {
+	routeList := make([]*ShardRoute, 0)
+	walkStatement(s, func(t *MySQLObject) bool {
+		switch (*t).Type() {
+		case "SelectStatement", "UpdateStatement", "DeleteStatement":
+			where := getWhereExpression(t)
+			for _, source := range getShardSourceList(t, shardKeyMap, &routeList) {
+				if where == nil {
+					source.route.setScatter("no WHERE clause")
+				} else {
+					source.route.addValueSet(source.evaluate(where.ObjectList))
+				}
+			}
+		case "InsertStatement":
+			insert := (*t).(*InsertStatement)
+			if len(insert.TableList) > 0 {
+				route := getShardRoute(shardKeyMap, &routeList, insert.DatabaseList[0], insert.TableList[0])
+				route.addInsertValues(insert.ColumnList, insert.ValuesList, insert.SetList)
+			}
+		case "ReplaceStatement":
+			replace := (*t).(*ReplaceStatement)
+			if len(replace.TableList) > 0 {
+				route := getShardRoute(shardKeyMap, &routeList, replace.DatabaseList[0], replace.TableList[0])
+				route.addInsertValues(replace.ColumnList, replace.ValuesList, replace.SetList)
+			}
+		}
+		return true
+	})
+	return routeList
+}
+
+// getShardRoute 获取分片表的路由结果，不存在时新建，不是分片表时返回nil
+// 表名不区分大小写，路由结果中的表名使用shardKeyMap中的写法
+func getShardRoute(shardKeyMap map[string][]string, routeList *[]*ShardRoute, database string,
+	table string) *ShardRoute {
+	var keyList []string
+	for name, nameKeyList := range shardKeyMap {
+		if database != "" && strings.EqualFold(name, database+"."+table) {
+			keyList = nameKeyList
+			database, table = name[:len(database)], name[len(database)+1:]
+			break
+		} else if strings.EqualFold(name, table) {
+			keyList = nameKeyList
+			table = name
+		}
+	}
+	if len(keyList) == 0 {
+		return nil
+	}
+	for _, route := range *routeList {
+		if route.Database == database && route.Table == table {
+			return route
+		}
+	}
+	route := &ShardRoute{
+		Database:  database,
+		Table:     table,
+		KeyList:   keyList,
+		ValueList: make([][]string, 0),
+	}
+	*routeList = append(*routeList, route)
+	return route
+}
+
+// getShardSourceList 获取SELECT/UPDATE/DELETE直接引用的分片表，不含子查询及派生表中的表
+// 单表DELETE的表名不在TableReferenceListComponent中，其后可跟别名
+func getShardSourceList(t *MySQLObject, shardKeyMap map[string][]string,
+	routeList *[]*ShardRoute) []*shardSource {
+	sourceList := make([]*shardSource, 0)
+	var deleteTable *MySQLTableNameComponent
+	lastKeyword := ""
+	// 多表DELETE中FROM之后为要删除的表或其别名
+	singleTable := (*t).Type() == "DeleteStatement"
+	for _, child := range getObjectList(t) {
+		if (*child).Type() == "TableReferenceListComponent" {
+			singleTable = false
+		}
+	}
+	for _, child := range getObjectList(t) {
+		if (*child).Type() == "MySQLKeywordToken" {
+			lastKeyword = (*child).Value()
+		} else if (*child).Type() == "TableReferenceListComponent" {
+			walkObjectList(getObjectList(child), func(c *MySQLObject) bool {
+				if (*c).Type() == "SubQueryComponent" {
+					return false
+				}
+				if (*c).Type() != "TableFactorComponent" || (*c).(*TableFactorComponent).TableName == nil {
+					return true
+				}
+				factor := (*c).(*TableFactorComponent)
+				route := getShardRoute(shardKeyMap, routeList, factor.TableName.Database, factor.TableName.Table)
+				if route != nil {
+					sourceList = append(sourceList, &shardSource{route: route, alias: factor.Alias})
+				}
+				return false
+			})
+		} else if singleTable && (*child).Type() == "MySQLTableNameComponent" && lastKeyword == "FROM" {
+			deleteTable = (*child).(*MySQLTableNameComponent)
+			route := getShardRoute(shardKeyMap, routeList, deleteTable.Database, deleteTable.Table)
+			if route != nil {
+				sourceList = append(sourceList, &shardSource{route: route})
+			}
+		} else if deleteTable != nil && (*child).Type() == "MySQLIdentifierComponent" && len(sourceList) > 0 {
+			sourceList[0].alias = strings.Trim((*child).Value(), "`")
+			deleteTable = nil
+		}
+	}
+	return sourceList
+}
+
+// getWhereExpression 获取WHERE之后的条件表达式
+func getWhereExpression(t *MySQLObject) *MySQLExpressionComponent {
+	lastKeyword := ""
+	for _, child := range getObjectList(t) {
+		if (*child).Type() == "MySQLKeywordToken" {
+			lastKeyword = (*child).Value()
+		} else if (*child).Type() == "MySQLExpressionComponent" && lastKeyword == "WHERE" {
+			return (*child).(*MySQLExpressionComponent)
+		}
+	}
+	return nil
+}
+
+// setScatter 标记为全分片扫描，只保留第一个原因
+func (r *ShardRoute) setScatter(reason string) {
+	if !r.Scatter {
+		r.Scatter, r.Reason = true, reason
+	}
+}
+
+// addValue 追加一组分片键的取值，已存在时忽略
+func (r *ShardRoute) addValue(valueList []string) {
+	for _, existValueList := range r.ValueList {
+		if strings.Join(existValueList, "\x00") == strings.Join(valueList, "\x00") {
+			return
+		}
+	}
+	r.ValueList = append(r.ValueList, valueList)
+}
+
+// addValueSet 追加WHERE条件限定的取值，每项均须限定全部分片键
+func (r *ShardRoute) addValueSet(valueSet *shardValueSet) {
+	if valueSet.all {
+		r.setScatter("WHERE clause does not restrict the shard key")
+		return
+	}
+	for _, row := range valueSet.rowList {
+		valueList := make([]string, 0, len(r.KeyList))
+		for _, key := range r.KeyList {
+			value, ok := row[key]
+			if !ok {
+				r.setScatter("WHERE clause does not restrict shard key " + key)
+				return
+			}
+			valueList = append(valueList, value)
+		}
+		r.addValue(valueList)
+	}
+}
+
+// addInsertValues 追加INSERT/REPLACE的VALUES各行或SET中分片键的取值
+func (r *ShardRoute) addInsertValues(columnList []*MySQLColumnNameComponent,
+	valuesList [][]*MySQLExpressionComponent, setList []*MySQLAssignmentExpressionComponent) {
+	if r == nil {
+		return
+	}
+	expressionList := make([]*MySQLExpressionComponent, len(r.KeyList))
+	if len(setList) > 0 {
+		for _, assignment := range setList {
+			for i, key := range r.KeyList {
+				if assignment.Column != nil && strings.EqualFold(assignment.Column.Column, key) {
+					expressionList[i] = assignment.Expression
+				}
+			}
+		}
+		r.addInsertRow(expressionList)
+		return
+	}
+	if len(valuesList) == 0 {
+		r.setScatter("rows are inserted from a query")
+		return
+	}
+	if len(columnList) == 0 {
+		r.setScatter("column list is not specified")
+		return
+	}
+	for _, valueList := range valuesList {
+		for i, key := range r.KeyList {
+			expressionList[i] = nil
+			for j, column := range columnList {
+				if strings.EqualFold(column.Column, key) && j < len(valueList) {
+					expressionList[i] = valueList[j]
+				}
+			}
+		}
+		r.addInsertRow(expressionList)
+	}
+}
+
+// addInsertRow 追加插入的一行中分片键的取值，未指定分片键或取值不是字面量时为全分片扫描
+func (r *ShardRoute) addInsertRow(expressionList []*MySQLExpressionComponent) {
+	valueList := make([]string, 0, len(r.KeyList))
+	for i, key := range r.KeyList {
+		if expressionList[i] == nil {
+			r.setScatter("shard key " + key + " is not specified")
+			return
+		}
+		validList := getValidObjectList(expressionList[i].ObjectList)
+		value, n := getLiteralValue(validList, 0)
+		if n == 0 || n != len(validList) {
+			r.setScatter("value of shard key " + key + " is not a literal")
+			return
+		}
+		valueList = append(valueList, value)
+	}
+	r.addValue(valueList)
+}
+
+// evaluate 计算条件对分片键取值的限定，优先级由低到高依次为OR、XOR、AND
+// 含XOR或NOT的条件及其他无法识别的条件不限定取值
+func (s *shardSource) evaluate(objectList []*MySQLObject) *shardValueSet {
+	validList := getValidObjectList(objectList)
+	if len(validList) == 1 && (*validList[0]).Type() == "MySQLExpressionComponent" {
+		return s.evaluate(getObjectList(validList[0]))
+	}
+	if partList := splitCondition(validList, "OR", "||"); len(partList) > 1 {
+		result := &shardValueSet{rowList: make([]map[string]string, 0)}
+		for _, part := range partList {
+			result = result.union(s.evaluate(part))
+		}
+		return result
+	}
+	if partList := splitCondition(validList, "XOR"); len(partList) > 1 {
+		return &shardValueSet{all: true}
+	}
+	if partList := splitCondition(validList, "AND", "&&"); len(partList) > 1 {
+		result := &shardValueSet{all: true}
+		for _, part := range partList {
+			result = result.intersect(s.evaluate(part))
+		}
+		return result
+	}
+	if len(validList) > 2 && getClosingParenthesis(validList, 0) == len(validList)-1 {
+		return s.evaluate(validList[1 : len(validList)-1])
+	}
+	return s.evaluatePredicate(validList)
+}
+
+// evaluatePredicate 计算单个谓词的限定，支持key = value、value = key、key <=> value及key IN (value, ...)
+func (s *shardSource) evaluatePredicate(validList []*MySQLObject) *shardValueSet {
+	key, n := s.getKey(validList, 0)
+	if n > 0 && key != "" && n+1 < len(validList) {
+		if isOperatorToken(validList[n], "=", "<=>") {
+			if value, m := getLiteralValue(validList, n+1); m > 0 && n+1+m == len(validList) {
+				return &shardValueSet{rowList: []map[string]string{{key: value}}}
+			}
+		} else if isKeywordToken(validList[n], "IN") && getClosingParenthesis(validList, n+1) == len(validList)-1 {
+			result := &shardValueSet{rowList: make([]map[string]string, 0)}
+			for i := n + 2; i < len(validList)-1; i++ {
+				value, m := getLiteralValue(validList, i)
+				if m == 0 {
+					return &shardValueSet{all: true}
+				}
+				result.rowList = append(result.rowList, map[string]string{key: value})
+				i += m
+				if i < len(validList)-1 && !((*validList[i]).Type() == "MySQLDelimiterToken" && (*validList[i]).Value() == ",") {
+					return &shardValueSet{all: true}
+				}
+			}
+			return result
+		}
+	} else if value, m := getLiteralValue(validList, 0); m > 0 && m+1 < len(validList) &&
+		isOperatorToken(validList[m], "=", "<=>") {
+		if key, n := s.getKey(validList, m+1); key != "" && m+1+n == len(validList) {
+			return &shardValueSet{rowList: []map[string]string{{key: value}}}
+		}
+	}
+	return &shardValueSet{all: true}
+}
+
+// getKey 第i个对象起为分片表的分片键时返回分片键的列名，n为列引用占用的对象数，不是列引用时为0
+func (s *shardSource) getKey(validList []*MySQLObject, i int) (key string, n int) {
+	database, table, column := "", "", ""
+	if (*validList[i]).Type() == "MySQLColumnNameComponent" {
+		columnName := (*validList[i]).(*MySQLColumnNameComponent)
+		database, table, column, n = columnName.Database, columnName.Table, columnName.Column, 1
+	} else if isNameToken(validList[i]) && !(i+1 < len(validList) && isOperatorToken(validList[i+1], "(")) {
+		partList := []string{getNameValue(validList[i])}
+		n = 1
+		for i+n+1 < len(validList) && len(partList) < 3 && isOperatorToken(validList[i+n], ".") &&
+			isNameToken(validList[i+n+1]) {
+			partList = append(partList, getNameValue(validList[i+n+1]))
+			n += 2
+		}
+		for len(partList) < 3 {
+			partList = append([]string{""}, partList...)
+		}
+		database, table, column = partList[0], partList[1], partList[2]
+	} else {
+		return "", 0
+	}
+	if table != "" {
+		if s.alias != "" && (!strings.EqualFold(table, s.alias) || database != "") {
+			return "", n
+		} else if s.alias == "" && (!strings.EqualFold(table, s.route.Table) ||
+			(database != "" && !strings.EqualFold(database, s.route.Database))) {
+			return "", n
+		}
+	}
+	for _, key := range s.route.KeyList {
+		if strings.EqualFold(key, column) {
+			return key, n
+		}
+	}
+	return "", n
+}
+
+func (v *shardValueSet) union(other *shardValueSet) *shardValueSet {
+	if v.all || other.all {
+		return &shardValueSet{all: true}
+	}
+	return &shardValueSet{
+		rowList: append(append(make([]map[string]string, 0), v.rowList...), other.rowList...),
+	}
+}
+
+// intersect 两组取值两两合并，同一分片键取值不同的组合被排除
+func (v *shardValueSet) intersect(other *shardValueSet) *shardValueSet {
+	if v.all {
+		return other
+	} else if other.all {
+		return v
+	}
+	result := &shardValueSet{rowList: make([]map[string]string, 0)}
+	for _, row := range v.rowList {
+		for _, otherRow := range other.rowList {
+			merged := make(map[string]string)
+			for key, value := range row {
+				merged[key] = value
+			}
+			conflict := false
+			for key, value := range otherRow {
+				if mergedValue, ok := merged[key]; ok && mergedValue != value {
+					conflict = true
+				}
+				merged[key] = value
+			}
+			if !conflict {
+				result.rowList = append(result.rowList, merged)
+			}
+		}
+	}
+	return result
+}
+
+// splitCondition 在最外层按逻辑运算符拆分条件，跳过括号、CASE ... END及BETWEEN ... AND中的运算符
+func splitCondition(validList []*MySQLObject, operatorList ...string) [][]*MySQLObject {
+	partList := make([][]*MySQLObject, 0)
+	depth, caseDepth, start := 0, 0, 0
+	between := false
+	for i, t := range validList {
+		if isOperatorToken(t, "(") {
+			depth++
+		} else if isOperatorToken(t, ")") {
+			depth--
+		} else if isKeywordToken(t, "CASE") {
+			caseDepth++
+		} else if isKeywordToken(t, "END") {
+			caseDepth--
+		} else if depth == 0 && caseDepth == 0 && isKeywordToken(t, "BETWEEN") {
+			between = true
+		} else if depth == 0 && caseDepth == 0 && between && isKeywordToken(t, "AND") {
+			between = false
+		} else if depth == 0 && caseDepth == 0 &&
+			((*t).Type() == "MySQLKeywordToken" || (*t).Type() == "MySQLOperatorToken") &&
+			InArray((*t).Value(), operatorList) {
+			partList = append(partList, validList[start:i])
+			start = i + 1
+		}
+	}
+	return append(partList, validList[start:])
+}
+
+// getClosingParenthesis 第i个对象为左括号时返回与其匹配的右括号的位置，否则返回-1
+func getClosingParenthesis(validList []*MySQLObject, i int) int {
+	if i >= len(validList) || !isOperatorToken(validList[i], "(") {
+		return -1
+	}
+	depth := 0
+	for j := i; j < len(validList); j++ {
+		if isOperatorToken(validList[j], "(") {
+			depth++
+		} else if isOperatorToken(validList[j], ")") {
+			depth--
+			if depth == 0 {
+				return j
+			}
+		}
+	}
+	return -1
+}
+
+// getLiteralValue 第i个对象起为数字或字符串字面量时返回其值，n为占用的对象数，不是字面量时为0
+// 字符串去掉引号，带符号的数字保留负号
+func getLiteralValue(validList []*MySQLObject, i int) (value string, n int) {
+	if i >= len(validList) {
+		return "", 0
+	}
+	t := validList[i]
+	if (*t).Type() == "MySQLNumericToken" {
+		return (*t).Value(), 1
+	} else if (*t).Type() == "MySQLStringToken" {
+		return unquoteString((*t).Value()), 1
+	} else if isOperatorToken(t, "-", "+") && i+1 < len(validList) &&
+		(*validList[i+1]).Type() == "MySQLNumericToken" {
+		if (*t).Value() == "-" {
+			return "-" + (*validList[i+1]).Value(), 2
+		}
+		return (*validList[i+1]).Value(), 2
+	}
+	return "", 0
+}
+
+func isOperatorToken(t *MySQLObject, valueList ...string) bool {
+	return (*t).Type() == "MySQLOperatorToken" && InArray((*t).Value(), valueList)
+}
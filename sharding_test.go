@@ -0,0 +1,73 @@
+package mysqlparser_go
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func formatShardRouteList(routeList []*ShardRoute) string {
+	resultList := make([]string, 0)
+	for _, route := range routeList {
+		valueList := make([]string, 0)
+		for _, value := range route.ValueList {
+			valueList = append(valueList, strings.Join(value, "/"))
+		}
+		result := fmt.Sprintf("%s=%s", joinName(route.Database, route.Table), strings.Join(valueList, ","))
+		if route.Scatter {
+			result = fmt.Sprintf("%s!%s", joinName(route.Database, route.Table), route.Reason)
+		}
+		resultList = append(resultList, result)
+	}
+	return strings.Join(resultList, " ")
+}
+
+func Test_ShardRoute(t *testing.T) {
+	shardKeyMap := map[string][]string{
+		"ad":           {"FUId"},
+		"DB_Ad_43.log": {"FUId", "FDate"},
+		"user":         {"id"},
+	}
+	sqlList := map[string]string{
+		"SELECT * FROM ad WHERE FUId = 5":                                          "ad=5",
+		"SELECT * FROM ad WHERE fuid IN (1, '2', -3) AND FState = 1":               "ad=1,2,-3",
+		"SELECT * FROM ad AS a WHERE (a.FUId = 1 OR 2 = a.FUId) AND a.FUId <=> 1":  "ad=1",
+		"SELECT * FROM ad WHERE FUId = 1 AND FUId = 2":                             "ad=",
+		"SELECT * FROM ad WHERE FUId = 1 OR FState = 2":                            "ad!WHERE clause does not restrict the shard key",
+		"SELECT * FROM ad WHERE FUId BETWEEN 1 AND 3 AND FUId = 2":                 "ad=2",
+		"SELECT * FROM ad WHERE FState = 1 XOR FUId = 2 AND FUId = 3":              "ad!WHERE clause does not restrict the shard key",
+		"SELECT * FROM ad WHERE NOT FUId = 1":                                      "ad!WHERE clause does not restrict the shard key",
+		"SELECT * FROM ad WHERE FUId = ?":                                          "ad!WHERE clause does not restrict the shard key",
+		"SELECT * FROM ad":                                                         "ad!no WHERE clause",
+		"SELECT * FROM other WHERE id = 1":                                         "",
+		"SELECT * FROM ad JOIN user ON ad.FUId = user.id WHERE ad.FUId = 7":        "ad=7 user!WHERE clause does not restrict the shard key",
+		"SELECT * FROM ad WHERE FUId IN (SELECT id FROM user WHERE id = 3)":        "ad!WHERE clause does not restrict the shard key user=3",
+		"SELECT * FROM ad WHERE FUId = 1 UNION SELECT * FROM ad WHERE FUId = 2":    "ad=1,2",
+		"SELECT * FROM DB_Ad_43.log WHERE FUId IN (1, 2) AND FDate = '2024-01-01'": "DB_Ad_43.log=1/2024-01-01,2/2024-01-01",
+		"SELECT * FROM DB_Ad_43.log WHERE FUId = 1":                                "DB_Ad_43.log!WHERE clause does not restrict shard key FDate",
+		"SELECT * FROM log WHERE FUId = 1":                                         "",
+		"UPDATE ad SET FState = 0 WHERE FUId = 9 AND (FState = 1 OR FState = 2)":   "ad=9",
+		"UPDATE ad a JOIN user u ON u.id = a.FUId SET a.FState = 0 WHERE u.id = 4": "ad!WHERE clause does not restrict the shard key user=4",
+		"DELETE FROM ad WHERE FUId IN (8)":                                         "ad=8",
+		"DELETE FROM ad AS a WHERE a.FUId = 8":                                     "ad=8",
+		"DELETE FROM ad WHERE other.FUId = 8":                                      "ad!WHERE clause does not restrict the shard key",
+		"DELETE a FROM ad a JOIN user u ON u.id = a.FUId WHERE a.FUId = 1":         "ad=1 user!WHERE clause does not restrict the shard key",
+		"INSERT INTO ad (FState, FUId) VALUES (1, 10), (2, '11'), (3, 10)":         "ad=10,11",
+		"INSERT INTO ad SET FUId = 12, FState = 1":                                 "ad=12",
+		"INSERT INTO ad (FUId) VALUES (10), (LAST_INSERT_ID())":                    "ad!value of shard key FUId is not a literal",
+		"INSERT INTO ad (FState) VALUES (1)":                                       "ad!shard key FUId is not specified",
+		"INSERT INTO ad VALUES (1, 2)":                                             "ad!column list is not specified",
+		"INSERT INTO ad (FUId) SELECT id FROM user WHERE id = 5":                   "ad!rows are inserted from a query user=5",
+		"REPLACE INTO DB_Ad_43.log (FUId, FDate) VALUES (-1, '2024-01-02')":        "DB_Ad_43.log=-1/2024-01-02",
+	}
+	for sql, expected := range sqlList {
+		statementList, err := Parse(sql)
+		if err != nil || len(statementList) != 1 {
+			t.Errorf("%s: Error: %+v", sql, err)
+			continue
+		}
+		if result := formatShardRouteList(GetShardRouteList(statementList[0], shardKeyMap)); result != expected {
+			t.Errorf("%s: Respect: %s, Got: %s", sql, expected, result)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package mysqlparser_go
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 )
@@ -17,26 +18,52 @@ func GetStatementGen(t string) func(tokenList MySQLTokenList,
 	verboseFunc func(message string, level LogLevel)) (MySQLStatement, MySQLTokenList) {
 	funcMap := map[string]func(tokenList MySQLTokenList,
 		verboseFunc func(message string, level LogLevel)) (MySQLStatement, MySQLTokenList){
-		"AlterDatabaseStatement":  NewAlterDatabaseStatement,
-		"AlterTableStatement":     NewAlterTableStatement,
-		"CreateDatabaseStatement": NewCreateDatabaseStatement,
-		"CreateTableStatement":    NewCreateTableStatement,
-		"CreateIndexStatement":    NewCreateIndexStatement,
-		"DeleteStatement":         NewDeleteStatement,
-		"DropDatabaseStatement":   NewDropDatabaseStatement,
-		"DropTableStatement":      NewDropTableStatement,
-		"DropIndexStatement":      NewDropIndexStatement,
-		"ExplainStatement":        NewExplainStatement,
-		"InsertStatement":         NewInsertStatement,
-		"RenameTableStatement":    NewRenameTableStatement,
-		"ReplaceStatement":        NewReplaceStatement,
-		"SelectStatement":         NewSelectStatement,
-		"UnionStatement":          NewUnionStatement,
-		"SetStatement":            NewSetStatement,
-		"ShowStatement":           NewShowStatement,
-		"TruncateTableStatement":  NewTruncateTableStatement,
-		"UpdateStatement":         NewUpdateStatement,
-		"UseStatement":            NewUseStatement,
+		"AlterDatabaseStatement":           NewAlterDatabaseStatement,
+		"AlterTableStatement":              NewAlterTableStatement,
+		"AnalyzeTableStatement":            NewAnalyzeTableStatement,
+		"CallStatement":                    NewCallStatement,
+		"ChangeMasterStatement":            NewChangeMasterStatement,
+		"ChangeReplicationFilterStatement": NewChangeReplicationFilterStatement,
+		"CheckTableStatement":              NewCheckTableStatement,
+		"ChecksumTableStatement":           NewChecksumTableStatement,
+		"CreateDatabaseStatement":          NewCreateDatabaseStatement,
+		"CreateTableStatement":             NewCreateTableStatement,
+		"CreateIndexStatement":             NewCreateIndexStatement,
+		"DeallocatePrepareStatement":       NewDeallocatePrepareStatement,
+		"DeleteStatement":                  NewDeleteStatement,
+		"DoStatement":                      NewDoStatement,
+		"DropDatabaseStatement":            NewDropDatabaseStatement,
+		"DropTableStatement":               NewDropTableStatement,
+		"DropIndexStatement":               NewDropIndexStatement,
+		"ExecuteStatement":                 NewExecuteStatement,
+		"ExplainStatement":                 NewExplainStatement,
+		"FlushStatement":                   NewFlushStatement,
+		"HandlerStatement":                 NewHandlerStatement,
+		"InsertStatement":                  NewInsertStatement,
+		"KillStatement":                    NewKillStatement,
+		"LockTablesStatement":              NewLockTablesStatement,
+		"OptimizeTableStatement":           NewOptimizeTableStatement,
+		"PrepareStatement":                 NewPrepareStatement,
+		"PurgeBinaryLogsStatement":         NewPurgeBinaryLogsStatement,
+		"RenameTableStatement":             NewRenameTableStatement,
+		"RepairTableStatement":             NewRepairTableStatement,
+		"ReplaceStatement":                 NewReplaceStatement,
+		"ResetStatement":                   NewResetStatement,
+		"ResetSlaveStatement":              NewResetSlaveStatement,
+		"SelectStatement":                  NewSelectStatement,
+		"UnionStatement":                   NewUnionStatement,
+		"SetStatement":                     NewSetStatement,
+		"ShowStatement":                    NewShowStatement,
+		"ShutdownStatement":                NewShutdownStatement,
+		"StartSlaveStatement":              NewStartSlaveStatement,
+		"StopSlaveStatement":               NewStopSlaveStatement,
+		"TableStatement":                   NewTableStatement,
+		"TransactionStatement":             NewTransactionStatement,
+		"TruncateTableStatement":           NewTruncateTableStatement,
+		"UpdateStatement":                  NewUpdateStatement,
+		"UseStatement":                     NewUseStatement,
+		"ValuesStatement":                  NewValuesStatement,
+		"XAStatement":                      NewXAStatement,
 	}
 	return funcMap[t]
 }
@@ -60,6 +87,11 @@ func (s *MySQLBaseStatement) GetFsmMap() []FsmMap {
 	return fsmMap
 }
 
+// GetObjectList 获取组成语句的对象列表
+func (s *MySQLBaseStatement) GetObjectList() []*MySQLObject {
+	return s.ObjectList
+}
+
 func (s *MySQLBaseStatement) ParseByFsm(fsmMap []FsmMap, tokenList MySQLTokenList, specialFinalStatus []int,
 	verboseFunc func(message string, level LogLevel)) int {
 	finalStatus := FinalStatus
@@ -289,11 +321,20 @@ func NewAlterDatabaseStatement(tokenList MySQLTokenList,
 // ALTER [ONLINE|OFFLINE] [IGNORE] TABLE tbl_name
 //   [alter_specification [, alter_specification] ...]
 //   [partition_options]
+//
+// alter_specification:
+//   ...
+//   | ALGORITHM [=] {DEFAULT | INSTANT | INPLACE | COPY}
+//   | LOCK [=] {DEFAULT | NONE | SHARED | EXCLUSIVE}
 
 type AlterTableStatement struct {
 	*MySQLBaseStatement
-	Database string
-	Table    string
+	Database          string
+	Table             string
+	SpecificationList []*MySQLAlterTableSpecificationComponent
+	Partition         *MySQLPartitionOptionComponent
+	Algorithm         string
+	Lock              string
 }
 
 func (s *AlterTableStatement) Type() string {
@@ -350,6 +391,48 @@ func (s *AlterTableStatement) GetFsmMap() []FsmMap {
 			AcceptValue:  ",",
 			EndStatus:    7,
 		},
+		{
+			StartStatus:  []int{5, 7},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "ALGORITHM",
+			EndStatus:    8,
+		},
+		{
+			StartStatus:  []int{8},
+			AcceptObject: "MySQLOperatorToken",
+			AcceptValue:  "=",
+			EndStatus:    9,
+		},
+		{
+			StartStatus:  []int{8, 9, 10, 11},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "DEFAULT",
+			EndStatus:    6,
+		},
+		{
+			StartStatus:  []int{8, 9, 10, 11},
+			AcceptObject: "MySQLUnquotedIdentifierToken",
+			AcceptValue:  "",
+			EndStatus:    6,
+		},
+		{
+			StartStatus:  []int{5, 7},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "LOCK",
+			EndStatus:    10,
+		},
+		{
+			StartStatus:  []int{10},
+			AcceptObject: "MySQLOperatorToken",
+			AcceptValue:  "=",
+			EndStatus:    11,
+		},
+		{
+			StartStatus:  []int{10, 11},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "NONE",
+			EndStatus:    6,
+		},
 		{
 			StartStatus:  []int{5, 6, 7},
 			AcceptObject: "MySQLPartitionOptionComponent",
@@ -359,6 +442,11 @@ func (s *AlterTableStatement) GetFsmMap() []FsmMap {
 	}
 }
 
+var (
+	alterTableAlgorithmList = []string{"DEFAULT", "INSTANT", "INPLACE", "COPY"}
+	alterTableLockList      = []string{"DEFAULT", "NONE", "SHARED", "EXCLUSIVE"}
+)
+
 func NewAlterTableStatement(tokenList MySQLTokenList,
 	verboseFunc func(message string, level LogLevel)) (MySQLStatement, MySQLTokenList) {
 	startPos := tokenList.CurrentPos()
@@ -366,105 +454,189 @@ func NewAlterTableStatement(tokenList MySQLTokenList,
 		MySQLBaseStatement: &MySQLBaseStatement{
 			ObjectList: make([]*MySQLObject, 0),
 		},
+		SpecificationList: make([]*MySQLAlterTableSpecificationComponent, 0),
 	}
 	endPos := s.ParseByFsm(s.GetFsmMap(), tokenList, []int{5, 6}, verboseFunc)
 	if endPos == -1 {
 		tokenList.Reset(startPos)
 		return nil, tokenList
 	} else {
+		lastKeyword := ""
 		for _, t := range s.ObjectList {
 			if (*t).Type() == "MySQLTableNameComponent" {
 				s.Database = (*t).(*MySQLTableNameComponent).Database
 				s.Table = (*t).(*MySQLTableNameComponent).Table
+			} else if (*t).Type() == "MySQLAlterTableSpecificationComponent" {
+				s.SpecificationList = append(s.SpecificationList, (*t).(*MySQLAlterTableSpecificationComponent))
+			} else if (*t).Type() == "MySQLPartitionOptionComponent" {
+				s.Partition = (*t).(*MySQLPartitionOptionComponent)
+			} else if (*t).Type() == "MySQLKeywordToken" && ((*t).Value() == "ALGORITHM" || (*t).Value() == "LOCK") {
+				lastKeyword = (*t).Value()
+			} else if (*t).Type() == "MySQLKeywordToken" || (*t).Type() == "MySQLUnquotedIdentifierToken" {
+				if lastKeyword == "ALGORITHM" {
+					s.Algorithm = strings.ToUpper((*t).Value())
+				} else if lastKeyword == "LOCK" {
+					s.Lock = strings.ToUpper((*t).Value())
+				}
+				lastKeyword = ""
 			}
 		}
+		if (s.Algorithm != "" && !InArray(s.Algorithm, alterTableAlgorithmList)) ||
+			(s.Lock != "" && !InArray(s.Lock, alterTableLockList)) {
+			tokenList.Reset(startPos)
+			return nil, tokenList
+		}
 		tokenList.Reset(endPos)
 		return s, tokenList
 	}
 }
 
-// 13.1.10 CREATE DATABASE Syntax
-// CREATE { DATABASE | SCHEMA } [IF NOT EXISTS] db_name
-//   [create_specification] ...
+// 13.7.2.1 ANALYZE TABLE Syntax
+// ANALYZE [NO_WRITE_TO_BINLOG | LOCAL]
+//    TABLE tbl_name [, tbl_name] ...
+//
+// ANALYZE [NO_WRITE_TO_BINLOG | LOCAL]
+//    TABLE tbl_name
+//    UPDATE HISTOGRAM ON col_name [, col_name] ...
+//        [WITH N BUCKETS]
+//
+// ANALYZE [NO_WRITE_TO_BINLOG | LOCAL]
+//    TABLE tbl_name
+//    DROP HISTOGRAM ON col_name [, col_name] ...
 
-type CreateDatabaseStatement struct {
+type AnalyzeTableStatement struct {
 	*MySQLBaseStatement
-	Database string
+	DatabaseList        []string
+	TableList           []string
+	NoWriteToBinlog     bool
+	HistogramAction     string
+	HistogramColumnList []string
+	Buckets             string
 }
 
-func (s *CreateDatabaseStatement) Type() string {
-	return "CreateDatabaseStatement"
+func (s *AnalyzeTableStatement) Type() string {
+	return "AnalyzeTableStatement"
 }
 
-func (s *CreateDatabaseStatement) GetFsmMap() []FsmMap {
+func (s *AnalyzeTableStatement) GetFsmMap() []FsmMap {
 	return []FsmMap{
 		{
 			StartStatus:  []int{0},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "CREATE",
+			AcceptValue:  "ANALYZE",
 			EndStatus:    1,
 		},
 		{
 			StartStatus:  []int{1},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "DATABASE",
+			AcceptValue:  "NO_WRITE_TO_BINLOG",
 			EndStatus:    2,
 		},
 		{
 			StartStatus:  []int{1},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "SCHEMA",
+			AcceptValue:  "LOCAL",
 			EndStatus:    2,
 		},
 		{
-			StartStatus:  []int{2},
+			StartStatus:  []int{1, 2},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "IF",
+			AcceptValue:  "TABLE",
 			EndStatus:    3,
 		},
 		{
 			StartStatus:  []int{3},
-			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "NOT",
+			AcceptObject: "MySQLTableNameListComponent",
+			AcceptValue:  "",
 			EndStatus:    4,
 		},
 		{
 			StartStatus:  []int{4},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "EXISTS",
+			AcceptValue:  "UPDATE",
 			EndStatus:    5,
 		},
 		{
-			StartStatus:  []int{2, 5},
-			AcceptObject: "MySQLDatabaseNameComponent",
-			AcceptValue:  "",
+			StartStatus:  []int{4},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "DROP",
+			EndStatus:    5,
+		},
+		{
+			StartStatus:  []int{5},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "HISTOGRAM",
 			EndStatus:    6,
 		},
 		{
-			StartStatus:  []int{6, 7},
-			AcceptObject: "MySQLDatabaseOptionComponent",
-			AcceptValue:  "",
+			StartStatus:  []int{6},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "ON",
 			EndStatus:    7,
 		},
+		{
+			StartStatus:  []int{7, 9},
+			AcceptObject: "MySQLIdentifierComponent",
+			AcceptValue:  "",
+			EndStatus:    8,
+		},
+		{
+			StartStatus:  []int{8},
+			AcceptObject: "MySQLDelimiterToken",
+			AcceptValue:  ",",
+			EndStatus:    9,
+		},
+		{
+			StartStatus:  []int{8},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "WITH",
+			EndStatus:    10,
+		},
+		{
+			StartStatus:  []int{10},
+			AcceptObject: "MySQLNumericToken",
+			AcceptValue:  "",
+			EndStatus:    11,
+		},
+		{
+			StartStatus:  []int{11},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "BUCKETS",
+			EndStatus:    FinalStatus,
+		},
 	}
 }
 
-func NewCreateDatabaseStatement(tokenList MySQLTokenList,
+func NewAnalyzeTableStatement(tokenList MySQLTokenList,
 	verboseFunc func(message string, level LogLevel)) (MySQLStatement, MySQLTokenList) {
 	startPos := tokenList.CurrentPos()
-	s := &CreateDatabaseStatement{
+	s := &AnalyzeTableStatement{
 		MySQLBaseStatement: &MySQLBaseStatement{
 			ObjectList: make([]*MySQLObject, 0),
 		},
+		DatabaseList:        make([]string, 0),
+		TableList:           make([]string, 0),
+		HistogramColumnList: make([]string, 0),
 	}
-	endPos := s.ParseByFsm(s.GetFsmMap(), tokenList, []int{6, 7}, verboseFunc)
+	endPos := s.ParseByFsm(s.GetFsmMap(), tokenList, []int{4, 8}, verboseFunc)
 	if endPos == -1 {
 		tokenList.Reset(startPos)
 		return nil, tokenList
 	} else {
 		for _, t := range s.ObjectList {
-			if (*t).Type() == "MySQLDatabaseNameComponent" {
-				s.Database = (*t).(*MySQLDatabaseNameComponent).Database
+			if (*t).Type() == "MySQLTableNameListComponent" {
+				for _, tableName := range (*t).(*MySQLTableNameListComponent).TableList {
+					s.DatabaseList = append(s.DatabaseList, tableName.Database)
+					s.TableList = append(s.TableList, tableName.Table)
+				}
+			} else if (*t).Type() == "MySQLKeywordToken" && InArray((*t).Value(), []string{"NO_WRITE_TO_BINLOG", "LOCAL"}) {
+				s.NoWriteToBinlog = true
+			} else if (*t).Type() == "MySQLKeywordToken" && InArray((*t).Value(), []string{"UPDATE", "DROP"}) {
+				s.HistogramAction = (*t).Value()
+			} else if (*t).Type() == "MySQLIdentifierComponent" {
+				s.HistogramColumnList = append(s.HistogramColumnList, strings.Trim((*t).Value(), "`"))
+			} else if (*t).Type() == "MySQLNumericToken" {
+				s.Buckets = (*t).Value()
 			}
 		}
 		tokenList.Reset(endPos)
@@ -472,196 +644,236 @@ func NewCreateDatabaseStatement(tokenList MySQLTokenList,
 	}
 }
 
-// 13.1.17 CREATE TABLE Syntax
-// CREATE [TEMPORARY] TABLE [IF NOT EXISTS] tbl_name
-//   (create_specification ...)
-//   [table_options]
-//   [partition_options]
-//
-// CREATE [TEMPORARY] TABLE [IF NOT EXISTS] tbl_name
-//   (create_specification ...)
-//   [table_options]
-//   [partition_options]
-//   [IGNORE | REPLACE]
-//   [AS] query_expression
-//
-// CREATE [TEMPORARY] TABLE [IF NOT EXISTS] tbl_name
-//   { LIKE old_tbl_name | (LIKE old_tbl_name) }
+// 13.2.1 CALL Syntax
+// CALL sp_name([parameter[,...]])
+// CALL sp_name[()]
 
-type CreateTableStatement struct {
+type CallStatement struct {
 	*MySQLBaseStatement
+	Database     string
+	Procedure    string
+	ArgumentList []*MySQLExpressionComponent
 	DatabaseList []string
 	TableList    []string
-	FromDatabase string
-	FromTable    string
 }
 
-func (s *CreateTableStatement) Type() string {
-	return "CreateTableStatement"
+func (s *CallStatement) Type() string {
+	return "CallStatement"
 }
 
-func (s *CreateTableStatement) GetFsmMap() []FsmMap {
+func (s *CallStatement) GetFsmMap() []FsmMap {
 	return []FsmMap{
 		{
 			StartStatus:  []int{0},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "CREATE",
+			AcceptValue:  "CALL",
 			EndStatus:    1,
 		},
 		{
 			StartStatus:  []int{1},
-			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "TEMPORARY",
+			AcceptObject: "MySQLTableNameComponent",
+			AcceptValue:  "",
 			EndStatus:    2,
 		},
 		{
-			StartStatus:  []int{1, 2},
-			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "TABLE",
-			EndStatus:    3,
+			StartStatus:  []int{2},
+			AcceptObject: "MySQLValueListComponent",
+			AcceptValue:  "",
+			EndStatus:    FinalStatus,
+		},
+	}
+}
+
+func NewCallStatement(tokenList MySQLTokenList,
+	verboseFunc func(message string, level LogLevel)) (MySQLStatement, MySQLTokenList) {
+	startPos := tokenList.CurrentPos()
+	s := &CallStatement{
+		MySQLBaseStatement: &MySQLBaseStatement{
+			ObjectList: make([]*MySQLObject, 0),
 		},
+		ArgumentList: make([]*MySQLExpressionComponent, 0),
+		DatabaseList: make([]string, 0),
+		TableList:    make([]string, 0),
+	}
+	endPos := s.ParseByFsm(s.GetFsmMap(), tokenList, []int{2}, verboseFunc)
+	if endPos == -1 {
+		tokenList.Reset(startPos)
+		return nil, tokenList
+	} else {
+		for _, t := range s.ObjectList {
+			if (*t).Type() == "MySQLTableNameComponent" {
+				s.Database = (*t).(*MySQLTableNameComponent).Database
+				s.Procedure = (*t).(*MySQLTableNameComponent).Table
+			} else if (*t).Type() == "MySQLValueListComponent" {
+				s.ArgumentList = (*t).(*MySQLValueListComponent).ValueList
+				for _, expression := range s.ArgumentList {
+					for _, tmpT := range expression.ObjectList {
+						if (*tmpT).Type() == "SubQueryComponent" {
+							s.DatabaseList = append(s.DatabaseList, (*tmpT).(*SubQueryComponent).DatabaseList...)
+							s.TableList = append(s.TableList, (*tmpT).(*SubQueryComponent).TableList...)
+						}
+					}
+				}
+			}
+		}
+		tokenList.Reset(endPos)
+		return s, tokenList
+	}
+}
+
+// 13.4.2.1 CHANGE MASTER TO Syntax
+// CHANGE MASTER TO option [, option] ... [ channel_option ]
+// CHANGE REPLICATION SOURCE TO option [, option] ... [ channel_option ]
+//
+// option:
+//    MASTER_BIND = 'interface_name'
+//  | MASTER_HOST = 'host_name'
+//  | MASTER_USER = 'user_name'
+//  | MASTER_PASSWORD = 'password'
+//  | MASTER_PORT = port_num
+//  | MASTER_CONNECT_RETRY = interval
+//  | MASTER_RETRY_COUNT = count
+//  | MASTER_DELAY = interval
+//  | MASTER_HEARTBEAT_PERIOD = interval
+//  | MASTER_LOG_FILE = 'master_log_name'
+//  | MASTER_LOG_POS = master_log_pos
+//  | MASTER_AUTO_POSITION = {0|1}
+//  | RELAY_LOG_FILE = 'relay_log_name'
+//  | RELAY_LOG_POS = relay_log_pos
+//  | MASTER_SSL = {0|1}
+//  | MASTER_SSL_CA = 'ca_file_name'
+//  | MASTER_SSL_CAPATH = 'ca_directory_name'
+//  | MASTER_SSL_CERT = 'cert_file_name'
+//  | MASTER_SSL_CRL = 'crl_file_name'
+//  | MASTER_SSL_CRLPATH = 'crl_directory_name'
+//  | MASTER_SSL_KEY = 'key_file_name'
+//  | MASTER_SSL_CIPHER = 'cipher_list'
+//  | MASTER_SSL_VERIFY_SERVER_CERT = {0|1}
+//  | MASTER_TLS_VERSION = 'protocol_list'
+//  | IGNORE_SERVER_IDS = (server_id_list)
+//
+// channel_option:
+//    FOR CHANNEL channel
+//
+// The SOURCE_* spellings are accepted after CHANGE REPLICATION SOURCE TO.
+// OptionMap is keyed by the option name as written; use GetOption to look up
+// an option regardless of spelling.
+
+type ChangeMasterStatement struct {
+	*MySQLBaseStatement
+	ReplicationSource  bool
+	OptionMap          map[string]string
+	IgnoreServerIdList []string
+	Channel            string
+}
+
+func (s *ChangeMasterStatement) Type() string {
+	return "ChangeMasterStatement"
+}
+
+func (s *ChangeMasterStatement) Value() string {
+	return redactReplicationPassword(s.ObjectList)
+}
+
+func (s *ChangeMasterStatement) GetFsmMap() []FsmMap {
+	return []FsmMap{
 		{
-			StartStatus:  []int{3},
+			StartStatus:  []int{0},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "IF",
-			EndStatus:    4,
+			AcceptValue:  "CHANGE",
+			EndStatus:    1,
 		},
 		{
-			StartStatus:  []int{4},
+			StartStatus:  []int{1},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "NOT",
-			EndStatus:    5,
+			AcceptValue:  "MASTER",
+			EndStatus:    3,
 		},
 		{
-			StartStatus:  []int{5},
+			StartStatus:  []int{1},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "EXISTS",
-			EndStatus:    6,
+			AcceptValue:  "REPLICATION",
+			EndStatus:    2,
 		},
 		{
-			StartStatus:  []int{3, 6},
-			AcceptObject: "MySQLTableNameComponent",
-			AcceptValue:  "",
-			EndStatus:    7,
+			StartStatus:  []int{2},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "SOURCE",
+			EndStatus:    3,
 		},
 		{
-			StartStatus:  []int{7},
-			AcceptObject: "MySQLOperatorToken",
-			AcceptValue:  "(",
-			EndStatus:    8,
+			StartStatus:  []int{3},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "TO",
+			EndStatus:    4,
 		},
 		{
-			StartStatus:  []int{8, 17},
-			AcceptObject: "MySQLCreateTableDefinitionComponent",
+			StartStatus:  []int{4, 6},
+			AcceptObject: "MySQLReplicationOptionComponent",
 			AcceptValue:  "",
-			EndStatus:    9,
+			EndStatus:    5,
 		},
 		{
-			StartStatus:  []int{9},
+			StartStatus:  []int{5},
 			AcceptObject: "MySQLDelimiterToken",
 			AcceptValue:  ",",
-			EndStatus:    17,
+			EndStatus:    6,
 		},
 		{
-			StartStatus:  []int{9},
-			AcceptObject: "MySQLOperatorToken",
-			AcceptValue:  ")",
-			EndStatus:    10,
+			StartStatus:  []int{5},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "FOR",
+			EndStatus:    7,
 		},
 		{
-			StartStatus:  []int{10},
-			AcceptObject: "MySQLTableOptionListComponent",
-			AcceptValue:  "",
-			EndStatus:    11,
+			StartStatus:  []int{7},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "CHANNEL",
+			EndStatus:    8,
 		},
 		{
-			StartStatus:  []int{10, 11},
-			AcceptObject: "MySQLPartitionOptionComponent",
+			StartStatus:  []int{8},
+			AcceptObject: "MySQLIdentifierComponent",
 			AcceptValue:  "",
-			EndStatus:    12,
-		},
-		{
-			StartStatus:  []int{10, 11, 12},
-			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "IGNORE",
-			EndStatus:    13,
-		},
-		{
-			StartStatus:  []int{10, 11, 12},
-			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "REPLACE",
-			EndStatus:    13,
-		},
-		{
-			StartStatus:  []int{10, 11, 12, 13},
-			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "AS",
-			EndStatus:    14,
-		},
-		{
-			StartStatus:  []int{10, 11, 12, 13, 14},
-			AcceptObject: "MySQLExpressionComponent",
-			AcceptValue:  "",
-			EndStatus:    FinalStatus,
-		},
-		{
-			StartStatus:  []int{7},
-			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "LIKE",
-			EndStatus:    15,
-		},
-		{
-			StartStatus:  []int{15},
-			AcceptObject: "MySQLTableNameComponent",
-			AcceptValue:  "",
-			EndStatus:    FinalStatus,
+			EndStatus:    FinalStatus,
 		},
 		{
 			StartStatus:  []int{8},
-			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "LIKE",
-			EndStatus:    16,
-		},
-		{
-			StartStatus:  []int{16},
-			AcceptObject: "MySQLOperatorToken",
-			AcceptValue:  ")",
+			AcceptObject: "MySQLStringToken",
+			AcceptValue:  "",
 			EndStatus:    FinalStatus,
 		},
 	}
 }
 
-func NewCreateTableStatement(tokenList MySQLTokenList,
+func NewChangeMasterStatement(tokenList MySQLTokenList,
 	verboseFunc func(message string, level LogLevel)) (MySQLStatement, MySQLTokenList) {
 	startPos := tokenList.CurrentPos()
-	s := &CreateTableStatement{
+	s := &ChangeMasterStatement{
 		MySQLBaseStatement: &MySQLBaseStatement{
 			ObjectList: make([]*MySQLObject, 0),
 		},
-		DatabaseList: make([]string, 0),
-		TableList:    make([]string, 0),
+		OptionMap:          make(map[string]string),
+		IgnoreServerIdList: make([]string, 0),
 	}
-	endPos := s.ParseByFsm(s.GetFsmMap(), tokenList, []int{10, 11, 12}, verboseFunc)
+	endPos := s.ParseByFsm(s.GetFsmMap(), tokenList, []int{5}, verboseFunc)
 	if endPos == -1 {
 		tokenList.Reset(startPos)
 		return nil, tokenList
 	} else {
 		for _, t := range s.ObjectList {
-			if (*t).Type() == "MySQLTableNameComponent" {
-				if len(s.TableList) > 0 {
-					s.FromDatabase = (*t).(*MySQLTableNameComponent).Database
-					s.FromTable = (*t).(*MySQLTableNameComponent).Table
-				} else {
-					s.DatabaseList = append(s.DatabaseList, (*t).(*MySQLTableNameComponent).Database)
-					s.TableList = append(s.TableList, (*t).(*MySQLTableNameComponent).Table)
-				}
-			} else if (*t).Type() == "MySQLExpressionComponent" {
-				for _, tmpT := range (*t).(*MySQLExpressionComponent).ObjectList {
-					if (*tmpT).Type() == "SubQueryComponent" {
-						s.DatabaseList = append(s.DatabaseList, (*tmpT).(*SubQueryComponent).DatabaseList...)
-						s.TableList = append(s.TableList, (*tmpT).(*SubQueryComponent).TableList...)
-					}
+			if (*t).Type() == "MySQLKeywordToken" && (*t).Value() == "SOURCE" {
+				s.ReplicationSource = true
+			} else if (*t).Type() == "MySQLReplicationOptionComponent" {
+				option := (*t).(*MySQLReplicationOptionComponent)
+				s.OptionMap[option.Name] = option.OptionValue
+				if option.Name == "IGNORE_SERVER_IDS" {
+					s.IgnoreServerIdList = option.ValueList
 				}
+			} else if (*t).Type() == "MySQLIdentifierComponent" {
+				s.Channel = strings.Trim((*t).Value(), "`")
+			} else if (*t).Type() == "MySQLStringToken" {
+				s.Channel = unquoteString((*t).Value())
 			}
 		}
 		tokenList.Reset(endPos)
@@ -669,134 +881,139 @@ func NewCreateTableStatement(tokenList MySQLTokenList,
 	}
 }
 
-//  13.1.13 CREATE INDEX Syntax
-// CREATE [ONLINE|OFFLINE] [UNIQUE|FULLTEXT|SPATIAL] INDEX index_name
-//    [index_type]
-//    ON tbl_name (index_col_name,...)
-//    [index_option] ...
+// GetOption 按选项名获取取值, MASTER_ 与 SOURCE_ 两种写法等价
+func (s *ChangeMasterStatement) GetOption(name string) (string, bool) {
+	name = strings.ToUpper(name)
+	if value, ok := s.OptionMap[name]; ok {
+		return value, ok
+	}
+	alias := ""
+	if strings.Contains(name, "MASTER_") {
+		alias = strings.Replace(name, "MASTER_", "SOURCE_", 1)
+	} else if strings.Contains(name, "SOURCE_") {
+		alias = strings.Replace(name, "SOURCE_", "MASTER_", 1)
+	}
+	value, ok := s.OptionMap[alias]
+	return value, ok
+}
 
-type CreateIndexStatement struct {
+// redactReplicationPassword 拼接语句原文, 并将其中的密码选项替换为***
+func redactReplicationPassword(objectList []*MySQLObject) string {
+	tmpList := make([]string, len(objectList))
+	for index, t := range objectList {
+		if (*t).Type() == "MySQLReplicationOptionComponent" {
+			tmpList[index] = (*t).(*MySQLReplicationOptionComponent).RedactedValue()
+		} else {
+			tmpList[index] = (*t).Value()
+		}
+	}
+	return strings.Join(tmpList, "")
+}
+
+// 13.4.2.2 CHANGE REPLICATION FILTER Syntax
+// CHANGE REPLICATION FILTER filter[, filter]
+//   [, ...] [FOR CHANNEL channel]
+//
+// filter: {
+//    REPLICATE_DO_DB = (db_list)
+//  | REPLICATE_IGNORE_DB = (db_list)
+//  | REPLICATE_DO_TABLE = (tbl_list)
+//  | REPLICATE_IGNORE_TABLE = (tbl_list)
+//  | REPLICATE_WILD_DO_TABLE = (wild_tbl_list)
+//  | REPLICATE_WILD_IGNORE_TABLE = (wild_tbl_list)
+//  | REPLICATE_REWRITE_DB = (db_pair_list)
+// }
+
+type ChangeReplicationFilterStatement struct {
 	*MySQLBaseStatement
-	Database string
-	Table    string
+	FilterList []*MySQLReplicationFilterComponent
+	Channel    string
 }
 
-func (s *CreateIndexStatement) Type() string {
-	return "CreateIndexStatement"
+func (s *ChangeReplicationFilterStatement) Type() string {
+	return "ChangeReplicationFilterStatement"
 }
 
-func (s *CreateIndexStatement) GetFsmMap() []FsmMap {
+func (s *ChangeReplicationFilterStatement) GetFsmMap() []FsmMap {
 	return []FsmMap{
 		{
 			StartStatus:  []int{0},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "CREATE",
+			AcceptValue:  "CHANGE",
 			EndStatus:    1,
 		},
 		{
 			StartStatus:  []int{1},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "ONLINE",
-			EndStatus:    2,
-		},
-		{
-			StartStatus:  []int{1},
-			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "OFFLINE",
+			AcceptValue:  "REPLICATION",
 			EndStatus:    2,
 		},
 		{
-			StartStatus:  []int{1, 2},
-			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "UNIQUE",
-			EndStatus:    3,
-		},
-		{
-			StartStatus:  []int{1, 2},
-			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "FULLTEXT",
-			EndStatus:    3,
-		},
-		{
-			StartStatus:  []int{1, 2},
+			StartStatus:  []int{2},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "SPATIAL",
+			AcceptValue:  "FILTER",
 			EndStatus:    3,
 		},
 		{
-			StartStatus:  []int{1, 2, 3},
-			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "INDEX",
+			StartStatus:  []int{3, 5},
+			AcceptObject: "MySQLReplicationFilterComponent",
+			AcceptValue:  "",
 			EndStatus:    4,
 		},
 		{
 			StartStatus:  []int{4},
-			AcceptObject: "MySQLIdentifierComponent",
-			AcceptValue:  "",
+			AcceptObject: "MySQLDelimiterToken",
+			AcceptValue:  ",",
 			EndStatus:    5,
 		},
 		{
-			StartStatus:  []int{5},
-			AcceptObject: "MySQLIndexTypeComponent",
-			AcceptValue:  "",
+			StartStatus:  []int{4},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "FOR",
 			EndStatus:    6,
 		},
 		{
-			StartStatus:  []int{5, 6},
+			StartStatus:  []int{6},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "ON",
+			AcceptValue:  "CHANNEL",
 			EndStatus:    7,
 		},
 		{
 			StartStatus:  []int{7},
-			AcceptObject: "MySQLTableNameComponent",
-			AcceptValue:  "",
-			EndStatus:    8,
-		},
-		{
-			StartStatus:  []int{8},
-			AcceptObject: "MySQLOperatorToken",
-			AcceptValue:  "(",
-			EndStatus:    9,
-		},
-		{
-			StartStatus:  []int{9},
-			AcceptObject: "MySQLIndexColumnNameListComponent",
+			AcceptObject: "MySQLIdentifierComponent",
 			AcceptValue:  "",
-			EndStatus:    10,
-		},
-		{
-			StartStatus:  []int{10},
-			AcceptObject: "MySQLOperatorToken",
-			AcceptValue:  ")",
-			EndStatus:    11,
+			EndStatus:    FinalStatus,
 		},
 		{
-			StartStatus:  []int{11, 12},
-			AcceptObject: "MySQLIndexOptionComponent",
+			StartStatus:  []int{7},
+			AcceptObject: "MySQLStringToken",
 			AcceptValue:  "",
-			EndStatus:    12,
+			EndStatus:    FinalStatus,
 		},
 	}
 }
 
-func NewCreateIndexStatement(tokenList MySQLTokenList,
+func NewChangeReplicationFilterStatement(tokenList MySQLTokenList,
 	verboseFunc func(message string, level LogLevel)) (MySQLStatement, MySQLTokenList) {
 	startPos := tokenList.CurrentPos()
-	s := &CreateIndexStatement{
+	s := &ChangeReplicationFilterStatement{
 		MySQLBaseStatement: &MySQLBaseStatement{
 			ObjectList: make([]*MySQLObject, 0),
 		},
+		FilterList: make([]*MySQLReplicationFilterComponent, 0),
 	}
-	endPos := s.ParseByFsm(s.GetFsmMap(), tokenList, []int{11, 12}, verboseFunc)
+	endPos := s.ParseByFsm(s.GetFsmMap(), tokenList, []int{4}, verboseFunc)
 	if endPos == -1 {
 		tokenList.Reset(startPos)
 		return nil, tokenList
 	} else {
 		for _, t := range s.ObjectList {
-			if (*t).Type() == "MySQLTableNameComponent" {
-				s.Database = (*t).(*MySQLTableNameComponent).Database
-				s.Table = (*t).(*MySQLTableNameComponent).Table
+			if (*t).Type() == "MySQLReplicationFilterComponent" {
+				s.FilterList = append(s.FilterList, (*t).(*MySQLReplicationFilterComponent))
+			} else if (*t).Type() == "MySQLIdentifierComponent" {
+				s.Channel = strings.Trim((*t).Value(), "`")
+			} else if (*t).Type() == "MySQLStringToken" {
+				s.Channel = unquoteString((*t).Value())
 			}
 		}
 		tokenList.Reset(endPos)
@@ -804,222 +1021,120 @@ func NewCreateIndexStatement(tokenList MySQLTokenList,
 	}
 }
 
-// 13.2.2 DELETE Syntax
-// DELETE [LOW_PRIORITY] [QUICK] [IGNORE] FROM tbl_name
-//    [WHERE where_condition]
-//    [ORDER BY ...]
-//    [LIMIT row_count]
-//
-// DELETE [LOW_PRIORITY] [QUICK] [IGNORE]
-//    tbl_name[.*] [, tbl_name[.*]] ...
-//    FROM table_references
-//    [WHERE where_condition]
+// 13.7.2.2 CHECK TABLE Syntax
+// CHECK TABLE tbl_name [, tbl_name] ... [option] ...
 //
-// DELETE [LOW_PRIORITY] [QUICK] [IGNORE]
-//    FROM tbl_name[.*] [, tbl_name[.*]] ...
-//    USING table_references
-//    [WHERE where_condition]
+// option: {
+//    FOR UPGRADE
+//  | QUICK
+//  | FAST
+//  | MEDIUM
+//  | EXTENDED
+//  | CHANGED
+// }
 
-type DeleteStatement struct {
+type CheckTableStatement struct {
 	*MySQLBaseStatement
 	DatabaseList []string
 	TableList    []string
+	OptionList   []string
 }
 
-func (s *DeleteStatement) Type() string {
-	return "DeleteStatement"
+func (s *CheckTableStatement) Type() string {
+	return "CheckTableStatement"
 }
 
-func (s *DeleteStatement) GetFsmMap() []FsmMap {
+func (s *CheckTableStatement) GetFsmMap() []FsmMap {
 	return []FsmMap{
 		{
 			StartStatus:  []int{0},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "DELETE",
+			AcceptValue:  "CHECK",
 			EndStatus:    1,
 		},
 		{
 			StartStatus:  []int{1},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "LOW_PRIORITY",
+			AcceptValue:  "TABLE",
 			EndStatus:    2,
 		},
 		{
-			StartStatus:  []int{1, 2},
-			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "QUICK",
+			StartStatus:  []int{2},
+			AcceptObject: "MySQLTableNameListComponent",
+			AcceptValue:  "",
 			EndStatus:    3,
 		},
 		{
-			StartStatus:  []int{1, 2, 3},
+			StartStatus:  []int{3},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "IGNORE",
+			AcceptValue:  "FOR",
 			EndStatus:    4,
 		},
 		{
-			StartStatus:  []int{1, 2, 3, 4},
+			StartStatus:  []int{4},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "IGNORE",
-			EndStatus:    5,
-		},
-		{
-			StartStatus:  []int{5},
-			AcceptObject: "MySQLTableNameComponent",
-			AcceptValue:  "",
-			EndStatus:    6,
+			AcceptValue:  "UPGRADE",
+			EndStatus:    3,
 		},
 		{
-			StartStatus:  []int{6},
+			StartStatus:  []int{3},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "WHERE",
-			EndStatus:    7,
-		},
-		{
-			StartStatus:  []int{7},
-			AcceptObject: "MySQLExpressionComponent",
-			AcceptValue:  "",
-			EndStatus:    8,
+			AcceptValue:  "QUICK",
+			EndStatus:    3,
 		},
 		{
-			StartStatus:  []int{6, 8},
+			StartStatus:  []int{3},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "ORDER",
-			EndStatus:    9,
+			AcceptValue:  "FAST",
+			EndStatus:    3,
 		},
 		{
-			StartStatus:  []int{9},
+			StartStatus:  []int{3},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "BY",
-			EndStatus:    10,
-		},
-		{
-			StartStatus:  []int{10},
-			AcceptObject: "MySQLOrderListOptionComponent",
-			AcceptValue:  "",
-			EndStatus:    11,
+			AcceptValue:  "MEDIUM",
+			EndStatus:    3,
 		},
 		{
-			StartStatus:  []int{6, 8, 11},
+			StartStatus:  []int{3},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "LIMIT",
-			EndStatus:    12,
+			AcceptValue:  "EXTENDED",
+			EndStatus:    3,
 		},
 		{
-			StartStatus:  []int{12},
-			AcceptObject: "MySQLNumericToken",
-			AcceptValue:  "",
-			EndStatus:    FinalStatus,
+			StartStatus:  []int{3},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "CHANGED",
+			EndStatus:    3,
 		},
-		{
-			StartStatus:  []int{6, 16},
-			AcceptObject: "MySQLOperatorToken",
-			AcceptValue:  ".",
-			EndStatus:    13,
-		},
-		{
-			StartStatus:  []int{13},
-			AcceptObject: "MySQLOperatorToken",
-			AcceptValue:  "*",
-			EndStatus:    14,
-		},
-		{
-			StartStatus:  []int{14},
-			AcceptObject: "MySQLDelimiterToken",
-			AcceptValue:  ",",
-			EndStatus:    15,
-		},
-		{
-			StartStatus:  []int{15},
-			AcceptObject: "MySQLTableNameComponent",
-			AcceptValue:  "",
-			EndStatus:    16,
-		},
-		{
-			StartStatus:  []int{6, 14, 16},
-			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "USING",
-			EndStatus:    17,
-		},
-		{
-			StartStatus:  []int{1, 2, 3, 4},
-			AcceptObject: "MySQLTableNameComponent",
-			AcceptValue:  "",
-			EndStatus:    18,
-		},
-		{
-			StartStatus:  []int{18},
-			AcceptObject: "MySQLOperatorToken",
-			AcceptValue:  ".",
-			EndStatus:    19,
-		},
-		{
-			StartStatus:  []int{19},
-			AcceptObject: "MySQLOperatorToken",
-			AcceptValue:  "*",
-			EndStatus:    20,
-		},
-		{
-			StartStatus:  []int{20},
-			AcceptObject: "MySQLDelimiterToken",
-			AcceptValue:  ",",
-			EndStatus:    18,
-		},
-		{
-			StartStatus:  []int{20},
-			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "FROM",
-			EndStatus:    17,
-		},
-		{
-			StartStatus:  []int{17},
-			AcceptObject: "TableReferenceListComponent",
-			AcceptValue:  "",
-			EndStatus:    21,
-		},
-		{
-			StartStatus:  []int{21},
-			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "WHERE",
-			EndStatus:    22,
-		},
-		{
-			StartStatus:  []int{22},
-			AcceptObject: "MySQLExpressionComponent",
-			AcceptValue:  "",
-			EndStatus:    FinalStatus,
-		},
-	}
-}
-
-func NewDeleteStatement(tokenList MySQLTokenList,
-	verboseFunc func(message string, level LogLevel)) (MySQLStatement, MySQLTokenList) {
-	startPos := tokenList.CurrentPos()
-	s := &DeleteStatement{
-		MySQLBaseStatement: &MySQLBaseStatement{
-			ObjectList: make([]*MySQLObject, 0),
+	}
+}
+
+func NewCheckTableStatement(tokenList MySQLTokenList,
+	verboseFunc func(message string, level LogLevel)) (MySQLStatement, MySQLTokenList) {
+	startPos := tokenList.CurrentPos()
+	s := &CheckTableStatement{
+		MySQLBaseStatement: &MySQLBaseStatement{
+			ObjectList: make([]*MySQLObject, 0),
 		},
 		DatabaseList: make([]string, 0),
 		TableList:    make([]string, 0),
+		OptionList:   make([]string, 0),
 	}
-	endPos := s.ParseByFsm(s.GetFsmMap(), tokenList, []int{6, 8, 11, 21}, verboseFunc)
+	endPos := s.ParseByFsm(s.GetFsmMap(), tokenList, []int{3}, verboseFunc)
 	if endPos == -1 {
 		tokenList.Reset(startPos)
 		return nil, tokenList
 	} else {
 		for _, t := range s.ObjectList {
-			if (*t).Type() == "MySQLTableNameComponent" {
-				s.DatabaseList = append(s.DatabaseList, (*t).(*MySQLTableNameComponent).Database)
-				s.TableList = append(s.TableList, (*t).(*MySQLTableNameComponent).Table)
-			} else if (*t).Type() == "TableReferenceListComponent" {
-				s.DatabaseList = append(s.DatabaseList, (*t).(*TableReferenceListComponent).DatabaseList...)
-				s.TableList = append(s.TableList, (*t).(*TableReferenceListComponent).TableList...)
-			} else if (*t).Type() == "MySQLExpressionComponent" {
-				for _, tmpT := range (*t).(*MySQLExpressionComponent).ObjectList {
-					if (*tmpT).Type() == "SubQueryComponent" {
-						s.DatabaseList = append(s.DatabaseList, (*tmpT).(*SubQueryComponent).DatabaseList...)
-						s.TableList = append(s.TableList, (*tmpT).(*SubQueryComponent).TableList...)
-					}
+			if (*t).Type() == "MySQLTableNameListComponent" {
+				for _, tableName := range (*t).(*MySQLTableNameListComponent).TableList {
+					s.DatabaseList = append(s.DatabaseList, tableName.Database)
+					s.TableList = append(s.TableList, tableName.Table)
 				}
+			} else if (*t).Type() == "MySQLKeywordToken" && (*t).Value() == "UPGRADE" {
+				s.OptionList = append(s.OptionList, "FOR UPGRADE")
+			} else if (*t).Type() == "MySQLKeywordToken" && InArray((*t).Value(), []string{"QUICK", "FAST", "MEDIUM", "EXTENDED", "CHANGED"}) {
+				s.OptionList = append(s.OptionList, (*t).Value())
 			}
 		}
 		tokenList.Reset(endPos)
@@ -1027,75 +1142,78 @@ func NewDeleteStatement(tokenList MySQLTokenList,
 	}
 }
 
-// 13.1.21 DROP DATABASE Syntax
-// DROP { DATABASE | SCHEMA } [IF EXISTS] db_name
+// 13.7.2.3 CHECKSUM TABLE Syntax
+// CHECKSUM TABLE tbl_name [, tbl_name] ... [QUICK | EXTENDED]
 
-type DropDatabaseStatement struct {
+type ChecksumTableStatement struct {
 	*MySQLBaseStatement
-	Database string
+	DatabaseList []string
+	TableList    []string
+	Option       string
 }
 
-func (s *DropDatabaseStatement) Type() string {
-	return "DropDatabaseStatement"
+func (s *ChecksumTableStatement) Type() string {
+	return "ChecksumTableStatement"
 }
 
-func (s *DropDatabaseStatement) GetFsmMap() []FsmMap {
+func (s *ChecksumTableStatement) GetFsmMap() []FsmMap {
 	return []FsmMap{
 		{
 			StartStatus:  []int{0},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "DROP",
+			AcceptValue:  "CHECKSUM",
 			EndStatus:    1,
 		},
 		{
 			StartStatus:  []int{1},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "DATABASE",
-			EndStatus:    2,
-		},
-		{
-			StartStatus:  []int{1},
-			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "SCHEMA",
+			AcceptValue:  "TABLE",
 			EndStatus:    2,
 		},
 		{
 			StartStatus:  []int{2},
-			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "IF",
+			AcceptObject: "MySQLTableNameListComponent",
+			AcceptValue:  "",
 			EndStatus:    3,
 		},
 		{
 			StartStatus:  []int{3},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "EXISTS",
-			EndStatus:    4,
+			AcceptValue:  "QUICK",
+			EndStatus:    FinalStatus,
 		},
 		{
-			StartStatus:  []int{2, 4},
-			AcceptObject: "MySQLDatabaseNameComponent",
-			AcceptValue:  "",
+			StartStatus:  []int{3},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "EXTENDED",
 			EndStatus:    FinalStatus,
 		},
 	}
 }
 
-func NewDropDatabaseStatement(tokenList MySQLTokenList,
+func NewChecksumTableStatement(tokenList MySQLTokenList,
 	verboseFunc func(message string, level LogLevel)) (MySQLStatement, MySQLTokenList) {
 	startPos := tokenList.CurrentPos()
-	s := &DropDatabaseStatement{
+	s := &ChecksumTableStatement{
 		MySQLBaseStatement: &MySQLBaseStatement{
 			ObjectList: make([]*MySQLObject, 0),
 		},
+		DatabaseList: make([]string, 0),
+		TableList:    make([]string, 0),
 	}
-	endPos := s.ParseByFsm(s.GetFsmMap(), tokenList, []int{}, verboseFunc)
+	endPos := s.ParseByFsm(s.GetFsmMap(), tokenList, []int{3}, verboseFunc)
 	if endPos == -1 {
 		tokenList.Reset(startPos)
 		return nil, tokenList
 	} else {
 		for _, t := range s.ObjectList {
-			if (*t).Type() == "MySQLDatabaseNameComponent" {
-				s.Database = (*t).(*MySQLDatabaseNameComponent).Database
+			if (*t).Type() == "MySQLTableNameListComponent" {
+				for _, tableName := range (*t).(*MySQLTableNameListComponent).TableList {
+					s.DatabaseList = append(s.DatabaseList, tableName.Database)
+					s.TableList = append(s.TableList, tableName.Table)
+				}
+			} else if (*t).Type() == "MySQLKeywordToken" && InArray((*t).Value(), []string{"QUICK", "EXTENDED"}) {
+				s.Option = (*t).Value()
 			}
 		}
 		tokenList.Reset(endPos)
@@ -1103,45 +1221,50 @@ func NewDropDatabaseStatement(tokenList MySQLTokenList,
 	}
 }
 
-// 13.1.28 DROP TABLE Syntax
-// DROP [TEMPORARY] TABLE [IF EXISTS] tbl_name
-//   tbl_name [, tbl_name] ...
-//   [RESTRICT | CASCADE]
+// 13.1.10 CREATE DATABASE Syntax
+// CREATE { DATABASE | SCHEMA } [IF NOT EXISTS] db_name
+//   [create_specification] ...
 
-type DropTableStatement struct {
+type CreateDatabaseStatement struct {
 	*MySQLBaseStatement
-	Database string
-	Table    string
+	Database    string
+	IfNotExists bool
 }
 
-func (s *DropTableStatement) Type() string {
-	return "DropTableStatement"
+func (s *CreateDatabaseStatement) Type() string {
+	return "CreateDatabaseStatement"
 }
 
-func (s *DropTableStatement) GetFsmMap() []FsmMap {
+func (s *CreateDatabaseStatement) GetFsmMap() []FsmMap {
 	return []FsmMap{
 		{
 			StartStatus:  []int{0},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "DROP",
+			AcceptValue:  "CREATE",
 			EndStatus:    1,
 		},
 		{
 			StartStatus:  []int{1},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "TEMPORARY",
+			AcceptValue:  "DATABASE",
 			EndStatus:    2,
 		},
 		{
-			StartStatus:  []int{1, 2},
+			StartStatus:  []int{1},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "TABLE",
+			AcceptValue:  "SCHEMA",
+			EndStatus:    2,
+		},
+		{
+			StartStatus:  []int{2},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "IF",
 			EndStatus:    3,
 		},
 		{
 			StartStatus:  []int{3},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "IF",
+			AcceptValue:  "NOT",
 			EndStatus:    4,
 		},
 		{
@@ -1151,43 +1274,38 @@ func (s *DropTableStatement) GetFsmMap() []FsmMap {
 			EndStatus:    5,
 		},
 		{
-			StartStatus:  []int{3, 5},
-			AcceptObject: "MySQLTableNameListComponent",
+			StartStatus:  []int{2, 5},
+			AcceptObject: "MySQLDatabaseNameComponent",
 			AcceptValue:  "",
 			EndStatus:    6,
 		},
 		{
-			StartStatus:  []int{6},
-			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "RESTRICT",
-			EndStatus:    FinalStatus,
-		},
-		{
-			StartStatus:  []int{6},
-			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "CASCADE",
-			EndStatus:    FinalStatus,
+			StartStatus:  []int{6, 7},
+			AcceptObject: "MySQLDatabaseOptionComponent",
+			AcceptValue:  "",
+			EndStatus:    7,
 		},
 	}
 }
 
-func NewDropTableStatement(tokenList MySQLTokenList,
+func NewCreateDatabaseStatement(tokenList MySQLTokenList,
 	verboseFunc func(message string, level LogLevel)) (MySQLStatement, MySQLTokenList) {
 	startPos := tokenList.CurrentPos()
-	s := &DropTableStatement{
+	s := &CreateDatabaseStatement{
 		MySQLBaseStatement: &MySQLBaseStatement{
 			ObjectList: make([]*MySQLObject, 0),
 		},
 	}
-	endPos := s.ParseByFsm(s.GetFsmMap(), tokenList, []int{6}, verboseFunc)
+	endPos := s.ParseByFsm(s.GetFsmMap(), tokenList, []int{6, 7}, verboseFunc)
 	if endPos == -1 {
 		tokenList.Reset(startPos)
 		return nil, tokenList
 	} else {
 		for _, t := range s.ObjectList {
-			if (*t).Type() == "MySQLTableNameComponent" {
-				s.Database = (*t).(*MySQLTableNameComponent).Database
-				s.Table = (*t).(*MySQLTableNameComponent).Table
+			if (*t).Type() == "MySQLDatabaseNameComponent" {
+				s.Database = (*t).(*MySQLDatabaseNameComponent).Database
+			} else if (*t).Type() == "MySQLKeywordToken" && strings.ToUpper((*t).Value()) == "EXISTS" {
+				s.IfNotExists = true
 			}
 		}
 		tokenList.Reset(endPos)
@@ -1195,199 +1313,240 @@ func NewDropTableStatement(tokenList MySQLTokenList,
 	}
 }
 
-//  13.1.24 DROP INDEX Syntax
-// DROP [ONLINE|OFFLINE] INDEX index_name ON tbl_name
+// 13.1.17 CREATE TABLE Syntax
+// CREATE [TEMPORARY] TABLE [IF NOT EXISTS] tbl_name
+//   (create_specification ...)
+//   [table_options]
+//   [partition_options]
+//
+// CREATE [TEMPORARY] TABLE [IF NOT EXISTS] tbl_name
+//   (create_specification ...)
+//   [table_options]
+//   [partition_options]
+//   [IGNORE | REPLACE]
+//   [AS] query_expression
+//
+// CREATE [TEMPORARY] TABLE [IF NOT EXISTS] tbl_name
+//   { LIKE old_tbl_name | (LIKE old_tbl_name) }
 
-type DropIndexStatement struct {
+type CreateTableStatement struct {
 	*MySQLBaseStatement
-	Database string
-	Table    string
+	DatabaseList    []string
+	TableList       []string
+	FromDatabase    string
+	FromTable       string
+	Temporary       bool
+	IfNotExists     bool
+	DefinitionList  []*MySQLCreateTableDefinitionComponent
+	TableOptionList []*MySQLTableOptionComponent
+	Partition       *MySQLPartitionOptionComponent
+	Select          MySQLStatement
 }
 
-func (s *DropIndexStatement) Type() string {
-	return "DropIndexStatement"
+func (s *CreateTableStatement) Type() string {
+	return "CreateTableStatement"
 }
 
-func (s *DropIndexStatement) GetFsmMap() []FsmMap {
+func (s *CreateTableStatement) GetFsmMap() []FsmMap {
 	return []FsmMap{
 		{
 			StartStatus:  []int{0},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "DROP",
+			AcceptValue:  "CREATE",
 			EndStatus:    1,
 		},
 		{
 			StartStatus:  []int{1},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "ONLINE",
-			EndStatus:    2,
-		},
-		{
-			StartStatus:  []int{1},
-			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "OFFLINE",
+			AcceptValue:  "TEMPORARY",
 			EndStatus:    2,
 		},
 		{
 			StartStatus:  []int{1, 2},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "INDEX",
+			AcceptValue:  "TABLE",
 			EndStatus:    3,
 		},
 		{
 			StartStatus:  []int{3},
-			AcceptObject: "MySQLIdentifierComponent",
-			AcceptValue:  "",
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "IF",
 			EndStatus:    4,
 		},
 		{
 			StartStatus:  []int{4},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "ON",
+			AcceptValue:  "NOT",
 			EndStatus:    5,
 		},
 		{
 			StartStatus:  []int{5},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "EXISTS",
+			EndStatus:    6,
+		},
+		{
+			StartStatus:  []int{3, 6},
 			AcceptObject: "MySQLTableNameComponent",
 			AcceptValue:  "",
-			EndStatus:    FinalStatus,
-		},
-	}
-}
-
-func NewDropIndexStatement(tokenList MySQLTokenList,
-	verboseFunc func(message string, level LogLevel)) (MySQLStatement, MySQLTokenList) {
-	startPos := tokenList.CurrentPos()
-	s := &DropIndexStatement{
-		MySQLBaseStatement: &MySQLBaseStatement{
-			ObjectList: make([]*MySQLObject, 0),
+			EndStatus:    7,
 		},
-	}
-	endPos := s.ParseByFsm(s.GetFsmMap(), tokenList, []int{}, verboseFunc)
-	if endPos == -1 {
-		tokenList.Reset(startPos)
-		return nil, tokenList
-	} else {
-		for _, t := range s.ObjectList {
-			if (*t).Type() == "MySQLTableNameComponent" {
-				s.Database = (*t).(*MySQLTableNameComponent).Database
-				s.Table = (*t).(*MySQLTableNameComponent).Table
-			}
-		}
-		tokenList.Reset(endPos)
-		return s, tokenList
-	}
-}
-
-// 13.8.2 EXPLAIN Syntax
-// {EXPLAIN | DESCRIBE | DESC}
-//    tbl_name [col_name | wild]
-//
-// {EXPLAIN | DESCRIBE | DESC}
-//    [explain_type] SELECT select_options
-//
-// explain_type: {EXTENDED | PARTITIONS}
-
-type ExplainStatement struct {
-	*MySQLBaseStatement
-	DatabaseList []string
-	TableList    []string
-}
-
-func (s *ExplainStatement) Type() string {
-	return "ExplainStatement"
-}
-
-func (s *ExplainStatement) GetFsmMap() []FsmMap {
-	return []FsmMap{
 		{
-			StartStatus:  []int{0},
+			StartStatus:  []int{7},
+			AcceptObject: "MySQLOperatorToken",
+			AcceptValue:  "(",
+			EndStatus:    8,
+		},
+		{
+			StartStatus:  []int{8, 17},
+			AcceptObject: "MySQLCreateTableDefinitionComponent",
+			AcceptValue:  "",
+			EndStatus:    9,
+		},
+		{
+			StartStatus:  []int{9},
+			AcceptObject: "MySQLDelimiterToken",
+			AcceptValue:  ",",
+			EndStatus:    17,
+		},
+		{
+			StartStatus:  []int{9},
+			AcceptObject: "MySQLOperatorToken",
+			AcceptValue:  ")",
+			EndStatus:    10,
+		},
+		{
+			StartStatus:  []int{7, 10},
+			AcceptObject: "MySQLTableOptionListComponent",
+			AcceptValue:  "",
+			EndStatus:    11,
+		},
+		{
+			StartStatus:  []int{7, 10, 11},
+			AcceptObject: "MySQLPartitionOptionComponent",
+			AcceptValue:  "",
+			EndStatus:    12,
+		},
+		{
+			StartStatus:  []int{7, 10, 11, 12},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "EXPLAIN",
-			EndStatus:    1,
+			AcceptValue:  "IGNORE",
+			EndStatus:    13,
 		},
 		{
-			StartStatus:  []int{0},
+			StartStatus:  []int{7, 10, 11, 12},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "DESCRIBE",
-			EndStatus:    1,
+			AcceptValue:  "REPLACE",
+			EndStatus:    13,
 		},
 		{
-			StartStatus:  []int{0},
+			StartStatus:  []int{7, 10, 11, 12, 13},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "DESC",
-			EndStatus:    1,
+			AcceptValue:  "AS",
+			EndStatus:    14,
 		},
 		{
-			StartStatus:  []int{1},
-			AcceptObject: "MySQLTableNameComponent",
+			StartStatus:  []int{7, 10, 11, 12, 13, 14},
+			AcceptObject: "UnionStatement",
 			AcceptValue:  "",
-			EndStatus:    2,
+			EndStatus:    FinalStatus,
 		},
 		{
-			StartStatus:  []int{2},
-			AcceptObject: "MySQLColumnNameComponent",
+			StartStatus:  []int{7, 10, 11, 12, 13, 14},
+			AcceptObject: "SelectStatement",
 			AcceptValue:  "",
 			EndStatus:    FinalStatus,
 		},
 		{
-			StartStatus:  []int{2},
-			AcceptObject: "MySQLStringToken",
+			StartStatus:  []int{10, 11, 12, 13, 14},
+			AcceptObject: "MySQLExpressionComponent",
 			AcceptValue:  "",
 			EndStatus:    FinalStatus,
 		},
 		{
-			StartStatus:  []int{1},
+			StartStatus:  []int{7},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "EXTENDED",
-			EndStatus:    3,
+			AcceptValue:  "LIKE",
+			EndStatus:    15,
 		},
 		{
-			StartStatus:  []int{1},
+			StartStatus:  []int{15},
+			AcceptObject: "MySQLTableNameComponent",
+			AcceptValue:  "",
+			EndStatus:    FinalStatus,
+		},
+		{
+			StartStatus:  []int{8},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "PARTITIONS",
-			EndStatus:    3,
+			AcceptValue:  "LIKE",
+			EndStatus:    16,
 		},
 		{
-			StartStatus:  []int{1, 3},
-			AcceptObject: "UnionStatement",
+			StartStatus:  []int{16},
+			AcceptObject: "MySQLTableNameComponent",
 			AcceptValue:  "",
-			EndStatus:    FinalStatus,
+			EndStatus:    18,
 		},
 		{
-			StartStatus:  []int{1, 3},
-			AcceptObject: "SelectStatement",
-			AcceptValue:  "",
+			StartStatus:  []int{18},
+			AcceptObject: "MySQLOperatorToken",
+			AcceptValue:  ")",
 			EndStatus:    FinalStatus,
 		},
 	}
 }
 
-func NewExplainStatement(tokenList MySQLTokenList,
+func NewCreateTableStatement(tokenList MySQLTokenList,
 	verboseFunc func(message string, level LogLevel)) (MySQLStatement, MySQLTokenList) {
 	startPos := tokenList.CurrentPos()
-	s := &ExplainStatement{
+	s := &CreateTableStatement{
 		MySQLBaseStatement: &MySQLBaseStatement{
 			ObjectList: make([]*MySQLObject, 0),
 		},
-		DatabaseList: make([]string, 0),
-		TableList:    make([]string, 0),
+		DatabaseList:    make([]string, 0),
+		TableList:       make([]string, 0),
+		DefinitionList:  make([]*MySQLCreateTableDefinitionComponent, 0),
+		TableOptionList: make([]*MySQLTableOptionComponent, 0),
 	}
-	endPos := s.ParseByFsm(s.GetFsmMap(), tokenList, []int{2}, verboseFunc)
+	endPos := s.ParseByFsm(s.GetFsmMap(), tokenList, []int{10, 11, 12}, verboseFunc)
 	if endPos == -1 {
 		tokenList.Reset(startPos)
 		return nil, tokenList
 	} else {
 		for _, t := range s.ObjectList {
-			if (*t).Type() == "MySQLTableNameComponent" {
-				s.DatabaseList = append(s.DatabaseList, (*t).(*MySQLTableNameComponent).Database)
-				s.TableList = append(s.TableList, (*t).(*MySQLTableNameComponent).Table)
+			if (*t).Type() == "MySQLKeywordToken" && strings.ToUpper((*t).Value()) == "TEMPORARY" {
+				s.Temporary = true
+			} else if (*t).Type() == "MySQLKeywordToken" && strings.ToUpper((*t).Value()) == "EXISTS" {
+				s.IfNotExists = true
+			} else if (*t).Type() == "MySQLCreateTableDefinitionComponent" {
+				s.DefinitionList = append(s.DefinitionList, (*t).(*MySQLCreateTableDefinitionComponent))
+			} else if (*t).Type() == "MySQLTableOptionListComponent" {
+				s.TableOptionList = append(s.TableOptionList, (*t).(*MySQLTableOptionListComponent).OptionList...)
+			} else if (*t).Type() == "MySQLPartitionOptionComponent" {
+				s.Partition = (*t).(*MySQLPartitionOptionComponent)
 			} else if (*t).Type() == "SelectStatement" {
+				s.Select = (*t).(*SelectStatement)
 				s.DatabaseList = append(s.DatabaseList, (*t).(*SelectStatement).DatabaseList...)
 				s.TableList = append(s.TableList, (*t).(*SelectStatement).TableList...)
 			} else if (*t).Type() == "UnionStatement" {
+				s.Select = (*t).(*UnionStatement)
 				s.DatabaseList = append(s.DatabaseList, (*t).(*UnionStatement).DatabaseList...)
 				s.TableList = append(s.TableList, (*t).(*UnionStatement).TableList...)
+			} else if (*t).Type() == "MySQLTableNameComponent" {
+				if len(s.TableList) > 0 {
+					s.FromDatabase = (*t).(*MySQLTableNameComponent).Database
+					s.FromTable = (*t).(*MySQLTableNameComponent).Table
+				} else {
+					s.DatabaseList = append(s.DatabaseList, (*t).(*MySQLTableNameComponent).Database)
+					s.TableList = append(s.TableList, (*t).(*MySQLTableNameComponent).Table)
+				}
+			} else if (*t).Type() == "MySQLExpressionComponent" {
+				for _, tmpT := range (*t).(*MySQLExpressionComponent).ObjectList {
+					if (*tmpT).Type() == "SubQueryComponent" {
+						s.DatabaseList = append(s.DatabaseList, (*tmpT).(*SubQueryComponent).DatabaseList...)
+						s.TableList = append(s.TableList, (*tmpT).(*SubQueryComponent).TableList...)
+					}
+				}
 			}
 		}
 		tokenList.Reset(endPos)
@@ -1395,244 +1554,147 @@ func NewExplainStatement(tokenList MySQLTokenList,
 	}
 }
 
-// 13.2.5 INSERT Syntax
-// INSERT [LOW_PRIORITY | DELAYED | HIGH_PRIORITY] [IGNORE]
-//    [INTO] tbl_name
-//    [(col_name [, col_name] ...)]
-//    {VALUES | VALUE} (value_list) [, (value_list)] ...
-//    [ON DUPLICATE KEY UPDATE assignment_list]
-//
-// INSERT [LOW_PRIORITY | DELAYED | HIGH_PRIORITY] [IGNORE]
-//    [INTO] tbl_name
-//    SET assignment_list
-//    [ON DUPLICATE KEY UPDATE assignment_list]
-//
-// INSERT [LOW_PRIORITY | HIGH_PRIORITY] [IGNORE]
-//    [INTO] tbl_name
-//    [(col_name [, col_name] ...)]
-//    SELECT ...
-//    [ON DUPLICATE KEY UPDATE assignment_list]
-//
-// value:
-//    {expr | DEFAULT}
-//
-// value_list:
-//    value [, value] ...
-//
+//  13.1.13 CREATE INDEX Syntax
+// CREATE [ONLINE|OFFLINE] [UNIQUE|FULLTEXT|SPATIAL] INDEX index_name
+//    [index_type]
+//    ON tbl_name (index_col_name,...)
+//    [index_option] ...
 
-type InsertStatement struct {
+type CreateIndexStatement struct {
 	*MySQLBaseStatement
-	DatabaseList     []string
-	TableList        []string
-	FromDatabaseList []string
-	FromTableList    []string
+	Database    string
+	Table       string
+	IndexKind   IndexKind
+	IndexName   string
+	KeyPartList []*MySQLIndexColumnNameComponent
+	Invisible   bool
 }
 
-func (s *InsertStatement) Type() string {
-	return "InsertStatement"
+func (s *CreateIndexStatement) Type() string {
+	return "CreateIndexStatement"
 }
 
-func (s *InsertStatement) GetFsmMap() []FsmMap {
+func (s *CreateIndexStatement) GetFsmMap() []FsmMap {
 	return []FsmMap{
 		{
 			StartStatus:  []int{0},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "INSERT",
+			AcceptValue:  "CREATE",
 			EndStatus:    1,
 		},
 		{
 			StartStatus:  []int{1},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "LOW_PRIORITY",
+			AcceptValue:  "ONLINE",
 			EndStatus:    2,
 		},
 		{
 			StartStatus:  []int{1},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "HIGH_PRIORITY",
+			AcceptValue:  "OFFLINE",
 			EndStatus:    2,
 		},
 		{
-			StartStatus:  []int{1},
+			StartStatus:  []int{1, 2},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "DELAYED",
-			EndStatus:    2,
+			AcceptValue:  "UNIQUE",
+			EndStatus:    3,
 		},
 		{
 			StartStatus:  []int{1, 2},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "IGNORE",
+			AcceptValue:  "FULLTEXT",
+			EndStatus:    3,
+		},
+		{
+			StartStatus:  []int{1, 2},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "SPATIAL",
 			EndStatus:    3,
 		},
 		{
 			StartStatus:  []int{1, 2, 3},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "INTO",
+			AcceptValue:  "INDEX",
 			EndStatus:    4,
 		},
 		{
-			StartStatus:  []int{1, 2, 3, 4},
-			AcceptObject: "MySQLTableNameComponent",
+			StartStatus:  []int{4},
+			AcceptObject: "MySQLIdentifierComponent",
 			AcceptValue:  "",
 			EndStatus:    5,
 		},
 		{
 			StartStatus:  []int{5},
-			AcceptObject: "MySQLOperatorToken",
-			AcceptValue:  "(",
+			AcceptObject: "MySQLIndexTypeComponent",
+			AcceptValue:  "",
 			EndStatus:    6,
 		},
 		{
-			StartStatus:  []int{6},
-			AcceptObject: "MySQLColumnNameListComponent",
-			AcceptValue:  "",
+			StartStatus:  []int{5, 6},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "ON",
 			EndStatus:    7,
 		},
 		{
 			StartStatus:  []int{7},
-			AcceptObject: "MySQLOperatorToken",
-			AcceptValue:  ")",
+			AcceptObject: "MySQLTableNameComponent",
+			AcceptValue:  "",
 			EndStatus:    8,
 		},
 		{
-			StartStatus:  []int{5, 8},
-			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "VALUES",
-			EndStatus:    9,
-		},
-		{
-			StartStatus:  []int{5, 8},
-			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "VALUE",
+			StartStatus:  []int{8},
+			AcceptObject: "MySQLOperatorToken",
+			AcceptValue:  "(",
 			EndStatus:    9,
 		},
 		{
 			StartStatus:  []int{9},
-			AcceptObject: "MySQLOperatorToken",
-			AcceptValue:  "(",
+			AcceptObject: "MySQLIndexColumnNameListComponent",
+			AcceptValue:  "",
 			EndStatus:    10,
 		},
 		{
 			StartStatus:  []int{10},
-			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "DEFAULT",
+			AcceptObject: "MySQLOperatorToken",
+			AcceptValue:  ")",
 			EndStatus:    11,
 		},
 		{
-			StartStatus:  []int{10},
-			AcceptObject: "MySQLExpressionComponent",
-			AcceptValue:  "",
-			EndStatus:    11,
-		},
-		{
-			StartStatus:  []int{11},
-			AcceptObject: "MySQLDelimiterToken",
-			AcceptValue:  ",",
-			EndStatus:    10,
-		},
-		{
-			StartStatus:  []int{11},
-			AcceptObject: "MySQLOperatorToken",
-			AcceptValue:  ")",
-			EndStatus:    12,
-		},
-		{
-			StartStatus:  []int{12},
-			AcceptObject: "MySQLDelimiterToken",
-			AcceptValue:  ",",
-			EndStatus:    9,
-		},
-		{
-			StartStatus:  []int{5},
-			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "SET",
-			EndStatus:    13,
-		},
-		{
-			StartStatus:  []int{13},
-			AcceptObject: "MySQLAssignmentListExpressionComponent",
-			AcceptValue:  "",
-			EndStatus:    14,
-		},
-		{
-			StartStatus:  []int{5, 8},
-			AcceptObject: "UnionStatement",
-			AcceptValue:  "",
-			EndStatus:    15,
-		},
-		{
-			StartStatus:  []int{5, 8},
-			AcceptObject: "SelectStatement",
-			AcceptValue:  "",
-			EndStatus:    15,
-		},
-		{
-			StartStatus:  []int{12, 14, 15},
-			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "ON",
-			EndStatus:    16,
-		},
-		{
-			StartStatus:  []int{16},
-			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "DUPLICATE",
-			EndStatus:    17,
-		},
-		{
-			StartStatus:  []int{17},
-			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "KEY",
-			EndStatus:    18,
-		},
-		{
-			StartStatus:  []int{18},
-			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "UPDATE",
-			EndStatus:    19,
-		},
-		{
-			StartStatus:  []int{19},
-			AcceptObject: "MySQLAssignmentListExpressionComponent",
+			StartStatus:  []int{11, 12},
+			AcceptObject: "MySQLIndexOptionComponent",
 			AcceptValue:  "",
-			EndStatus:    FinalStatus,
+			EndStatus:    12,
 		},
 	}
 }
 
-func NewInsertStatement(tokenList MySQLTokenList,
+func NewCreateIndexStatement(tokenList MySQLTokenList,
 	verboseFunc func(message string, level LogLevel)) (MySQLStatement, MySQLTokenList) {
 	startPos := tokenList.CurrentPos()
-	s := &InsertStatement{
+	s := &CreateIndexStatement{
 		MySQLBaseStatement: &MySQLBaseStatement{
 			ObjectList: make([]*MySQLObject, 0),
 		},
-		DatabaseList:     make([]string, 0),
-		TableList:        make([]string, 0),
-		FromDatabaseList: make([]string, 0),
-		FromTableList:    make([]string, 0),
+		KeyPartList: make([]*MySQLIndexColumnNameComponent, 0),
 	}
-	endPos := s.ParseByFsm(s.GetFsmMap(), tokenList, []int{12, 14, 15}, verboseFunc)
+	endPos := s.ParseByFsm(s.GetFsmMap(), tokenList, []int{11, 12}, verboseFunc)
 	if endPos == -1 {
 		tokenList.Reset(startPos)
 		return nil, tokenList
 	} else {
 		for _, t := range s.ObjectList {
 			if (*t).Type() == "MySQLTableNameComponent" {
-				s.DatabaseList = append(s.DatabaseList, (*t).(*MySQLTableNameComponent).Database)
-				s.TableList = append(s.TableList, (*t).(*MySQLTableNameComponent).Table)
-			} else if (*t).Type() == "MySQLExpressionComponent" {
-				for _, tmpT := range (*t).(*MySQLExpressionComponent).ObjectList {
-					if (*tmpT).Type() == "SubQueryComponent" {
-						s.FromDatabaseList = append(s.FromDatabaseList, (*tmpT).(*SubQueryComponent).DatabaseList...)
-						s.FromTableList = append(s.FromTableList, (*tmpT).(*SubQueryComponent).TableList...)
-					}
-				}
-			} else if (*t).Type() == "SelectStatement" {
-				s.FromDatabaseList = append(s.FromDatabaseList, (*t).(*SelectStatement).DatabaseList...)
-				s.FromTableList = append(s.FromTableList, (*t).(*SelectStatement).TableList...)
-			} else if (*t).Type() == "UnionStatement" {
-				s.FromDatabaseList = append(s.FromDatabaseList, (*t).(*UnionStatement).DatabaseList...)
-				s.FromTableList = append(s.FromTableList, (*t).(*UnionStatement).TableList...)
+				s.Database = (*t).(*MySQLTableNameComponent).Database
+				s.Table = (*t).(*MySQLTableNameComponent).Table
+			} else if (*t).Type() == "MySQLIdentifierComponent" {
+				s.IndexName = strings.Trim((*t).Value(), "`")
+			} else if (*t).Type() == "MySQLIndexColumnNameListComponent" {
+				s.KeyPartList = append(s.KeyPartList, (*t).(*MySQLIndexColumnNameListComponent).NameList...)
+			} else if (*t).Type() == "MySQLIndexOptionComponent" {
+				s.Invisible = s.Invisible || (*t).(*MySQLIndexOptionComponent).Invisible
+			} else if (*t).Type() == "MySQLKeywordToken" {
+				s.IndexKind = getIndexKind(strings.ToUpper((*t).Value()), s.IndexKind)
 			}
 		}
 		tokenList.Reset(endPos)
@@ -1640,92 +1702,63 @@ func NewInsertStatement(tokenList MySQLTokenList,
 	}
 }
 
-// 13.1.32 RENAME TABLE Syntax
-// RENAME TABLE
-//   tbl_name TO new_tbl_name
-//   [, tbl_name2 TO new_tbl_name2] ...
+// 13.5.3 DEALLOCATE PREPARE Syntax
+// {DEALLOCATE | DROP} PREPARE stmt_name
 
-type RenameTableStatement struct {
+type DeallocatePrepareStatement struct {
 	*MySQLBaseStatement
-	DatabaseList     []string
-	TableList        []string
-	FromDatabaseList []string
-	FromTableList    []string
+	Name string
 }
 
-func (s *RenameTableStatement) Type() string {
-	return "RenameTableStatement"
+func (s *DeallocatePrepareStatement) Type() string {
+	return "DeallocatePrepareStatement"
 }
 
-func (s *RenameTableStatement) GetFsmMap() []FsmMap {
+func (s *DeallocatePrepareStatement) GetFsmMap() []FsmMap {
 	return []FsmMap{
 		{
 			StartStatus:  []int{0},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "RENAME",
+			AcceptValue:  "DEALLOCATE",
 			EndStatus:    1,
 		},
 		{
-			StartStatus:  []int{1},
+			StartStatus:  []int{0},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "TABLE",
-			EndStatus:    2,
-		},
-		{
-			StartStatus:  []int{2},
-			AcceptObject: "MySQLTableNameComponent",
-			AcceptValue:  "",
-			EndStatus:    3,
+			AcceptValue:  "DROP",
+			EndStatus:    1,
 		},
 		{
-			StartStatus:  []int{3},
+			StartStatus:  []int{1},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "TO",
-			EndStatus:    4,
+			AcceptValue:  "PREPARE",
+			EndStatus:    2,
 		},
 		{
-			StartStatus:  []int{4},
-			AcceptObject: "MySQLTableNameComponent",
+			StartStatus:  []int{2},
+			AcceptObject: "MySQLIdentifierComponent",
 			AcceptValue:  "",
-			EndStatus:    5,
-		},
-		{
-			StartStatus:  []int{5},
-			AcceptObject: "MySQLDelimiterToken",
-			AcceptValue:  ",",
-			EndStatus:    2,
+			EndStatus:    FinalStatus,
 		},
 	}
 }
 
-func NewRenameTableStatement(tokenList MySQLTokenList,
+func NewDeallocatePrepareStatement(tokenList MySQLTokenList,
 	verboseFunc func(message string, level LogLevel)) (MySQLStatement, MySQLTokenList) {
 	startPos := tokenList.CurrentPos()
-	s := &RenameTableStatement{
+	s := &DeallocatePrepareStatement{
 		MySQLBaseStatement: &MySQLBaseStatement{
 			ObjectList: make([]*MySQLObject, 0),
 		},
-		DatabaseList:     make([]string, 0),
-		TableList:        make([]string, 0),
-		FromDatabaseList: make([]string, 0),
-		FromTableList:    make([]string, 0),
 	}
-	endPos := s.ParseByFsm(s.GetFsmMap(), tokenList, []int{5}, verboseFunc)
+	endPos := s.ParseByFsm(s.GetFsmMap(), tokenList, []int{}, verboseFunc)
 	if endPos == -1 {
 		tokenList.Reset(startPos)
 		return nil, tokenList
 	} else {
-		i := 0
 		for _, t := range s.ObjectList {
-			if (*t).Type() == "MySQLTableNameComponent" {
-				i += 1
-				if i%2 == 1 {
-					s.FromDatabaseList = append(s.FromDatabaseList, (*t).(*MySQLTableNameComponent).Database)
-					s.FromTableList = append(s.FromTableList, (*t).(*MySQLTableNameComponent).Table)
-				} else {
-					s.DatabaseList = append(s.DatabaseList, (*t).(*MySQLTableNameComponent).Database)
-					s.TableList = append(s.TableList, (*t).(*MySQLTableNameComponent).Table)
-				}
+			if (*t).Type() == "MySQLIdentifierComponent" {
+				s.Name = strings.Trim((*t).Value(), "`")
 			}
 		}
 		tokenList.Reset(endPos)
@@ -1733,46 +1766,43 @@ func NewRenameTableStatement(tokenList MySQLTokenList,
 	}
 }
 
-// 13.2.8 REPLACE Syntax
-// REPLACE [LOW_PRIORITY | DELAYED] [IGNORE]
-//    [INTO] tbl_name
-//    [(col_name [, col_name] ...)]
-//    {VALUES | VALUE} (value_list) [, (value_list)] ...
-//
-// REPLACE [LOW_PRIORITY | DELAYED] [IGNORE]
-//    [INTO] tbl_name
-//    SET assignment_list
-//
-// REPLACE [LOW_PRIORITY | DELAYED] [IGNORE]
-//    [INTO] tbl_name
-//    [(col_name [, col_name] ...)]
-//    SELECT ...
-//
-// value:
-//    {expr | DEFAULT}
+// 13.2.2 DELETE Syntax
+// DELETE [LOW_PRIORITY] [QUICK] [IGNORE] FROM tbl_name [[AS] tbl_alias]
+//    [WHERE where_condition]
+//    [ORDER BY ...]
+//    [LIMIT row_count]
 //
-// value_list:
-//    value [, value] ...
+// DELETE [LOW_PRIORITY] [QUICK] [IGNORE]
+//    tbl_name[.*] [, tbl_name[.*]] ...
+//    FROM table_references
+//    [WHERE where_condition]
 //
+// DELETE [LOW_PRIORITY] [QUICK] [IGNORE]
+//    FROM tbl_name[.*] [, tbl_name[.*]] ...
+//    USING table_references
+//    [WHERE where_condition]
 
-type ReplaceStatement struct {
+type DeleteStatement struct {
 	*MySQLBaseStatement
-	DatabaseList     []string
-	TableList        []string
-	FromDatabaseList []string
-	FromTableList    []string
+	DatabaseList []string
+	TableList    []string
+	TargetTables []*MySQLTableNameComponent
+	ReadTables   []*MySQLTableNameComponent
+	Where        *MySQLExpressionComponent
+	OrderBy      *MySQLOrderListOptionComponent
+	Limit        string
 }
 
-func (s *ReplaceStatement) Type() string {
-	return "ReplaceStatement"
+func (s *DeleteStatement) Type() string {
+	return "DeleteStatement"
 }
 
-func (s *ReplaceStatement) GetFsmMap() []FsmMap {
+func (s *DeleteStatement) GetFsmMap() []FsmMap {
 	return []FsmMap{
 		{
 			StartStatus:  []int{0},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "REPLACE",
+			AcceptValue:  "DELETE",
 			EndStatus:    1,
 		},
 		{
@@ -1781,1218 +1811,4828 @@ func (s *ReplaceStatement) GetFsmMap() []FsmMap {
 			AcceptValue:  "LOW_PRIORITY",
 			EndStatus:    2,
 		},
-		{
-			StartStatus:  []int{1},
-			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "DELAYED",
-			EndStatus:    2,
-		},
 		{
 			StartStatus:  []int{1, 2},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "IGNORE",
+			AcceptValue:  "QUICK",
 			EndStatus:    3,
 		},
 		{
 			StartStatus:  []int{1, 2, 3},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "INTO",
+			AcceptValue:  "IGNORE",
 			EndStatus:    4,
 		},
 		{
 			StartStatus:  []int{1, 2, 3, 4},
-			AcceptObject: "MySQLTableNameComponent",
-			AcceptValue:  "",
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "FROM",
 			EndStatus:    5,
 		},
 		{
 			StartStatus:  []int{5},
-			AcceptObject: "MySQLOperatorToken",
-			AcceptValue:  "(",
+			AcceptObject: "MySQLTableNameComponent",
+			AcceptValue:  "",
 			EndStatus:    6,
 		},
 		{
-			StartStatus:  []int{6},
-			AcceptObject: "MySQLColumnNameListComponent",
-			AcceptValue:  "",
+			StartStatus:  []int{6, 25},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "WHERE",
 			EndStatus:    7,
 		},
 		{
 			StartStatus:  []int{7},
-			AcceptObject: "MySQLOperatorToken",
-			AcceptValue:  ")",
+			AcceptObject: "MySQLExpressionComponent",
+			AcceptValue:  "",
 			EndStatus:    8,
 		},
 		{
-			StartStatus:  []int{5, 8},
+			StartStatus:  []int{6, 8, 25},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "VALUES",
-			EndStatus:    9,
-		},
-		{
-			StartStatus:  []int{5, 8},
-			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "VALUE",
+			AcceptValue:  "ORDER",
 			EndStatus:    9,
 		},
 		{
 			StartStatus:  []int{9},
-			AcceptObject: "MySQLOperatorToken",
-			AcceptValue:  "(",
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "BY",
 			EndStatus:    10,
 		},
 		{
 			StartStatus:  []int{10},
-			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "DEFAULT",
+			AcceptObject: "MySQLOrderListOptionComponent",
+			AcceptValue:  "",
 			EndStatus:    11,
 		},
 		{
-			StartStatus:  []int{10},
-			AcceptObject: "MySQLExpressionComponent",
-			AcceptValue:  "",
-			EndStatus:    11,
+			StartStatus:  []int{6, 8, 11, 25},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "LIMIT",
+			EndStatus:    12,
 		},
 		{
-			StartStatus:  []int{11},
-			AcceptObject: "MySQLDelimiterToken",
+			StartStatus:  []int{12},
+			AcceptObject: "MySQLNumericToken",
+			AcceptValue:  "",
+			EndStatus:    FinalStatus,
+		},
+		{
+			StartStatus:  []int{12},
+			AcceptObject: "MySQLParamMarkerToken",
+			AcceptValue:  "",
+			EndStatus:    FinalStatus,
+		},
+		{
+			StartStatus:  []int{6, 16},
+			AcceptObject: "MySQLOperatorToken",
+			AcceptValue:  ".",
+			EndStatus:    13,
+		},
+		{
+			StartStatus:  []int{13},
+			AcceptObject: "MySQLOperatorToken",
+			AcceptValue:  "*",
+			EndStatus:    14,
+		},
+		{
+			StartStatus:  []int{6, 14, 16},
+			AcceptObject: "MySQLDelimiterToken",
 			AcceptValue:  ",",
-			EndStatus:    10,
+			EndStatus:    15,
 		},
 		{
-			StartStatus:  []int{11},
+			StartStatus:  []int{15},
+			AcceptObject: "MySQLTableNameComponent",
+			AcceptValue:  "",
+			EndStatus:    16,
+		},
+		{
+			StartStatus:  []int{6, 14, 16},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "USING",
+			EndStatus:    17,
+		},
+		{
+			StartStatus:  []int{1, 2, 3, 4},
+			AcceptObject: "MySQLTableNameComponent",
+			AcceptValue:  "",
+			EndStatus:    18,
+		},
+		{
+			StartStatus:  []int{18},
 			AcceptObject: "MySQLOperatorToken",
-			AcceptValue:  ")",
-			EndStatus:    12,
+			AcceptValue:  ".",
+			EndStatus:    19,
 		},
 		{
-			StartStatus:  []int{12},
+			StartStatus:  []int{19},
+			AcceptObject: "MySQLOperatorToken",
+			AcceptValue:  "*",
+			EndStatus:    20,
+		},
+		{
+			StartStatus:  []int{18, 20},
 			AcceptObject: "MySQLDelimiterToken",
 			AcceptValue:  ",",
-			EndStatus:    9,
+			EndStatus:    23,
 		},
 		{
-			StartStatus:  []int{5},
+			StartStatus:  []int{18, 20},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "SET",
-			EndStatus:    13,
+			AcceptValue:  "FROM",
+			EndStatus:    17,
 		},
 		{
-			StartStatus:  []int{13},
-			AcceptObject: "MySQLAssignmentListExpressionComponent",
+			StartStatus:  []int{23},
+			AcceptObject: "MySQLTableNameComponent",
 			AcceptValue:  "",
-			EndStatus:    FinalStatus,
+			EndStatus:    18,
 		},
 		{
-			StartStatus:  []int{5, 8},
-			AcceptObject: "UnionStatement",
+			StartStatus:  []int{17},
+			AcceptObject: "TableReferenceListComponent",
 			AcceptValue:  "",
-			EndStatus:    FinalStatus,
+			EndStatus:    21,
 		},
 		{
-			StartStatus:  []int{5, 8},
-			AcceptObject: "SelectStatement",
+			StartStatus:  []int{21},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "WHERE",
+			EndStatus:    22,
+		},
+		{
+			StartStatus:  []int{22},
+			AcceptObject: "MySQLExpressionComponent",
 			AcceptValue:  "",
 			EndStatus:    FinalStatus,
 		},
+		{
+			StartStatus:  []int{6},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "AS",
+			EndStatus:    24,
+		},
+		{
+			StartStatus:  []int{6, 24},
+			AcceptObject: "MySQLIdentifierComponent",
+			AcceptValue:  "",
+			EndStatus:    25,
+		},
 	}
 }
 
-func NewReplaceStatement(tokenList MySQLTokenList,
+func NewDeleteStatement(tokenList MySQLTokenList,
 	verboseFunc func(message string, level LogLevel)) (MySQLStatement, MySQLTokenList) {
 	startPos := tokenList.CurrentPos()
-	s := &ReplaceStatement{
+	s := &DeleteStatement{
 		MySQLBaseStatement: &MySQLBaseStatement{
 			ObjectList: make([]*MySQLObject, 0),
 		},
-		DatabaseList:     make([]string, 0),
-		TableList:        make([]string, 0),
-		FromDatabaseList: make([]string, 0),
-		FromTableList:    make([]string, 0),
+		DatabaseList: make([]string, 0),
+		TableList:    make([]string, 0),
+		TargetTables: make([]*MySQLTableNameComponent, 0),
+		ReadTables:   make([]*MySQLTableNameComponent, 0),
 	}
-	endPos := s.ParseByFsm(s.GetFsmMap(), tokenList, []int{12}, verboseFunc)
+	endPos := s.ParseByFsm(s.GetFsmMap(), tokenList, []int{6, 8, 11, 21, 25}, verboseFunc)
 	if endPos == -1 {
 		tokenList.Reset(startPos)
 		return nil, tokenList
 	} else {
+		factorList := getTableFactorList(s.ObjectList, false)
 		for _, t := range s.ObjectList {
 			if (*t).Type() == "MySQLTableNameComponent" {
-				s.DatabaseList = append(s.DatabaseList, (*t).(*MySQLTableNameComponent).Database)
-				s.TableList = append(s.TableList, (*t).(*MySQLTableNameComponent).Table)
+				tableName := (*t).(*MySQLTableNameComponent)
+				s.DatabaseList = append(s.DatabaseList, tableName.Database)
+				s.TableList = append(s.TableList, tableName.Table)
+				factor := findTableFactor(factorList, tableName.Database, tableName.Table)
+				if factor != nil && factor.TableName != nil {
+					tableName = factor.TableName
+				}
+				s.TargetTables = appendTableName(s.TargetTables, tableName)
+			} else if (*t).Type() == "TableReferenceListComponent" {
+				s.DatabaseList = append(s.DatabaseList, (*t).(*TableReferenceListComponent).DatabaseList...)
+				s.TableList = append(s.TableList, (*t).(*TableReferenceListComponent).TableList...)
 			} else if (*t).Type() == "MySQLExpressionComponent" {
+				s.Where = (*t).(*MySQLExpressionComponent)
 				for _, tmpT := range (*t).(*MySQLExpressionComponent).ObjectList {
 					if (*tmpT).Type() == "SubQueryComponent" {
-						s.FromDatabaseList = append(s.FromDatabaseList, (*tmpT).(*SubQueryComponent).DatabaseList...)
-						s.FromTableList = append(s.FromTableList, (*tmpT).(*SubQueryComponent).TableList...)
+						s.DatabaseList = append(s.DatabaseList, (*tmpT).(*SubQueryComponent).DatabaseList...)
+						s.TableList = append(s.TableList, (*tmpT).(*SubQueryComponent).TableList...)
 					}
 				}
-			} else if (*t).Type() == "SelectStatement" {
-				s.FromDatabaseList = append(s.FromDatabaseList, (*t).(*SelectStatement).DatabaseList...)
-				s.FromTableList = append(s.FromTableList, (*t).(*SelectStatement).TableList...)
-			} else if (*t).Type() == "UnionStatement" {
-				s.FromDatabaseList = append(s.FromDatabaseList, (*t).(*UnionStatement).DatabaseList...)
-				s.FromTableList = append(s.FromTableList, (*t).(*UnionStatement).TableList...)
+			} else if (*t).Type() == "MySQLOrderListOptionComponent" {
+				s.OrderBy = (*t).(*MySQLOrderListOptionComponent)
+			} else if (*t).Type() == "MySQLNumericToken" || (*t).Type() == "MySQLParamMarkerToken" {
+				s.Limit = (*t).Value()
 			}
 		}
+		s.ReadTables = getReadTableList(getTableFactorList(s.ObjectList, true), s.TargetTables)
 		tokenList.Reset(endPos)
 		return s, tokenList
 	}
 }
 
-// 13.2.9 SELECT Syntax
-// SELECT
-//    [ALL | DISTINCT | DISTINCTROW ]
-//      [HIGH_PRIORITY]
-//      [STRAIGHT_JOIN]
-//      [SQL_SMALL_RESULT] [SQL_BIG_RESULT] [SQL_BUFFER_RESULT]
-//      [SQL_CACHE | SQL_NO_CACHE] [SQL_CALC_FOUND_ROWS]
-//    select_expr [, select_expr ...]
-//    [FROM table_references
-//    [WHERE where_condition]
-//    [GROUP BY {col_name | expr | position}
-//      [ASC | DESC], ... [WITH ROLLUP]]
-//    [HAVING where_condition]
-//    [ORDER BY {col_name | expr | position}
-//      [ASC | DESC], ...]
-//    [LIMIT {[offset,] row_count | row_count OFFSET offset}]
-//    [PROCEDURE procedure_name(argument_list)]
-//    [INTO OUTFILE 'file_name'
-//        [CHARACTER SET charset_name]
-//        export_options
-//      | INTO DUMPFILE 'file_name'
-//      | INTO var_name [, var_name]]
-//    [FOR UPDATE | LOCK IN SHARE MODE]]
+// 13.2.3 DO Syntax
+// DO expr [, expr] ...
 
-type SelectStatement struct {
+type DoStatement struct {
 	*MySQLBaseStatement
-	DatabaseList []string
-	TableList    []string
+	ExpressionList []*MySQLExpressionComponent
+	DatabaseList   []string
+	TableList      []string
 }
 
-func (s *SelectStatement) Type() string {
-	return "SelectStatement"
+func (s *DoStatement) Type() string {
+	return "DoStatement"
 }
 
-func (s *SelectStatement) GetFsmMap() []FsmMap {
+func (s *DoStatement) GetFsmMap() []FsmMap {
 	return []FsmMap{
 		{
 			StartStatus:  []int{0},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "SELECT",
+			AcceptValue:  "DO",
 			EndStatus:    1,
 		},
 		{
-			StartStatus:  []int{1},
-			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "ALL",
+			StartStatus:  []int{1, 3},
+			AcceptObject: "MySQLExpressionComponent",
+			AcceptValue:  "",
 			EndStatus:    2,
 		},
+		{
+			StartStatus:  []int{2},
+			AcceptObject: "MySQLDelimiterToken",
+			AcceptValue:  ",",
+			EndStatus:    3,
+		},
+	}
+}
+
+func NewDoStatement(tokenList MySQLTokenList,
+	verboseFunc func(message string, level LogLevel)) (MySQLStatement, MySQLTokenList) {
+	startPos := tokenList.CurrentPos()
+	s := &DoStatement{
+		MySQLBaseStatement: &MySQLBaseStatement{
+			ObjectList: make([]*MySQLObject, 0),
+		},
+		ExpressionList: make([]*MySQLExpressionComponent, 0),
+		DatabaseList:   make([]string, 0),
+		TableList:      make([]string, 0),
+	}
+	endPos := s.ParseByFsm(s.GetFsmMap(), tokenList, []int{2}, verboseFunc)
+	if endPos == -1 {
+		tokenList.Reset(startPos)
+		return nil, tokenList
+	} else {
+		for _, t := range s.ObjectList {
+			if (*t).Type() == "MySQLExpressionComponent" {
+				s.ExpressionList = append(s.ExpressionList, (*t).(*MySQLExpressionComponent))
+				for _, tmpT := range (*t).(*MySQLExpressionComponent).ObjectList {
+					if (*tmpT).Type() == "SubQueryComponent" {
+						s.DatabaseList = append(s.DatabaseList, (*tmpT).(*SubQueryComponent).DatabaseList...)
+						s.TableList = append(s.TableList, (*tmpT).(*SubQueryComponent).TableList...)
+					}
+				}
+			}
+		}
+		tokenList.Reset(endPos)
+		return s, tokenList
+	}
+}
+
+// 13.1.21 DROP DATABASE Syntax
+// DROP { DATABASE | SCHEMA } [IF EXISTS] db_name
+
+type DropDatabaseStatement struct {
+	*MySQLBaseStatement
+	Database string
+	IfExists bool
+}
+
+func (s *DropDatabaseStatement) Type() string {
+	return "DropDatabaseStatement"
+}
+
+func (s *DropDatabaseStatement) GetFsmMap() []FsmMap {
+	return []FsmMap{
+		{
+			StartStatus:  []int{0},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "DROP",
+			EndStatus:    1,
+		},
 		{
 			StartStatus:  []int{1},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "DISTINCT",
+			AcceptValue:  "DATABASE",
 			EndStatus:    2,
 		},
 		{
 			StartStatus:  []int{1},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "DISTINCTROW",
+			AcceptValue:  "SCHEMA",
 			EndStatus:    2,
 		},
 		{
-			StartStatus:  []int{1, 2},
+			StartStatus:  []int{2},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "HIGH_PRIORITY",
+			AcceptValue:  "IF",
 			EndStatus:    3,
 		},
 		{
-			StartStatus:  []int{1, 2, 3},
+			StartStatus:  []int{3},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "STRAIGHT_JOIN",
+			AcceptValue:  "EXISTS",
 			EndStatus:    4,
 		},
 		{
-			StartStatus:  []int{1, 2, 3, 4},
-			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "SQL_SMALL_RESULT",
-			EndStatus:    5,
-		},
-		{
-			StartStatus:  []int{1, 2, 3, 4, 5},
-			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "SQL_BIG_RESULT",
-			EndStatus:    6,
+			StartStatus:  []int{2, 4},
+			AcceptObject: "MySQLDatabaseNameComponent",
+			AcceptValue:  "",
+			EndStatus:    FinalStatus,
 		},
-		{
-			StartStatus:  []int{1, 2, 3, 4, 5, 6},
-			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "SQL_BUFFER_RESULT",
-			EndStatus:    7,
+	}
+}
+
+func NewDropDatabaseStatement(tokenList MySQLTokenList,
+	verboseFunc func(message string, level LogLevel)) (MySQLStatement, MySQLTokenList) {
+	startPos := tokenList.CurrentPos()
+	s := &DropDatabaseStatement{
+		MySQLBaseStatement: &MySQLBaseStatement{
+			ObjectList: make([]*MySQLObject, 0),
 		},
-		{
-			StartStatus:  []int{1, 2, 3, 4, 5, 6, 7},
-			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "SQL_CACHE",
+	}
+	endPos := s.ParseByFsm(s.GetFsmMap(), tokenList, []int{}, verboseFunc)
+	if endPos == -1 {
+		tokenList.Reset(startPos)
+		return nil, tokenList
+	} else {
+		for _, t := range s.ObjectList {
+			if (*t).Type() == "MySQLDatabaseNameComponent" {
+				s.Database = (*t).(*MySQLDatabaseNameComponent).Database
+			} else if (*t).Type() == "MySQLKeywordToken" && strings.ToUpper((*t).Value()) == "EXISTS" {
+				s.IfExists = true
+			}
+		}
+		tokenList.Reset(endPos)
+		return s, tokenList
+	}
+}
+
+// 13.1.28 DROP TABLE Syntax
+// DROP [TEMPORARY] TABLE [IF EXISTS] tbl_name
+//   tbl_name [, tbl_name] ...
+//   [RESTRICT | CASCADE]
+
+type DropTableStatement struct {
+	*MySQLBaseStatement
+	Database     string
+	Table        string
+	DatabaseList []string
+	TableList    []string
+	Temporary    bool
+	IfExists     bool
+}
+
+func (s *DropTableStatement) Type() string {
+	return "DropTableStatement"
+}
+
+func (s *DropTableStatement) GetFsmMap() []FsmMap {
+	return []FsmMap{
+		{
+			StartStatus:  []int{0},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "DROP",
+			EndStatus:    1,
+		},
+		{
+			StartStatus:  []int{1},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "TEMPORARY",
+			EndStatus:    2,
+		},
+		{
+			StartStatus:  []int{1, 2},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "TABLE",
+			EndStatus:    3,
+		},
+		{
+			StartStatus:  []int{3},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "IF",
+			EndStatus:    4,
+		},
+		{
+			StartStatus:  []int{4},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "EXISTS",
+			EndStatus:    5,
+		},
+		{
+			StartStatus:  []int{3, 5},
+			AcceptObject: "MySQLTableNameListComponent",
+			AcceptValue:  "",
+			EndStatus:    6,
+		},
+		{
+			StartStatus:  []int{6},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "RESTRICT",
+			EndStatus:    FinalStatus,
+		},
+		{
+			StartStatus:  []int{6},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "CASCADE",
+			EndStatus:    FinalStatus,
+		},
+	}
+}
+
+func NewDropTableStatement(tokenList MySQLTokenList,
+	verboseFunc func(message string, level LogLevel)) (MySQLStatement, MySQLTokenList) {
+	startPos := tokenList.CurrentPos()
+	s := &DropTableStatement{
+		MySQLBaseStatement: &MySQLBaseStatement{
+			ObjectList: make([]*MySQLObject, 0),
+		},
+		DatabaseList: make([]string, 0),
+		TableList:    make([]string, 0),
+	}
+	endPos := s.ParseByFsm(s.GetFsmMap(), tokenList, []int{6}, verboseFunc)
+	if endPos == -1 {
+		tokenList.Reset(startPos)
+		return nil, tokenList
+	} else {
+		for _, t := range s.ObjectList {
+			if (*t).Type() == "MySQLTableNameListComponent" {
+				for _, tableName := range (*t).(*MySQLTableNameListComponent).TableList {
+					s.DatabaseList = append(s.DatabaseList, tableName.Database)
+					s.TableList = append(s.TableList, tableName.Table)
+				}
+			} else if (*t).Type() == "MySQLKeywordToken" && strings.ToUpper((*t).Value()) == "TEMPORARY" {
+				s.Temporary = true
+			} else if (*t).Type() == "MySQLKeywordToken" && strings.ToUpper((*t).Value()) == "EXISTS" {
+				s.IfExists = true
+			}
+		}
+		if len(s.TableList) > 0 {
+			s.Database = s.DatabaseList[0]
+			s.Table = s.TableList[0]
+		}
+		tokenList.Reset(endPos)
+		return s, tokenList
+	}
+}
+
+//  13.1.24 DROP INDEX Syntax
+// DROP [ONLINE|OFFLINE] INDEX index_name ON tbl_name
+
+type DropIndexStatement struct {
+	*MySQLBaseStatement
+	Database  string
+	Table     string
+	IndexName string
+}
+
+func (s *DropIndexStatement) Type() string {
+	return "DropIndexStatement"
+}
+
+func (s *DropIndexStatement) GetFsmMap() []FsmMap {
+	return []FsmMap{
+		{
+			StartStatus:  []int{0},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "DROP",
+			EndStatus:    1,
+		},
+		{
+			StartStatus:  []int{1},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "ONLINE",
+			EndStatus:    2,
+		},
+		{
+			StartStatus:  []int{1},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "OFFLINE",
+			EndStatus:    2,
+		},
+		{
+			StartStatus:  []int{1, 2},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "INDEX",
+			EndStatus:    3,
+		},
+		{
+			StartStatus:  []int{3},
+			AcceptObject: "MySQLIdentifierComponent",
+			AcceptValue:  "",
+			EndStatus:    4,
+		},
+		{
+			StartStatus:  []int{4},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "ON",
+			EndStatus:    5,
+		},
+		{
+			StartStatus:  []int{5},
+			AcceptObject: "MySQLTableNameComponent",
+			AcceptValue:  "",
+			EndStatus:    FinalStatus,
+		},
+	}
+}
+
+func NewDropIndexStatement(tokenList MySQLTokenList,
+	verboseFunc func(message string, level LogLevel)) (MySQLStatement, MySQLTokenList) {
+	startPos := tokenList.CurrentPos()
+	s := &DropIndexStatement{
+		MySQLBaseStatement: &MySQLBaseStatement{
+			ObjectList: make([]*MySQLObject, 0),
+		},
+	}
+	endPos := s.ParseByFsm(s.GetFsmMap(), tokenList, []int{}, verboseFunc)
+	if endPos == -1 {
+		tokenList.Reset(startPos)
+		return nil, tokenList
+	} else {
+		for _, t := range s.ObjectList {
+			if (*t).Type() == "MySQLTableNameComponent" {
+				s.Database = (*t).(*MySQLTableNameComponent).Database
+				s.Table = (*t).(*MySQLTableNameComponent).Table
+			} else if (*t).Type() == "MySQLIdentifierComponent" {
+				s.IndexName = strings.Trim((*t).Value(), "`")
+			}
+		}
+		tokenList.Reset(endPos)
+		return s, tokenList
+	}
+}
+
+// 13.5.2 EXECUTE Syntax
+// EXECUTE stmt_name
+//    [USING @var_name [, @var_name] ...]
+
+type ExecuteStatement struct {
+	*MySQLBaseStatement
+	Name         string
+	VariableList []string
+}
+
+func (s *ExecuteStatement) Type() string {
+	return "ExecuteStatement"
+}
+
+func (s *ExecuteStatement) GetFsmMap() []FsmMap {
+	return []FsmMap{
+		{
+			StartStatus:  []int{0},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "EXECUTE",
+			EndStatus:    1,
+		},
+		{
+			StartStatus:  []int{1},
+			AcceptObject: "MySQLIdentifierComponent",
+			AcceptValue:  "",
+			EndStatus:    2,
+		},
+		{
+			StartStatus:  []int{2},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "USING",
+			EndStatus:    3,
+		},
+		{
+			StartStatus:  []int{3, 5},
+			AcceptObject: "MySQLVariableToken",
+			AcceptValue:  "",
+			EndStatus:    4,
+		},
+		{
+			StartStatus:  []int{4},
+			AcceptObject: "MySQLDelimiterToken",
+			AcceptValue:  ",",
+			EndStatus:    5,
+		},
+	}
+}
+
+func NewExecuteStatement(tokenList MySQLTokenList,
+	verboseFunc func(message string, level LogLevel)) (MySQLStatement, MySQLTokenList) {
+	startPos := tokenList.CurrentPos()
+	s := &ExecuteStatement{
+		MySQLBaseStatement: &MySQLBaseStatement{
+			ObjectList: make([]*MySQLObject, 0),
+		},
+		VariableList: make([]string, 0),
+	}
+	endPos := s.ParseByFsm(s.GetFsmMap(), tokenList, []int{2, 4}, verboseFunc)
+	if endPos == -1 {
+		tokenList.Reset(startPos)
+		return nil, tokenList
+	} else {
+		for _, t := range s.ObjectList {
+			if (*t).Type() == "MySQLIdentifierComponent" {
+				s.Name = strings.Trim((*t).Value(), "`")
+			} else if (*t).Type() == "MySQLVariableToken" {
+				s.VariableList = append(s.VariableList, (*t).Value())
+			}
+		}
+		tokenList.Reset(endPos)
+		return s, tokenList
+	}
+}
+
+// 13.8.2 EXPLAIN Syntax
+// {EXPLAIN | DESCRIBE | DESC}
+//    tbl_name [col_name | wild]
+//
+// {EXPLAIN | DESCRIBE | DESC}
+//    [explain_type] SELECT select_options
+//
+// explain_type: {EXTENDED | PARTITIONS}
+
+type ExplainStatement struct {
+	*MySQLBaseStatement
+	DatabaseList []string
+	TableList    []string
+}
+
+func (s *ExplainStatement) Type() string {
+	return "ExplainStatement"
+}
+
+func (s *ExplainStatement) GetFsmMap() []FsmMap {
+	return []FsmMap{
+		{
+			StartStatus:  []int{0},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "EXPLAIN",
+			EndStatus:    1,
+		},
+		{
+			StartStatus:  []int{0},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "DESCRIBE",
+			EndStatus:    1,
+		},
+		{
+			StartStatus:  []int{0},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "DESC",
+			EndStatus:    1,
+		},
+		{
+			StartStatus:  []int{1},
+			AcceptObject: "MySQLTableNameComponent",
+			AcceptValue:  "",
+			EndStatus:    2,
+		},
+		{
+			StartStatus:  []int{2},
+			AcceptObject: "MySQLColumnNameComponent",
+			AcceptValue:  "",
+			EndStatus:    FinalStatus,
+		},
+		{
+			StartStatus:  []int{2},
+			AcceptObject: "MySQLStringToken",
+			AcceptValue:  "",
+			EndStatus:    FinalStatus,
+		},
+		{
+			StartStatus:  []int{1},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "EXTENDED",
+			EndStatus:    3,
+		},
+		{
+			StartStatus:  []int{1},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "PARTITIONS",
+			EndStatus:    3,
+		},
+		{
+			StartStatus:  []int{1, 3},
+			AcceptObject: "UnionStatement",
+			AcceptValue:  "",
+			EndStatus:    FinalStatus,
+		},
+		{
+			StartStatus:  []int{1, 3},
+			AcceptObject: "SelectStatement",
+			AcceptValue:  "",
+			EndStatus:    FinalStatus,
+		},
+	}
+}
+
+func NewExplainStatement(tokenList MySQLTokenList,
+	verboseFunc func(message string, level LogLevel)) (MySQLStatement, MySQLTokenList) {
+	startPos := tokenList.CurrentPos()
+	s := &ExplainStatement{
+		MySQLBaseStatement: &MySQLBaseStatement{
+			ObjectList: make([]*MySQLObject, 0),
+		},
+		DatabaseList: make([]string, 0),
+		TableList:    make([]string, 0),
+	}
+	endPos := s.ParseByFsm(s.GetFsmMap(), tokenList, []int{2}, verboseFunc)
+	if endPos == -1 {
+		tokenList.Reset(startPos)
+		return nil, tokenList
+	} else {
+		for _, t := range s.ObjectList {
+			if (*t).Type() == "MySQLTableNameComponent" {
+				s.DatabaseList = append(s.DatabaseList, (*t).(*MySQLTableNameComponent).Database)
+				s.TableList = append(s.TableList, (*t).(*MySQLTableNameComponent).Table)
+			} else if (*t).Type() == "SelectStatement" {
+				s.DatabaseList = append(s.DatabaseList, (*t).(*SelectStatement).DatabaseList...)
+				s.TableList = append(s.TableList, (*t).(*SelectStatement).TableList...)
+			} else if (*t).Type() == "UnionStatement" {
+				s.DatabaseList = append(s.DatabaseList, (*t).(*UnionStatement).DatabaseList...)
+				s.TableList = append(s.TableList, (*t).(*UnionStatement).TableList...)
+			}
+		}
+		tokenList.Reset(endPos)
+		return s, tokenList
+	}
+}
+
+// 13.7.6.3 FLUSH Syntax
+// FLUSH [NO_WRITE_TO_BINLOG | LOCAL] {
+//    flush_option [, flush_option] ...
+//  | tables_option
+// }
+//
+// flush_option: {
+//    BINARY LOGS
+//  | DES_KEY_FILE
+//  | ENGINE LOGS
+//  | ERROR LOGS
+//  | GENERAL LOGS
+//  | HOSTS
+//  | LOGS
+//  | PRIVILEGES
+//  | OPTIMIZER_COSTS
+//  | QUERY CACHE
+//  | RELAY LOGS [FOR CHANNEL channel]
+//  | SLOW LOGS
+//  | STATUS
+//  | USER_RESOURCES
+// }
+//
+// tables_option: {
+//    TABLES
+//  | TABLES tbl_name [, tbl_name] ...
+//  | TABLES WITH READ LOCK
+//  | TABLES tbl_name [, tbl_name] ... WITH READ LOCK
+//  | TABLES tbl_name [, tbl_name] ... FOR EXPORT
+// }
+
+type FlushStatement struct {
+	*MySQLBaseStatement
+	NoWriteToBinlog bool
+	OptionList      []string
+	Channel         string
+	DatabaseList    []string
+	TableList       []string
+	WithReadLock    bool
+	ForExport       bool
+}
+
+func (s *FlushStatement) Type() string {
+	return "FlushStatement"
+}
+
+func (s *FlushStatement) GetFsmMap() []FsmMap {
+	return []FsmMap{
+		{
+			StartStatus:  []int{0},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "FLUSH",
+			EndStatus:    1,
+		},
+		{
+			StartStatus:  []int{1},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "NO_WRITE_TO_BINLOG",
+			EndStatus:    2,
+		},
+		{
+			StartStatus:  []int{1},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "LOCAL",
+			EndStatus:    2,
+		},
+		{
+			StartStatus:  []int{1, 2, 4},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "BINARY",
+			EndStatus:    5,
+		},
+		{
+			StartStatus:  []int{1, 2, 4},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "ENGINE",
+			EndStatus:    5,
+		},
+		{
+			StartStatus:  []int{1, 2, 4},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "ERROR",
+			EndStatus:    5,
+		},
+		{
+			StartStatus:  []int{1, 2, 4},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "GENERAL",
+			EndStatus:    5,
+		},
+		{
+			StartStatus:  []int{1, 2, 4},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "RELAY",
+			EndStatus:    5,
+		},
+		{
+			StartStatus:  []int{1, 2, 4},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "SLOW",
+			EndStatus:    5,
+		},
+		{
+			StartStatus:  []int{5},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "LOGS",
+			EndStatus:    3,
+		},
+		{
+			StartStatus:  []int{1, 2, 4},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "DES_KEY_FILE",
+			EndStatus:    3,
+		},
+		{
+			StartStatus:  []int{1, 2, 4},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "HOSTS",
+			EndStatus:    3,
+		},
+		{
+			StartStatus:  []int{1, 2, 4},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "LOGS",
+			EndStatus:    3,
+		},
+		{
+			StartStatus:  []int{1, 2, 4},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "PRIVILEGES",
+			EndStatus:    3,
+		},
+		{
+			StartStatus:  []int{1, 2, 4},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "OPTIMIZER_COSTS",
+			EndStatus:    3,
+		},
+		{
+			StartStatus:  []int{1, 2, 4},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "STATUS",
+			EndStatus:    3,
+		},
+		{
+			StartStatus:  []int{1, 2, 4},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "USER_RESOURCES",
+			EndStatus:    3,
+		},
+		{
+			StartStatus:  []int{1, 2, 4},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "QUERY",
+			EndStatus:    6,
+		},
+		{
+			StartStatus:  []int{6},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "CACHE",
+			EndStatus:    3,
+		},
+		{
+			StartStatus:  []int{3},
+			AcceptObject: "MySQLDelimiterToken",
+			AcceptValue:  ",",
+			EndStatus:    4,
+		},
+		{
+			StartStatus:  []int{3},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "FOR",
+			EndStatus:    7,
+		},
+		{
+			StartStatus:  []int{7},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "CHANNEL",
+			EndStatus:    8,
+		},
+		{
+			StartStatus:  []int{8},
+			AcceptObject: "MySQLIdentifierComponent",
+			AcceptValue:  "",
+			EndStatus:    9,
+		},
+		{
+			StartStatus:  []int{8},
+			AcceptObject: "MySQLStringToken",
+			AcceptValue:  "",
+			EndStatus:    9,
+		},
+		{
+			StartStatus:  []int{9},
+			AcceptObject: "MySQLDelimiterToken",
+			AcceptValue:  ",",
+			EndStatus:    4,
+		},
+		{
+			StartStatus:  []int{1, 2},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "TABLES",
+			EndStatus:    10,
+		},
+		{
+			StartStatus:  []int{1, 2},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "TABLE",
+			EndStatus:    10,
+		},
+		{
+			StartStatus:  []int{10},
+			AcceptObject: "MySQLTableNameListComponent",
+			AcceptValue:  "",
+			EndStatus:    11,
+		},
+		{
+			StartStatus:  []int{10, 11},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "WITH",
+			EndStatus:    12,
+		},
+		{
+			StartStatus:  []int{12},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "READ",
+			EndStatus:    13,
+		},
+		{
+			StartStatus:  []int{13},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "LOCK",
+			EndStatus:    FinalStatus,
+		},
+		{
+			StartStatus:  []int{11},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "FOR",
+			EndStatus:    14,
+		},
+		{
+			StartStatus:  []int{14},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "EXPORT",
+			EndStatus:    FinalStatus,
+		},
+	}
+}
+
+func NewFlushStatement(tokenList MySQLTokenList,
+	verboseFunc func(message string, level LogLevel)) (MySQLStatement, MySQLTokenList) {
+	startPos := tokenList.CurrentPos()
+	s := &FlushStatement{
+		MySQLBaseStatement: &MySQLBaseStatement{
+			ObjectList: make([]*MySQLObject, 0),
+		},
+		OptionList:   make([]string, 0),
+		DatabaseList: make([]string, 0),
+		TableList:    make([]string, 0),
+	}
+	endPos := s.ParseByFsm(s.GetFsmMap(), tokenList, []int{3, 9, 10, 11}, verboseFunc)
+	if endPos == -1 {
+		tokenList.Reset(startPos)
+		return nil, tokenList
+	} else {
+		prefix := ""
+		for _, t := range s.ObjectList {
+			if (*t).Type() == "MySQLKeywordToken" && InArray((*t).Value(), []string{"NO_WRITE_TO_BINLOG", "LOCAL"}) {
+				s.NoWriteToBinlog = true
+			} else if (*t).Type() == "MySQLKeywordToken" && InArray((*t).Value(), []string{"BINARY", "ENGINE", "ERROR", "GENERAL", "RELAY", "SLOW", "QUERY"}) {
+				prefix = (*t).Value() + " "
+			} else if (*t).Type() == "MySQLKeywordToken" && InArray((*t).Value(), []string{"LOGS", "CACHE", "DES_KEY_FILE", "HOSTS", "PRIVILEGES", "OPTIMIZER_COSTS", "STATUS", "USER_RESOURCES", "TABLES", "TABLE"}) {
+				s.OptionList = append(s.OptionList, prefix+(*t).Value())
+				prefix = ""
+			} else if (*t).Type() == "MySQLIdentifierComponent" {
+				s.Channel = strings.Trim((*t).Value(), "`")
+			} else if (*t).Type() == "MySQLStringToken" {
+				s.Channel = unquoteString((*t).Value())
+			} else if (*t).Type() == "MySQLTableNameListComponent" {
+				for _, tableName := range (*t).(*MySQLTableNameListComponent).TableList {
+					s.DatabaseList = append(s.DatabaseList, tableName.Database)
+					s.TableList = append(s.TableList, tableName.Table)
+				}
+			} else if (*t).Type() == "MySQLKeywordToken" && (*t).Value() == "LOCK" {
+				s.WithReadLock = true
+			} else if (*t).Type() == "MySQLKeywordToken" && (*t).Value() == "EXPORT" {
+				s.ForExport = true
+			}
+		}
+		tokenList.Reset(endPos)
+		return s, tokenList
+	}
+}
+
+// 13.2.4 HANDLER Syntax
+// HANDLER tbl_name OPEN [ [AS] alias]
+//
+// HANDLER tbl_name READ index_name { = | <= | >= | < | > } (value1,value2,...)
+//     [ WHERE where_condition ] [LIMIT ... ]
+// HANDLER tbl_name READ index_name { FIRST | NEXT | PREV | LAST }
+//     [ WHERE where_condition ] [LIMIT ... ]
+// HANDLER tbl_name READ { FIRST | NEXT }
+//     [ WHERE where_condition ] [LIMIT ... ]
+//
+// HANDLER tbl_name CLOSE
+
+type HandlerStatement struct {
+	*MySQLBaseStatement
+	Database  string
+	Table     string
+	Action    string
+	Alias     string
+	Index     string
+	Operator  string
+	ValueList []*MySQLExpressionComponent
+	Position  string
+	Where     *MySQLExpressionComponent
+	Limit     string
+	Offset    string
+}
+
+func (s *HandlerStatement) Type() string {
+	return "HandlerStatement"
+}
+
+func (s *HandlerStatement) GetFsmMap() []FsmMap {
+	return []FsmMap{
+		{
+			StartStatus:  []int{0},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "HANDLER",
+			EndStatus:    1,
+		},
+		{
+			StartStatus:  []int{1},
+			AcceptObject: "MySQLTableNameComponent",
+			AcceptValue:  "",
+			EndStatus:    2,
+		},
+		{
+			StartStatus:  []int{2},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "OPEN",
+			EndStatus:    3,
+		},
+		{
+			StartStatus:  []int{3},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "AS",
+			EndStatus:    4,
+		},
+		{
+			StartStatus:  []int{3, 4},
+			AcceptObject: "MySQLIdentifierComponent",
+			AcceptValue:  "",
+			EndStatus:    FinalStatus,
+		},
+		{
+			StartStatus:  []int{2},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "CLOSE",
+			EndStatus:    FinalStatus,
+		},
+		{
+			StartStatus:  []int{2},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "READ",
+			EndStatus:    5,
+		},
+		{
+			StartStatus:  []int{5, 6},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "FIRST",
+			EndStatus:    7,
+		},
+		{
+			StartStatus:  []int{5, 6},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "NEXT",
+			EndStatus:    7,
+		},
+		{
+			StartStatus:  []int{5, 6},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "PREV",
+			EndStatus:    7,
+		},
+		{
+			StartStatus:  []int{5, 6},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "LAST",
+			EndStatus:    7,
+		},
+		{
+			StartStatus:  []int{5},
+			AcceptObject: "MySQLIdentifierComponent",
+			AcceptValue:  "",
+			EndStatus:    6,
+		},
+		{
+			StartStatus:  []int{6},
+			AcceptObject: "MySQLOperatorToken",
+			AcceptValue:  "=",
+			EndStatus:    8,
+		},
+		{
+			StartStatus:  []int{6},
+			AcceptObject: "MySQLOperatorToken",
+			AcceptValue:  "<=",
+			EndStatus:    8,
+		},
+		{
+			StartStatus:  []int{6},
+			AcceptObject: "MySQLOperatorToken",
+			AcceptValue:  ">=",
+			EndStatus:    8,
+		},
+		{
+			StartStatus:  []int{6},
+			AcceptObject: "MySQLOperatorToken",
+			AcceptValue:  "<",
+			EndStatus:    8,
+		},
+		{
+			StartStatus:  []int{6},
+			AcceptObject: "MySQLOperatorToken",
+			AcceptValue:  ">",
+			EndStatus:    8,
+		},
+		{
+			StartStatus:  []int{8},
+			AcceptObject: "MySQLValueListComponent",
+			AcceptValue:  "",
+			EndStatus:    7,
+		},
+		{
+			StartStatus:  []int{7},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "WHERE",
+			EndStatus:    9,
+		},
+		{
+			StartStatus:  []int{9},
+			AcceptObject: "MySQLExpressionComponent",
+			AcceptValue:  "",
+			EndStatus:    10,
+		},
+		{
+			StartStatus:  []int{7, 10},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "LIMIT",
+			EndStatus:    11,
+		},
+		{
+			StartStatus:  []int{11},
+			AcceptObject: "MySQLNumericToken",
+			AcceptValue:  "",
+			EndStatus:    12,
+		},
+		{
+			StartStatus:  []int{11},
+			AcceptObject: "MySQLParamMarkerToken",
+			AcceptValue:  "",
+			EndStatus:    12,
+		},
+		{
+			StartStatus:  []int{12},
+			AcceptObject: "MySQLDelimiterToken",
+			AcceptValue:  ",",
+			EndStatus:    13,
+		},
+		{
+			StartStatus:  []int{13},
+			AcceptObject: "MySQLNumericToken",
+			AcceptValue:  "",
+			EndStatus:    FinalStatus,
+		},
+		{
+			StartStatus:  []int{13},
+			AcceptObject: "MySQLParamMarkerToken",
+			AcceptValue:  "",
+			EndStatus:    FinalStatus,
+		},
+	}
+}
+
+func NewHandlerStatement(tokenList MySQLTokenList,
+	verboseFunc func(message string, level LogLevel)) (MySQLStatement, MySQLTokenList) {
+	startPos := tokenList.CurrentPos()
+	s := &HandlerStatement{
+		MySQLBaseStatement: &MySQLBaseStatement{
+			ObjectList: make([]*MySQLObject, 0),
+		},
+		ValueList: make([]*MySQLExpressionComponent, 0),
+	}
+	endPos := s.ParseByFsm(s.GetFsmMap(), tokenList, []int{3, 7, 10, 12}, verboseFunc)
+	if endPos == -1 {
+		tokenList.Reset(startPos)
+		return nil, tokenList
+	} else {
+		for _, t := range s.ObjectList {
+			if (*t).Type() == "MySQLTableNameComponent" {
+				s.Database = (*t).(*MySQLTableNameComponent).Database
+				s.Table = (*t).(*MySQLTableNameComponent).Table
+			} else if (*t).Type() == "MySQLKeywordToken" && InArray((*t).Value(), []string{"OPEN", "READ", "CLOSE"}) {
+				s.Action = (*t).Value()
+			} else if (*t).Type() == "MySQLKeywordToken" && InArray((*t).Value(), []string{"FIRST", "NEXT", "PREV", "LAST"}) {
+				s.Position = (*t).Value()
+			} else if (*t).Type() == "MySQLIdentifierComponent" && s.Action == "OPEN" {
+				s.Alias = strings.Trim((*t).Value(), "`")
+			} else if (*t).Type() == "MySQLIdentifierComponent" {
+				s.Index = strings.Trim((*t).Value(), "`")
+			} else if (*t).Type() == "MySQLOperatorToken" {
+				s.Operator = (*t).Value()
+			} else if (*t).Type() == "MySQLValueListComponent" {
+				s.ValueList = (*t).(*MySQLValueListComponent).ValueList
+			} else if (*t).Type() == "MySQLExpressionComponent" {
+				s.Where = (*t).(*MySQLExpressionComponent)
+			} else if (*t).Type() == "MySQLNumericToken" || (*t).Type() == "MySQLParamMarkerToken" {
+				if s.Limit != "" {
+					s.Offset = s.Limit
+				}
+				s.Limit = (*t).Value()
+			}
+		}
+		tokenList.Reset(endPos)
+		return s, tokenList
+	}
+}
+
+// 13.2.5 INSERT Syntax
+// INSERT [LOW_PRIORITY | DELAYED | HIGH_PRIORITY] [IGNORE]
+//    [INTO] tbl_name
+//    [PARTITION (partition_name [, partition_name] ...)]
+//    [(col_name [, col_name] ...)]
+//    {VALUES | VALUE} (value_list) [, (value_list)] ...
+//    [AS row_alias[(col_alias [, col_alias] ...)]]
+//    [ON DUPLICATE KEY UPDATE assignment_list]
+//
+// INSERT [LOW_PRIORITY | DELAYED | HIGH_PRIORITY] [IGNORE]
+//    [INTO] tbl_name
+//    [PARTITION (partition_name [, partition_name] ...)]
+//    SET assignment_list
+//    [AS row_alias[(col_alias [, col_alias] ...)]]
+//    [ON DUPLICATE KEY UPDATE assignment_list]
+//
+// INSERT [LOW_PRIORITY | HIGH_PRIORITY] [IGNORE]
+//    [INTO] tbl_name
+//    [PARTITION (partition_name [, partition_name] ...)]
+//    [(col_name [, col_name] ...)]
+//    { SELECT ...
+//      | TABLE table_name
+//      | VALUES row_constructor_list
+//    }
+//    [ON DUPLICATE KEY UPDATE assignment_list]
+//
+// value:
+//    {expr | DEFAULT}
+//
+// value_list:
+//    value [, value] ...
+//
+
+type InsertStatement struct {
+	*MySQLBaseStatement
+	DatabaseList        []string
+	TableList           []string
+	FromDatabaseList    []string
+	FromTableList       []string
+	Priority            string
+	Ignore              bool
+	PartitionList       []string
+	ColumnList          []*MySQLColumnNameComponent
+	ValuesList          [][]*MySQLExpressionComponent
+	SetList             []*MySQLAssignmentExpressionComponent
+	RowAlias            string
+	ColumnAliasList     []string
+	DuplicateUpdateList []*MySQLAssignmentExpressionComponent
+}
+
+func (s *InsertStatement) Type() string {
+	return "InsertStatement"
+}
+
+func (s *InsertStatement) GetFsmMap() []FsmMap {
+	return []FsmMap{
+		{
+			StartStatus:  []int{0},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "INSERT",
+			EndStatus:    1,
+		},
+		{
+			StartStatus:  []int{1},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "LOW_PRIORITY",
+			EndStatus:    2,
+		},
+		{
+			StartStatus:  []int{1},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "HIGH_PRIORITY",
+			EndStatus:    2,
+		},
+		{
+			StartStatus:  []int{1},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "DELAYED",
+			EndStatus:    2,
+		},
+		{
+			StartStatus:  []int{1, 2},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "IGNORE",
+			EndStatus:    3,
+		},
+		{
+			StartStatus:  []int{1, 2, 3},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "INTO",
+			EndStatus:    4,
+		},
+		{
+			StartStatus:  []int{1, 2, 3, 4},
+			AcceptObject: "MySQLTableNameComponent",
+			AcceptValue:  "",
+			EndStatus:    5,
+		},
+		{
+			StartStatus:  []int{5},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "PARTITION",
+			EndStatus:    20,
+		},
+		{
+			StartStatus:  []int{20},
+			AcceptObject: "MySQLOperatorToken",
+			AcceptValue:  "(",
+			EndStatus:    21,
+		},
+		{
+			StartStatus:  []int{21},
+			AcceptObject: "MySQLIdentifierComponent",
+			AcceptValue:  "",
+			EndStatus:    22,
+		},
+		{
+			StartStatus:  []int{22},
+			AcceptObject: "MySQLDelimiterToken",
+			AcceptValue:  ",",
+			EndStatus:    21,
+		},
+		{
+			StartStatus:  []int{22},
+			AcceptObject: "MySQLOperatorToken",
+			AcceptValue:  ")",
+			EndStatus:    23,
+		},
+		{
+			StartStatus:  []int{5, 23},
+			AcceptObject: "MySQLOperatorToken",
+			AcceptValue:  "(",
+			EndStatus:    6,
+		},
+		{
+			StartStatus:  []int{6},
+			AcceptObject: "MySQLColumnNameListComponent",
+			AcceptValue:  "",
+			EndStatus:    7,
+		},
+		{
+			StartStatus:  []int{6, 7},
+			AcceptObject: "MySQLOperatorToken",
+			AcceptValue:  ")",
+			EndStatus:    8,
+		},
+		{
+			StartStatus:  []int{5, 8, 23},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "VALUES",
+			EndStatus:    9,
+		},
+		{
+			StartStatus:  []int{5, 8, 23},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "VALUE",
+			EndStatus:    9,
+		},
+		{
+			StartStatus:  []int{9},
+			AcceptObject: "MySQLValueListComponent",
+			AcceptValue:  "",
+			EndStatus:    12,
+		},
+		{
+			StartStatus:  []int{9},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "ROW",
+			EndStatus:    29,
+		},
+		{
+			StartStatus:  []int{29},
+			AcceptObject: "MySQLValueListComponent",
+			AcceptValue:  "",
+			EndStatus:    12,
+		},
+		{
+			StartStatus:  []int{12},
+			AcceptObject: "MySQLDelimiterToken",
+			AcceptValue:  ",",
+			EndStatus:    9,
+		},
+		{
+			StartStatus:  []int{5, 23},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "SET",
+			EndStatus:    13,
+		},
+		{
+			StartStatus:  []int{13},
+			AcceptObject: "MySQLAssignmentListExpressionComponent",
+			AcceptValue:  "",
+			EndStatus:    14,
+		},
+		{
+			StartStatus:  []int{5, 8, 23},
+			AcceptObject: "UnionStatement",
+			AcceptValue:  "",
+			EndStatus:    15,
+		},
+		{
+			StartStatus:  []int{5, 8, 23},
+			AcceptObject: "SelectStatement",
+			AcceptValue:  "",
+			EndStatus:    15,
+		},
+		{
+			StartStatus:  []int{5, 8, 23},
+			AcceptObject: "TableStatement",
+			AcceptValue:  "",
+			EndStatus:    15,
+		},
+		{
+			StartStatus:  []int{12, 14},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "AS",
+			EndStatus:    24,
+		},
+		{
+			StartStatus:  []int{24},
+			AcceptObject: "MySQLIdentifierComponent",
+			AcceptValue:  "",
+			EndStatus:    25,
+		},
+		{
+			StartStatus:  []int{25},
+			AcceptObject: "MySQLOperatorToken",
+			AcceptValue:  "(",
+			EndStatus:    26,
+		},
+		{
+			StartStatus:  []int{26},
+			AcceptObject: "MySQLColumnNameListComponent",
+			AcceptValue:  "",
+			EndStatus:    27,
+		},
+		{
+			StartStatus:  []int{27},
+			AcceptObject: "MySQLOperatorToken",
+			AcceptValue:  ")",
+			EndStatus:    28,
+		},
+		{
+			StartStatus:  []int{12, 14, 15, 25, 28},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "ON",
+			EndStatus:    16,
+		},
+		{
+			StartStatus:  []int{16},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "DUPLICATE",
+			EndStatus:    17,
+		},
+		{
+			StartStatus:  []int{17},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "KEY",
+			EndStatus:    18,
+		},
+		{
+			StartStatus:  []int{18},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "UPDATE",
+			EndStatus:    19,
+		},
+		{
+			StartStatus:  []int{19},
+			AcceptObject: "MySQLAssignmentListExpressionComponent",
+			AcceptValue:  "",
+			EndStatus:    FinalStatus,
+		},
+	}
+}
+
+func NewInsertStatement(tokenList MySQLTokenList,
+	verboseFunc func(message string, level LogLevel)) (MySQLStatement, MySQLTokenList) {
+	startPos := tokenList.CurrentPos()
+	s := &InsertStatement{
+		MySQLBaseStatement: &MySQLBaseStatement{
+			ObjectList: make([]*MySQLObject, 0),
+		},
+		DatabaseList:        make([]string, 0),
+		TableList:           make([]string, 0),
+		FromDatabaseList:    make([]string, 0),
+		FromTableList:       make([]string, 0),
+		PartitionList:       make([]string, 0),
+		ColumnList:          make([]*MySQLColumnNameComponent, 0),
+		ValuesList:          make([][]*MySQLExpressionComponent, 0),
+		SetList:             make([]*MySQLAssignmentExpressionComponent, 0),
+		ColumnAliasList:     make([]string, 0),
+		DuplicateUpdateList: make([]*MySQLAssignmentExpressionComponent, 0),
+	}
+	endPos := s.ParseByFsm(s.GetFsmMap(), tokenList, []int{12, 14, 15, 25, 28}, verboseFunc)
+	if endPos == -1 {
+		tokenList.Reset(startPos)
+		return nil, tokenList
+	} else {
+		lastKeyword := ""
+		for _, t := range s.ObjectList {
+			if (*t).Type() == "MySQLKeywordToken" {
+				lastKeyword = (*t).Value()
+				if InArray(lastKeyword, []string{"LOW_PRIORITY", "DELAYED", "HIGH_PRIORITY"}) {
+					s.Priority = lastKeyword
+				} else if lastKeyword == "IGNORE" {
+					s.Ignore = true
+				}
+			} else if (*t).Type() == "MySQLTableNameComponent" {
+				s.DatabaseList = append(s.DatabaseList, (*t).(*MySQLTableNameComponent).Database)
+				s.TableList = append(s.TableList, (*t).(*MySQLTableNameComponent).Table)
+			} else if (*t).Type() == "MySQLIdentifierComponent" {
+				if lastKeyword == "PARTITION" {
+					s.PartitionList = append(s.PartitionList, strings.Trim((*t).Value(), "`"))
+				} else if lastKeyword == "AS" {
+					s.RowAlias = strings.Trim((*t).Value(), "`")
+				}
+			} else if (*t).Type() == "MySQLColumnNameListComponent" {
+				if lastKeyword == "AS" {
+					for _, column := range (*t).(*MySQLColumnNameListComponent).ColumnList {
+						s.ColumnAliasList = append(s.ColumnAliasList, column.Column)
+					}
+				} else {
+					s.ColumnList = (*t).(*MySQLColumnNameListComponent).ColumnList
+				}
+			} else if (*t).Type() == "MySQLValueListComponent" {
+				valueList := (*t).(*MySQLValueListComponent).ValueList
+				s.ValuesList = append(s.ValuesList, valueList)
+				for _, value := range valueList {
+					databaseList, tableList := getSubQueryTableList(value)
+					s.FromDatabaseList = append(s.FromDatabaseList, databaseList...)
+					s.FromTableList = append(s.FromTableList, tableList...)
+				}
+			} else if (*t).Type() == "MySQLAssignmentListExpressionComponent" {
+				for _, assignment := range (*t).(*MySQLAssignmentListExpressionComponent).AssignmentList {
+					if lastKeyword == "UPDATE" {
+						s.DuplicateUpdateList = append(s.DuplicateUpdateList, assignment)
+					} else {
+						s.SetList = append(s.SetList, assignment)
+					}
+					databaseList, tableList := getSubQueryTableList(assignment.Expression)
+					s.FromDatabaseList = append(s.FromDatabaseList, databaseList...)
+					s.FromTableList = append(s.FromTableList, tableList...)
+				}
+			} else if (*t).Type() == "SelectStatement" {
+				s.FromDatabaseList = append(s.FromDatabaseList, (*t).(*SelectStatement).DatabaseList...)
+				s.FromTableList = append(s.FromTableList, (*t).(*SelectStatement).TableList...)
+			} else if (*t).Type() == "UnionStatement" {
+				s.FromDatabaseList = append(s.FromDatabaseList, (*t).(*UnionStatement).DatabaseList...)
+				s.FromTableList = append(s.FromTableList, (*t).(*UnionStatement).TableList...)
+			} else if (*t).Type() == "TableStatement" {
+				s.FromDatabaseList = append(s.FromDatabaseList, (*t).(*TableStatement).DatabaseList...)
+				s.FromTableList = append(s.FromTableList, (*t).(*TableStatement).TableList...)
+			}
+		}
+		tokenList.Reset(endPos)
+		return s, tokenList
+	}
+}
+
+// 13.7.6.4 KILL Syntax
+// KILL [CONNECTION | QUERY] processlist_id
+
+type KillStatement struct {
+	*MySQLBaseStatement
+	Query     bool
+	ProcessId string
+}
+
+func (s *KillStatement) Type() string {
+	return "KillStatement"
+}
+
+func (s *KillStatement) GetFsmMap() []FsmMap {
+	return []FsmMap{
+		{
+			StartStatus:  []int{0},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "KILL",
+			EndStatus:    1,
+		},
+		{
+			StartStatus:  []int{1},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "CONNECTION",
+			EndStatus:    2,
+		},
+		{
+			StartStatus:  []int{1},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "QUERY",
+			EndStatus:    2,
+		},
+		{
+			StartStatus:  []int{1, 2},
+			AcceptObject: "MySQLExpressionComponent",
+			AcceptValue:  "",
+			EndStatus:    FinalStatus,
+		},
+	}
+}
+
+func NewKillStatement(tokenList MySQLTokenList,
+	verboseFunc func(message string, level LogLevel)) (MySQLStatement, MySQLTokenList) {
+	startPos := tokenList.CurrentPos()
+	s := &KillStatement{
+		MySQLBaseStatement: &MySQLBaseStatement{
+			ObjectList: make([]*MySQLObject, 0),
+		},
+	}
+	endPos := s.ParseByFsm(s.GetFsmMap(), tokenList, []int{}, verboseFunc)
+	if endPos == -1 {
+		tokenList.Reset(startPos)
+		return nil, tokenList
+	} else {
+		for _, t := range s.ObjectList {
+			if (*t).Type() == "MySQLKeywordToken" && (*t).Value() == "QUERY" {
+				s.Query = true
+			} else if (*t).Type() == "MySQLExpressionComponent" {
+				s.ProcessId = strings.TrimSpace((*t).Value())
+			}
+		}
+		tokenList.Reset(endPos)
+		return s, tokenList
+	}
+}
+
+// 13.3.6 LOCK TABLES and UNLOCK TABLES Syntax
+// LOCK {TABLE | TABLES}
+//     tbl_name [[AS] alias] lock_type
+//     [, tbl_name [[AS] alias] lock_type] ...
+//
+// lock_type: {
+//     READ [LOCAL]
+//   | [LOW_PRIORITY] WRITE
+// }
+//
+// UNLOCK {TABLE | TABLES}
+
+type LockTablesStatement struct {
+	*MySQLBaseStatement
+	Unlock       bool
+	DatabaseList []string
+	TableList    []string
+	AliasList    []string
+	LockTypeList []string
+}
+
+func (s *LockTablesStatement) Type() string {
+	return "LockTablesStatement"
+}
+
+func (s *LockTablesStatement) GetFsmMap() []FsmMap {
+	return []FsmMap{
+		{
+			StartStatus:  []int{0},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "LOCK",
+			EndStatus:    1,
+		},
+		{
+			StartStatus:  []int{1},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "TABLE",
+			EndStatus:    2,
+		},
+		{
+			StartStatus:  []int{1},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "TABLES",
+			EndStatus:    2,
+		},
+		{
+			StartStatus:  []int{2, 8},
+			AcceptObject: "MySQLTableNameComponent",
+			AcceptValue:  "",
+			EndStatus:    3,
+		},
+		{
+			StartStatus:  []int{3},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "AS",
+			EndStatus:    4,
+		},
+		{
+			StartStatus:  []int{3, 4},
+			AcceptObject: "MySQLIdentifierComponent",
+			AcceptValue:  "",
+			EndStatus:    5,
+		},
+		{
+			StartStatus:  []int{3, 5},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "READ",
+			EndStatus:    6,
+		},
+		{
+			StartStatus:  []int{6},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "LOCAL",
+			EndStatus:    7,
+		},
+		{
+			StartStatus:  []int{3, 5},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "LOW_PRIORITY",
+			EndStatus:    9,
+		},
+		{
+			StartStatus:  []int{3, 5, 9},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "WRITE",
+			EndStatus:    7,
+		},
+		{
+			StartStatus:  []int{6, 7},
+			AcceptObject: "MySQLDelimiterToken",
+			AcceptValue:  ",",
+			EndStatus:    8,
+		},
+		{
+			StartStatus:  []int{0},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "UNLOCK",
+			EndStatus:    10,
+		},
+		{
+			StartStatus:  []int{10},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "TABLE",
+			EndStatus:    FinalStatus,
+		},
+		{
+			StartStatus:  []int{10},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "TABLES",
+			EndStatus:    FinalStatus,
+		},
+	}
+}
+
+func NewLockTablesStatement(tokenList MySQLTokenList,
+	verboseFunc func(message string, level LogLevel)) (MySQLStatement, MySQLTokenList) {
+	startPos := tokenList.CurrentPos()
+	s := &LockTablesStatement{
+		MySQLBaseStatement: &MySQLBaseStatement{
+			ObjectList: make([]*MySQLObject, 0),
+		},
+		DatabaseList: make([]string, 0),
+		TableList:    make([]string, 0),
+		AliasList:    make([]string, 0),
+		LockTypeList: make([]string, 0),
+	}
+	endPos := s.ParseByFsm(s.GetFsmMap(), tokenList, []int{6, 7}, verboseFunc)
+	if endPos == -1 {
+		tokenList.Reset(startPos)
+		return nil, tokenList
+	} else {
+		for _, t := range s.ObjectList {
+			if (*t).Type() == "MySQLTableNameComponent" {
+				s.DatabaseList = append(s.DatabaseList, (*t).(*MySQLTableNameComponent).Database)
+				s.TableList = append(s.TableList, (*t).(*MySQLTableNameComponent).Table)
+				s.AliasList = append(s.AliasList, "")
+				s.LockTypeList = append(s.LockTypeList, "")
+			} else if (*t).Type() == "MySQLIdentifierComponent" {
+				s.AliasList[len(s.AliasList)-1] = strings.Trim((*t).Value(), "`")
+			} else if (*t).Type() == "MySQLKeywordToken" {
+				switch (*t).Value() {
+				case "UNLOCK":
+					s.Unlock = true
+				case "READ", "LOW_PRIORITY", "WRITE", "LOCAL":
+					lockType := s.LockTypeList[len(s.LockTypeList)-1]
+					s.LockTypeList[len(s.LockTypeList)-1] = strings.TrimSpace(lockType + " " + (*t).Value())
+				}
+			}
+		}
+		tokenList.Reset(endPos)
+		return s, tokenList
+	}
+}
+
+// 13.7.2.4 OPTIMIZE TABLE Syntax
+// OPTIMIZE [NO_WRITE_TO_BINLOG | LOCAL]
+//    TABLE tbl_name [, tbl_name] ...
+
+type OptimizeTableStatement struct {
+	*MySQLBaseStatement
+	DatabaseList    []string
+	TableList       []string
+	NoWriteToBinlog bool
+}
+
+func (s *OptimizeTableStatement) Type() string {
+	return "OptimizeTableStatement"
+}
+
+func (s *OptimizeTableStatement) GetFsmMap() []FsmMap {
+	return []FsmMap{
+		{
+			StartStatus:  []int{0},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "OPTIMIZE",
+			EndStatus:    1,
+		},
+		{
+			StartStatus:  []int{1},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "NO_WRITE_TO_BINLOG",
+			EndStatus:    2,
+		},
+		{
+			StartStatus:  []int{1},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "LOCAL",
+			EndStatus:    2,
+		},
+		{
+			StartStatus:  []int{1, 2},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "TABLE",
+			EndStatus:    3,
+		},
+		{
+			StartStatus:  []int{3},
+			AcceptObject: "MySQLTableNameListComponent",
+			AcceptValue:  "",
+			EndStatus:    FinalStatus,
+		},
+	}
+}
+
+func NewOptimizeTableStatement(tokenList MySQLTokenList,
+	verboseFunc func(message string, level LogLevel)) (MySQLStatement, MySQLTokenList) {
+	startPos := tokenList.CurrentPos()
+	s := &OptimizeTableStatement{
+		MySQLBaseStatement: &MySQLBaseStatement{
+			ObjectList: make([]*MySQLObject, 0),
+		},
+		DatabaseList: make([]string, 0),
+		TableList:    make([]string, 0),
+	}
+	endPos := s.ParseByFsm(s.GetFsmMap(), tokenList, []int{}, verboseFunc)
+	if endPos == -1 {
+		tokenList.Reset(startPos)
+		return nil, tokenList
+	} else {
+		for _, t := range s.ObjectList {
+			if (*t).Type() == "MySQLTableNameListComponent" {
+				for _, tableName := range (*t).(*MySQLTableNameListComponent).TableList {
+					s.DatabaseList = append(s.DatabaseList, tableName.Database)
+					s.TableList = append(s.TableList, tableName.Table)
+				}
+			} else if (*t).Type() == "MySQLKeywordToken" && InArray((*t).Value(), []string{"NO_WRITE_TO_BINLOG", "LOCAL"}) {
+				s.NoWriteToBinlog = true
+			}
+		}
+		tokenList.Reset(endPos)
+		return s, tokenList
+	}
+}
+
+// 13.5.1 PREPARE Syntax
+// PREPARE stmt_name FROM preparable_stmt
+//
+// preparable_stmt:
+//    'string_literal'
+//  | @var_name
+
+type PrepareStatement struct {
+	*MySQLBaseStatement
+	Name      string
+	Variable  string
+	SQL       string
+	Statement MySQLStatement // 语句文本解析后的语句，来自变量或解析失败时为nil
+	Error     error          // 语句文本解析失败或包含多条语句时的错误
+}
+
+func (s *PrepareStatement) Type() string {
+	return "PrepareStatement"
+}
+
+func (s *PrepareStatement) GetFsmMap() []FsmMap {
+	return []FsmMap{
+		{
+			StartStatus:  []int{0},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "PREPARE",
+			EndStatus:    1,
+		},
+		{
+			StartStatus:  []int{1},
+			AcceptObject: "MySQLIdentifierComponent",
+			AcceptValue:  "",
+			EndStatus:    2,
+		},
+		{
+			StartStatus:  []int{2},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "FROM",
+			EndStatus:    3,
+		},
+		{
+			StartStatus:  []int{3},
+			AcceptObject: "MySQLStringToken",
+			AcceptValue:  "",
+			EndStatus:    FinalStatus,
+		},
+		{
+			StartStatus:  []int{3},
+			AcceptObject: "MySQLVariableToken",
+			AcceptValue:  "",
+			EndStatus:    FinalStatus,
+		},
+	}
+}
+
+func NewPrepareStatement(tokenList MySQLTokenList,
+	verboseFunc func(message string, level LogLevel)) (MySQLStatement, MySQLTokenList) {
+	startPos := tokenList.CurrentPos()
+	s := &PrepareStatement{
+		MySQLBaseStatement: &MySQLBaseStatement{
+			ObjectList: make([]*MySQLObject, 0),
+		},
+	}
+	endPos := s.ParseByFsm(s.GetFsmMap(), tokenList, []int{}, verboseFunc)
+	if endPos == -1 {
+		tokenList.Reset(startPos)
+		return nil, tokenList
+	} else {
+		for _, t := range s.ObjectList {
+			if (*t).Type() == "MySQLIdentifierComponent" {
+				s.Name = strings.Trim((*t).Value(), "`")
+			} else if (*t).Type() == "MySQLVariableToken" {
+				s.Variable = (*t).Value()
+			} else if (*t).Type() == "MySQLStringToken" {
+				s.SQL = unquoteString((*t).Value())
+				statementList, err := Parse(s.SQL)
+				if err != nil {
+					s.Error = err
+				} else if len(statementList) != 1 {
+					s.Error = errors.New(fmt.Sprintf("Prepared statement contains %d statements", len(statementList)))
+				} else {
+					s.Statement = statementList[0]
+				}
+			}
+		}
+		tokenList.Reset(endPos)
+		return s, tokenList
+	}
+}
+
+// 13.4.1.1 PURGE BINARY LOGS Syntax
+// PURGE { BINARY | MASTER } LOGS {
+//    TO 'log_name'
+//  | BEFORE datetime_expr
+// }
+
+type PurgeBinaryLogsStatement struct {
+	*MySQLBaseStatement
+	LogName string
+	Before  string
+}
+
+func (s *PurgeBinaryLogsStatement) Type() string {
+	return "PurgeBinaryLogsStatement"
+}
+
+func (s *PurgeBinaryLogsStatement) GetFsmMap() []FsmMap {
+	return []FsmMap{
+		{
+			StartStatus:  []int{0},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "PURGE",
+			EndStatus:    1,
+		},
+		{
+			StartStatus:  []int{1},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "BINARY",
+			EndStatus:    2,
+		},
+		{
+			StartStatus:  []int{1},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "MASTER",
+			EndStatus:    2,
+		},
+		{
+			StartStatus:  []int{2},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "LOGS",
+			EndStatus:    3,
+		},
+		{
+			StartStatus:  []int{3},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "TO",
+			EndStatus:    4,
+		},
+		{
+			StartStatus:  []int{4},
+			AcceptObject: "MySQLStringToken",
+			AcceptValue:  "",
+			EndStatus:    FinalStatus,
+		},
+		{
+			StartStatus:  []int{3},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "BEFORE",
+			EndStatus:    5,
+		},
+		{
+			StartStatus:  []int{5},
+			AcceptObject: "MySQLExpressionComponent",
+			AcceptValue:  "",
+			EndStatus:    FinalStatus,
+		},
+	}
+}
+
+func NewPurgeBinaryLogsStatement(tokenList MySQLTokenList,
+	verboseFunc func(message string, level LogLevel)) (MySQLStatement, MySQLTokenList) {
+	startPos := tokenList.CurrentPos()
+	s := &PurgeBinaryLogsStatement{
+		MySQLBaseStatement: &MySQLBaseStatement{
+			ObjectList: make([]*MySQLObject, 0),
+		},
+	}
+	endPos := s.ParseByFsm(s.GetFsmMap(), tokenList, []int{}, verboseFunc)
+	if endPos == -1 {
+		tokenList.Reset(startPos)
+		return nil, tokenList
+	} else {
+		for _, t := range s.ObjectList {
+			if (*t).Type() == "MySQLStringToken" {
+				s.LogName = unquoteString((*t).Value())
+			} else if (*t).Type() == "MySQLExpressionComponent" {
+				s.Before = strings.TrimSpace((*t).Value())
+			}
+		}
+		tokenList.Reset(endPos)
+		return s, tokenList
+	}
+}
+
+// 13.1.32 RENAME TABLE Syntax
+// RENAME TABLE
+//   tbl_name TO new_tbl_name
+//   [, tbl_name2 TO new_tbl_name2] ...
+
+type RenameTableStatement struct {
+	*MySQLBaseStatement
+	DatabaseList     []string
+	TableList        []string
+	FromDatabaseList []string
+	FromTableList    []string
+}
+
+func (s *RenameTableStatement) Type() string {
+	return "RenameTableStatement"
+}
+
+func (s *RenameTableStatement) GetFsmMap() []FsmMap {
+	return []FsmMap{
+		{
+			StartStatus:  []int{0},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "RENAME",
+			EndStatus:    1,
+		},
+		{
+			StartStatus:  []int{1},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "TABLE",
+			EndStatus:    2,
+		},
+		{
+			StartStatus:  []int{2},
+			AcceptObject: "MySQLTableNameComponent",
+			AcceptValue:  "",
+			EndStatus:    3,
+		},
+		{
+			StartStatus:  []int{3},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "TO",
+			EndStatus:    4,
+		},
+		{
+			StartStatus:  []int{4},
+			AcceptObject: "MySQLTableNameComponent",
+			AcceptValue:  "",
+			EndStatus:    5,
+		},
+		{
+			StartStatus:  []int{5},
+			AcceptObject: "MySQLDelimiterToken",
+			AcceptValue:  ",",
+			EndStatus:    2,
+		},
+	}
+}
+
+func NewRenameTableStatement(tokenList MySQLTokenList,
+	verboseFunc func(message string, level LogLevel)) (MySQLStatement, MySQLTokenList) {
+	startPos := tokenList.CurrentPos()
+	s := &RenameTableStatement{
+		MySQLBaseStatement: &MySQLBaseStatement{
+			ObjectList: make([]*MySQLObject, 0),
+		},
+		DatabaseList:     make([]string, 0),
+		TableList:        make([]string, 0),
+		FromDatabaseList: make([]string, 0),
+		FromTableList:    make([]string, 0),
+	}
+	endPos := s.ParseByFsm(s.GetFsmMap(), tokenList, []int{5}, verboseFunc)
+	if endPos == -1 {
+		tokenList.Reset(startPos)
+		return nil, tokenList
+	} else {
+		i := 0
+		for _, t := range s.ObjectList {
+			if (*t).Type() == "MySQLTableNameComponent" {
+				i += 1
+				if i%2 == 1 {
+					s.FromDatabaseList = append(s.FromDatabaseList, (*t).(*MySQLTableNameComponent).Database)
+					s.FromTableList = append(s.FromTableList, (*t).(*MySQLTableNameComponent).Table)
+				} else {
+					s.DatabaseList = append(s.DatabaseList, (*t).(*MySQLTableNameComponent).Database)
+					s.TableList = append(s.TableList, (*t).(*MySQLTableNameComponent).Table)
+				}
+			}
+		}
+		tokenList.Reset(endPos)
+		return s, tokenList
+	}
+}
+
+// 13.7.2.5 REPAIR TABLE Syntax
+// REPAIR [NO_WRITE_TO_BINLOG | LOCAL]
+//    TABLE tbl_name [, tbl_name] ...
+//    [QUICK] [EXTENDED] [USE_FRM]
+
+type RepairTableStatement struct {
+	*MySQLBaseStatement
+	DatabaseList    []string
+	TableList       []string
+	NoWriteToBinlog bool
+	Quick           bool
+	Extended        bool
+	UseFrm          bool
+}
+
+func (s *RepairTableStatement) Type() string {
+	return "RepairTableStatement"
+}
+
+func (s *RepairTableStatement) GetFsmMap() []FsmMap {
+	return []FsmMap{
+		{
+			StartStatus:  []int{0},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "REPAIR",
+			EndStatus:    1,
+		},
+		{
+			StartStatus:  []int{1},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "NO_WRITE_TO_BINLOG",
+			EndStatus:    2,
+		},
+		{
+			StartStatus:  []int{1},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "LOCAL",
+			EndStatus:    2,
+		},
+		{
+			StartStatus:  []int{1, 2},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "TABLE",
+			EndStatus:    3,
+		},
+		{
+			StartStatus:  []int{3},
+			AcceptObject: "MySQLTableNameListComponent",
+			AcceptValue:  "",
+			EndStatus:    4,
+		},
+		{
+			StartStatus:  []int{4},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "QUICK",
+			EndStatus:    5,
+		},
+		{
+			StartStatus:  []int{4, 5},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "EXTENDED",
+			EndStatus:    6,
+		},
+		{
+			StartStatus:  []int{4, 5, 6},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "USE_FRM",
+			EndStatus:    FinalStatus,
+		},
+	}
+}
+
+func NewRepairTableStatement(tokenList MySQLTokenList,
+	verboseFunc func(message string, level LogLevel)) (MySQLStatement, MySQLTokenList) {
+	startPos := tokenList.CurrentPos()
+	s := &RepairTableStatement{
+		MySQLBaseStatement: &MySQLBaseStatement{
+			ObjectList: make([]*MySQLObject, 0),
+		},
+		DatabaseList: make([]string, 0),
+		TableList:    make([]string, 0),
+	}
+	endPos := s.ParseByFsm(s.GetFsmMap(), tokenList, []int{4, 5, 6}, verboseFunc)
+	if endPos == -1 {
+		tokenList.Reset(startPos)
+		return nil, tokenList
+	} else {
+		for _, t := range s.ObjectList {
+			if (*t).Type() == "MySQLTableNameListComponent" {
+				for _, tableName := range (*t).(*MySQLTableNameListComponent).TableList {
+					s.DatabaseList = append(s.DatabaseList, tableName.Database)
+					s.TableList = append(s.TableList, tableName.Table)
+				}
+			} else if (*t).Type() == "MySQLKeywordToken" && InArray((*t).Value(), []string{"NO_WRITE_TO_BINLOG", "LOCAL"}) {
+				s.NoWriteToBinlog = true
+			} else if (*t).Type() == "MySQLKeywordToken" && (*t).Value() == "QUICK" {
+				s.Quick = true
+			} else if (*t).Type() == "MySQLKeywordToken" && (*t).Value() == "EXTENDED" {
+				s.Extended = true
+			} else if (*t).Type() == "MySQLKeywordToken" && (*t).Value() == "USE_FRM" {
+				s.UseFrm = true
+			}
+		}
+		tokenList.Reset(endPos)
+		return s, tokenList
+	}
+}
+
+// 13.2.8 REPLACE Syntax
+// REPLACE [LOW_PRIORITY | DELAYED] [IGNORE]
+//    [INTO] tbl_name
+//    [PARTITION (partition_name [, partition_name] ...)]
+//    [(col_name [, col_name] ...)]
+//    {VALUES | VALUE} (value_list) [, (value_list)] ...
+//
+// REPLACE [LOW_PRIORITY | DELAYED] [IGNORE]
+//    [INTO] tbl_name
+//    [PARTITION (partition_name [, partition_name] ...)]
+//    SET assignment_list
+//
+// REPLACE [LOW_PRIORITY | DELAYED] [IGNORE]
+//    [INTO] tbl_name
+//    [PARTITION (partition_name [, partition_name] ...)]
+//    [(col_name [, col_name] ...)]
+//    SELECT ...
+//
+// value:
+//    {expr | DEFAULT}
+//
+// value_list:
+//    value [, value] ...
+//
+
+type ReplaceStatement struct {
+	*MySQLBaseStatement
+	DatabaseList     []string
+	TableList        []string
+	FromDatabaseList []string
+	FromTableList    []string
+	Priority         string
+	Ignore           bool
+	PartitionList    []string
+	ColumnList       []*MySQLColumnNameComponent
+	ValuesList       [][]*MySQLExpressionComponent
+	SetList          []*MySQLAssignmentExpressionComponent
+}
+
+func (s *ReplaceStatement) Type() string {
+	return "ReplaceStatement"
+}
+
+func (s *ReplaceStatement) GetFsmMap() []FsmMap {
+	return []FsmMap{
+		{
+			StartStatus:  []int{0},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "REPLACE",
+			EndStatus:    1,
+		},
+		{
+			StartStatus:  []int{1},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "LOW_PRIORITY",
+			EndStatus:    2,
+		},
+		{
+			StartStatus:  []int{1},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "DELAYED",
+			EndStatus:    2,
+		},
+		{
+			StartStatus:  []int{1, 2},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "IGNORE",
+			EndStatus:    3,
+		},
+		{
+			StartStatus:  []int{1, 2, 3},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "INTO",
+			EndStatus:    4,
+		},
+		{
+			StartStatus:  []int{1, 2, 3, 4},
+			AcceptObject: "MySQLTableNameComponent",
+			AcceptValue:  "",
+			EndStatus:    5,
+		},
+		{
+			StartStatus:  []int{5},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "PARTITION",
+			EndStatus:    20,
+		},
+		{
+			StartStatus:  []int{20},
+			AcceptObject: "MySQLOperatorToken",
+			AcceptValue:  "(",
+			EndStatus:    21,
+		},
+		{
+			StartStatus:  []int{21},
+			AcceptObject: "MySQLIdentifierComponent",
+			AcceptValue:  "",
+			EndStatus:    22,
+		},
+		{
+			StartStatus:  []int{22},
+			AcceptObject: "MySQLDelimiterToken",
+			AcceptValue:  ",",
+			EndStatus:    21,
+		},
+		{
+			StartStatus:  []int{22},
+			AcceptObject: "MySQLOperatorToken",
+			AcceptValue:  ")",
+			EndStatus:    23,
+		},
+		{
+			StartStatus:  []int{5, 23},
+			AcceptObject: "MySQLOperatorToken",
+			AcceptValue:  "(",
+			EndStatus:    6,
+		},
+		{
+			StartStatus:  []int{6},
+			AcceptObject: "MySQLColumnNameListComponent",
+			AcceptValue:  "",
+			EndStatus:    7,
+		},
+		{
+			StartStatus:  []int{6, 7},
+			AcceptObject: "MySQLOperatorToken",
+			AcceptValue:  ")",
+			EndStatus:    8,
+		},
+		{
+			StartStatus:  []int{5, 8, 23},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "VALUES",
+			EndStatus:    9,
+		},
+		{
+			StartStatus:  []int{5, 8, 23},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "VALUE",
+			EndStatus:    9,
+		},
+		{
+			StartStatus:  []int{9},
+			AcceptObject: "MySQLValueListComponent",
+			AcceptValue:  "",
+			EndStatus:    12,
+		},
+		{
+			StartStatus:  []int{12},
+			AcceptObject: "MySQLDelimiterToken",
+			AcceptValue:  ",",
+			EndStatus:    9,
+		},
+		{
+			StartStatus:  []int{5, 23},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "SET",
+			EndStatus:    13,
+		},
+		{
+			StartStatus:  []int{13},
+			AcceptObject: "MySQLAssignmentListExpressionComponent",
+			AcceptValue:  "",
+			EndStatus:    FinalStatus,
+		},
+		{
+			StartStatus:  []int{5, 8, 23},
+			AcceptObject: "UnionStatement",
+			AcceptValue:  "",
+			EndStatus:    FinalStatus,
+		},
+		{
+			StartStatus:  []int{5, 8, 23},
+			AcceptObject: "SelectStatement",
+			AcceptValue:  "",
+			EndStatus:    FinalStatus,
+		},
+	}
+}
+
+func NewReplaceStatement(tokenList MySQLTokenList,
+	verboseFunc func(message string, level LogLevel)) (MySQLStatement, MySQLTokenList) {
+	startPos := tokenList.CurrentPos()
+	s := &ReplaceStatement{
+		MySQLBaseStatement: &MySQLBaseStatement{
+			ObjectList: make([]*MySQLObject, 0),
+		},
+		DatabaseList:     make([]string, 0),
+		TableList:        make([]string, 0),
+		FromDatabaseList: make([]string, 0),
+		FromTableList:    make([]string, 0),
+		PartitionList:    make([]string, 0),
+		ColumnList:       make([]*MySQLColumnNameComponent, 0),
+		ValuesList:       make([][]*MySQLExpressionComponent, 0),
+		SetList:          make([]*MySQLAssignmentExpressionComponent, 0),
+	}
+	endPos := s.ParseByFsm(s.GetFsmMap(), tokenList, []int{12}, verboseFunc)
+	if endPos == -1 {
+		tokenList.Reset(startPos)
+		return nil, tokenList
+	} else {
+		lastKeyword := ""
+		for _, t := range s.ObjectList {
+			if (*t).Type() == "MySQLKeywordToken" {
+				lastKeyword = (*t).Value()
+				if InArray(lastKeyword, []string{"LOW_PRIORITY", "DELAYED"}) {
+					s.Priority = lastKeyword
+				} else if lastKeyword == "IGNORE" {
+					s.Ignore = true
+				}
+			} else if (*t).Type() == "MySQLTableNameComponent" {
+				s.DatabaseList = append(s.DatabaseList, (*t).(*MySQLTableNameComponent).Database)
+				s.TableList = append(s.TableList, (*t).(*MySQLTableNameComponent).Table)
+			} else if (*t).Type() == "MySQLIdentifierComponent" {
+				if lastKeyword == "PARTITION" {
+					s.PartitionList = append(s.PartitionList, strings.Trim((*t).Value(), "`"))
+				}
+			} else if (*t).Type() == "MySQLColumnNameListComponent" {
+				s.ColumnList = (*t).(*MySQLColumnNameListComponent).ColumnList
+			} else if (*t).Type() == "MySQLValueListComponent" {
+				valueList := (*t).(*MySQLValueListComponent).ValueList
+				s.ValuesList = append(s.ValuesList, valueList)
+				for _, value := range valueList {
+					databaseList, tableList := getSubQueryTableList(value)
+					s.FromDatabaseList = append(s.FromDatabaseList, databaseList...)
+					s.FromTableList = append(s.FromTableList, tableList...)
+				}
+			} else if (*t).Type() == "MySQLAssignmentListExpressionComponent" {
+				for _, assignment := range (*t).(*MySQLAssignmentListExpressionComponent).AssignmentList {
+					s.SetList = append(s.SetList, assignment)
+					databaseList, tableList := getSubQueryTableList(assignment.Expression)
+					s.FromDatabaseList = append(s.FromDatabaseList, databaseList...)
+					s.FromTableList = append(s.FromTableList, tableList...)
+				}
+			} else if (*t).Type() == "SelectStatement" {
+				s.FromDatabaseList = append(s.FromDatabaseList, (*t).(*SelectStatement).DatabaseList...)
+				s.FromTableList = append(s.FromTableList, (*t).(*SelectStatement).TableList...)
+			} else if (*t).Type() == "UnionStatement" {
+				s.FromDatabaseList = append(s.FromDatabaseList, (*t).(*UnionStatement).DatabaseList...)
+				s.FromTableList = append(s.FromTableList, (*t).(*UnionStatement).TableList...)
+			}
+		}
+		tokenList.Reset(endPos)
+		return s, tokenList
+	}
+}
+
+// 13.7.6.6 RESET Syntax
+// RESET reset_option [, reset_option] ...
+//
+// reset_option: {
+//    MASTER [TO binary_log_file_index_number]
+//  | QUERY CACHE
+// }
+//
+// RESET PERSIST [[IF EXISTS] system_var_name]
+
+type ResetStatement struct {
+	*MySQLBaseStatement
+	OptionList  []string
+	MasterIndex string
+	Persist     bool
+	Variable    string
+}
+
+func (s *ResetStatement) Type() string {
+	return "ResetStatement"
+}
+
+func (s *ResetStatement) GetFsmMap() []FsmMap {
+	return []FsmMap{
+		{
+			StartStatus:  []int{0},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "RESET",
+			EndStatus:    1,
+		},
+		{
+			StartStatus:  []int{1, 3},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "MASTER",
+			EndStatus:    2,
+		},
+		{
+			StartStatus:  []int{2},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "TO",
+			EndStatus:    4,
+		},
+		{
+			StartStatus:  []int{4},
+			AcceptObject: "MySQLNumericToken",
+			AcceptValue:  "",
+			EndStatus:    5,
+		},
+		{
+			StartStatus:  []int{1, 3},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "QUERY",
+			EndStatus:    6,
+		},
+		{
+			StartStatus:  []int{6},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "CACHE",
+			EndStatus:    5,
+		},
+		{
+			StartStatus:  []int{2, 5},
+			AcceptObject: "MySQLDelimiterToken",
+			AcceptValue:  ",",
+			EndStatus:    3,
+		},
+		{
+			StartStatus:  []int{1},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "PERSIST",
+			EndStatus:    7,
+		},
+		{
+			StartStatus:  []int{7},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "IF",
+			EndStatus:    8,
+		},
+		{
+			StartStatus:  []int{8},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "EXISTS",
+			EndStatus:    9,
+		},
+		{
+			StartStatus:  []int{7, 9},
+			AcceptObject: "MySQLIdentifierComponent",
+			AcceptValue:  "",
+			EndStatus:    FinalStatus,
+		},
+	}
+}
+
+func NewResetStatement(tokenList MySQLTokenList,
+	verboseFunc func(message string, level LogLevel)) (MySQLStatement, MySQLTokenList) {
+	startPos := tokenList.CurrentPos()
+	s := &ResetStatement{
+		MySQLBaseStatement: &MySQLBaseStatement{
+			ObjectList: make([]*MySQLObject, 0),
+		},
+		OptionList: make([]string, 0),
+	}
+	endPos := s.ParseByFsm(s.GetFsmMap(), tokenList, []int{2, 5, 7}, verboseFunc)
+	if endPos == -1 {
+		tokenList.Reset(startPos)
+		return nil, tokenList
+	} else {
+		for _, t := range s.ObjectList {
+			if (*t).Type() == "MySQLKeywordToken" && (*t).Value() == "MASTER" {
+				s.OptionList = append(s.OptionList, (*t).Value())
+			} else if (*t).Type() == "MySQLKeywordToken" && (*t).Value() == "CACHE" {
+				s.OptionList = append(s.OptionList, "QUERY CACHE")
+			} else if (*t).Type() == "MySQLNumericToken" {
+				s.MasterIndex = (*t).Value()
+			} else if (*t).Type() == "MySQLKeywordToken" && (*t).Value() == "PERSIST" {
+				s.Persist = true
+			} else if (*t).Type() == "MySQLIdentifierComponent" {
+				s.Variable = strings.Trim((*t).Value(), "`")
+			}
+		}
+		tokenList.Reset(endPos)
+		return s, tokenList
+	}
+}
+
+// 13.4.2.4 RESET SLAVE Syntax
+// RESET {SLAVE | REPLICA} [ALL] [channel_option]
+//
+// channel_option:
+//    FOR CHANNEL channel
+
+type ResetSlaveStatement struct {
+	*MySQLBaseStatement
+	All     bool
+	Channel string
+}
+
+func (s *ResetSlaveStatement) Type() string {
+	return "ResetSlaveStatement"
+}
+
+func (s *ResetSlaveStatement) GetFsmMap() []FsmMap {
+	return []FsmMap{
+		{
+			StartStatus:  []int{0},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "RESET",
+			EndStatus:    1,
+		},
+		{
+			StartStatus:  []int{1},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "SLAVE",
+			EndStatus:    2,
+		},
+		{
+			StartStatus:  []int{1},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "REPLICA",
+			EndStatus:    2,
+		},
+		{
+			StartStatus:  []int{2},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "ALL",
+			EndStatus:    3,
+		},
+		{
+			StartStatus:  []int{2, 3},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "FOR",
+			EndStatus:    4,
+		},
+		{
+			StartStatus:  []int{4},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "CHANNEL",
+			EndStatus:    5,
+		},
+		{
+			StartStatus:  []int{5},
+			AcceptObject: "MySQLIdentifierComponent",
+			AcceptValue:  "",
+			EndStatus:    FinalStatus,
+		},
+		{
+			StartStatus:  []int{5},
+			AcceptObject: "MySQLStringToken",
+			AcceptValue:  "",
+			EndStatus:    FinalStatus,
+		},
+	}
+}
+
+func NewResetSlaveStatement(tokenList MySQLTokenList,
+	verboseFunc func(message string, level LogLevel)) (MySQLStatement, MySQLTokenList) {
+	startPos := tokenList.CurrentPos()
+	s := &ResetSlaveStatement{
+		MySQLBaseStatement: &MySQLBaseStatement{
+			ObjectList: make([]*MySQLObject, 0),
+		},
+	}
+	endPos := s.ParseByFsm(s.GetFsmMap(), tokenList, []int{2, 3}, verboseFunc)
+	if endPos == -1 {
+		tokenList.Reset(startPos)
+		return nil, tokenList
+	} else {
+		for _, t := range s.ObjectList {
+			if (*t).Type() == "MySQLKeywordToken" && (*t).Value() == "ALL" {
+				s.All = true
+			} else if (*t).Type() == "MySQLIdentifierComponent" {
+				s.Channel = strings.Trim((*t).Value(), "`")
+			} else if (*t).Type() == "MySQLStringToken" {
+				s.Channel = unquoteString((*t).Value())
+			}
+		}
+		tokenList.Reset(endPos)
+		return s, tokenList
+	}
+}
+
+// 13.2.9 SELECT Syntax
+// SELECT
+//    [ALL | DISTINCT | DISTINCTROW ]
+//      [HIGH_PRIORITY]
+//      [STRAIGHT_JOIN]
+//      [SQL_SMALL_RESULT] [SQL_BIG_RESULT] [SQL_BUFFER_RESULT]
+//      [SQL_CACHE | SQL_NO_CACHE] [SQL_CALC_FOUND_ROWS]
+//    select_expr [, select_expr ...]
+//    [INTO var_name [, var_name]]
+//    [FROM table_references
+//    [WHERE where_condition]
+//    [GROUP BY {col_name | expr | position}
+//      [ASC | DESC], ... [WITH ROLLUP]]
+//    [HAVING where_condition]
+//    [WINDOW window_name AS (window_spec)
+//      [, window_name AS (window_spec)] ...]
+//    [ORDER BY {col_name | expr | position}
+//      [ASC | DESC], ...]
+//    [LIMIT {[offset,] row_count | row_count OFFSET offset}]
+//    [PROCEDURE procedure_name(argument_list)]
+//    [INTO OUTFILE 'file_name'
+//        [CHARACTER SET charset_name]
+//        export_options
+//      | INTO DUMPFILE 'file_name'
+//      | INTO var_name [, var_name]]
+//    [locking_clause [locking_clause] ...]
+//
+// locking_clause:
+//    FOR {UPDATE | SHARE} [OF tbl_name [, tbl_name] ...] [NOWAIT | SKIP LOCKED]
+//  | LOCK IN SHARE MODE
+
+type SelectStatement struct {
+	*MySQLBaseStatement
+	DatabaseList []string
+	TableList    []string
+	FieldList    []*MySQLExpressionComponent
+	AliasList    []string
+	WindowList   []*MySQLWindowDefinitionComponent
+	LockMode     LockMode
+}
+
+func (s *SelectStatement) Type() string {
+	return "SelectStatement"
+}
+
+func (s *SelectStatement) GetFsmMap() []FsmMap {
+	return []FsmMap{
+		{
+			StartStatus:  []int{0},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "SELECT",
+			EndStatus:    1,
+		},
+		{
+			StartStatus:  []int{1},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "ALL",
+			EndStatus:    2,
+		},
+		{
+			StartStatus:  []int{1},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "DISTINCT",
+			EndStatus:    2,
+		},
+		{
+			StartStatus:  []int{1},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "DISTINCTROW",
+			EndStatus:    2,
+		},
+		{
+			StartStatus:  []int{1, 2},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "HIGH_PRIORITY",
+			EndStatus:    3,
+		},
+		{
+			StartStatus:  []int{1, 2, 3},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "STRAIGHT_JOIN",
+			EndStatus:    4,
+		},
+		{
+			StartStatus:  []int{1, 2, 3, 4},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "SQL_SMALL_RESULT",
+			EndStatus:    5,
+		},
+		{
+			StartStatus:  []int{1, 2, 3, 4, 5},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "SQL_BIG_RESULT",
+			EndStatus:    6,
+		},
+		{
+			StartStatus:  []int{1, 2, 3, 4, 5, 6},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "SQL_BUFFER_RESULT",
+			EndStatus:    7,
+		},
+		{
+			StartStatus:  []int{1, 2, 3, 4, 5, 6, 7},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "SQL_CACHE",
+			EndStatus:    8,
+		},
+		{
+			StartStatus:  []int{1, 2, 3, 4, 5, 6, 7},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "SQL_NO_CACHE",
+			EndStatus:    8,
+		},
+		{
+			StartStatus:  []int{1, 2, 3, 4, 5, 6, 7, 8},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "SQL_CALC_FOUND_ROWS",
+			EndStatus:    9,
+		},
+		{
+			StartStatus:  []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 11},
+			AcceptObject: "MySQLExpressionComponent",
+			AcceptValue:  "",
+			EndStatus:    10,
+		},
+		{
+			StartStatus:  []int{10},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "AS",
+			EndStatus:    51,
+		},
+		{
+			StartStatus:  []int{51},
+			AcceptObject: "MySQLStringToken",
+			AcceptValue:  "",
+			EndStatus:    52,
+		},
+		{
+			StartStatus:  []int{51},
+			AcceptObject: "MySQLIdentifierComponent",
+			AcceptValue:  "",
+			EndStatus:    52,
+		},
+		{
+			StartStatus:  []int{10, 52},
+			AcceptObject: "MySQLDelimiterToken",
+			AcceptValue:  ",",
+			EndStatus:    11,
+		},
+		{
+			StartStatus:  []int{10, 52},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "FROM",
+			EndStatus:    12,
+		},
+		{
+			StartStatus:  []int{12},
+			AcceptObject: "TableReferenceListComponent",
+			AcceptValue:  "",
+			EndStatus:    13,
+		},
+		{
+			StartStatus:  []int{13},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "WHERE",
+			EndStatus:    14,
+		},
+		{
+			StartStatus:  []int{14},
+			AcceptObject: "MySQLExpressionComponent",
+			AcceptValue:  "",
+			EndStatus:    15,
+		},
+		{
+			StartStatus:  []int{13, 15},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "GROUP",
+			EndStatus:    16,
+		},
+		{
+			StartStatus:  []int{16},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "BY",
+			EndStatus:    17,
+		},
+		{
+			StartStatus:  []int{17},
+			AcceptObject: "MySQLExpressionComponent",
+			AcceptValue:  "",
+			EndStatus:    18,
+		},
+		{
+			StartStatus:  []int{17},
+			AcceptObject: "MySQLColumnNameComponent",
+			AcceptValue:  "",
+			EndStatus:    18,
+		},
+		{
+			StartStatus:  []int{17},
+			AcceptObject: "MySQLNumericToken",
+			AcceptValue:  "",
+			EndStatus:    18,
+		},
+		{
+			StartStatus:  []int{18},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "ASC",
+			EndStatus:    19,
+		},
+		{
+			StartStatus:  []int{18},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "DESC",
+			EndStatus:    19,
+		},
+		{
+			StartStatus:  []int{18, 19},
+			AcceptObject: "MySQLDelimiterToken",
+			AcceptValue:  ",",
+			EndStatus:    17,
+		},
+		{
+			StartStatus:  []int{18, 19},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "WITH",
+			EndStatus:    20,
+		},
+		{
+			StartStatus:  []int{20},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "ROLLUP",
+			EndStatus:    21,
+		},
+		{
+			StartStatus:  []int{13, 15, 18, 19, 21},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "HAVING",
+			EndStatus:    22,
+		},
+		{
+			StartStatus:  []int{22},
+			AcceptObject: "MySQLExpressionComponent",
+			AcceptValue:  "",
+			EndStatus:    23,
+		},
+		{
+			StartStatus:  []int{13, 15, 18, 19, 21, 23},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "WINDOW",
+			EndStatus:    54,
+		},
+		{
+			StartStatus:  []int{54},
+			AcceptObject: "MySQLWindowDefinitionComponent",
+			AcceptValue:  "",
+			EndStatus:    55,
+		},
+		{
+			StartStatus:  []int{55},
+			AcceptObject: "MySQLDelimiterToken",
+			AcceptValue:  ",",
+			EndStatus:    54,
+		},
+		{
+			StartStatus:  []int{13, 15, 18, 19, 21, 23, 55},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "ORDER",
+			EndStatus:    24,
+		},
+		{
+			StartStatus:  []int{24},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "BY",
+			EndStatus:    25,
+		},
+		{
+			StartStatus:  []int{25},
+			AcceptObject: "MySQLExpressionComponent",
+			AcceptValue:  "",
+			EndStatus:    26,
+		},
+		{
+			StartStatus:  []int{25},
+			AcceptObject: "MySQLColumnNameComponent",
+			AcceptValue:  "",
+			EndStatus:    26,
+		},
+		{
+			StartStatus:  []int{25},
+			AcceptObject: "MySQLNumericToken",
+			AcceptValue:  "",
+			EndStatus:    26,
+		},
+		{
+			StartStatus:  []int{26},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "ASC",
+			EndStatus:    27,
+		},
+		{
+			StartStatus:  []int{26},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "DESC",
+			EndStatus:    27,
+		},
+		{
+			StartStatus:  []int{26, 27},
+			AcceptObject: "MySQLDelimiterToken",
+			AcceptValue:  ",",
+			EndStatus:    25,
+		},
+		{
+			StartStatus:  []int{13, 15, 18, 19, 21, 23, 55, 26, 27},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "LIMIT",
+			EndStatus:    28,
+		},
+		{
+			StartStatus:  []int{28},
+			AcceptObject: "MySQLNumericToken",
+			AcceptValue:  "",
+			EndStatus:    29,
+		},
+		{
+			StartStatus:  []int{28},
+			AcceptObject: "MySQLParamMarkerToken",
+			AcceptValue:  "",
+			EndStatus:    29,
+		},
+		{
+			StartStatus:  []int{29},
+			AcceptObject: "MySQLDelimiterToken",
+			AcceptValue:  ",",
+			EndStatus:    30,
+		},
+		{
+			StartStatus:  []int{29},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "OFFSET",
+			EndStatus:    30,
+		},
+		{
+			StartStatus:  []int{30},
+			AcceptObject: "MySQLNumericToken",
+			AcceptValue:  "",
+			EndStatus:    31,
+		},
+		{
+			StartStatus:  []int{30},
+			AcceptObject: "MySQLParamMarkerToken",
+			AcceptValue:  "",
+			EndStatus:    31,
+		},
+		{
+			StartStatus:  []int{13, 15, 18, 19, 21, 23, 55, 26, 27, 29, 31},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "PROCEDURE",
+			EndStatus:    32,
+		},
+		{
+			StartStatus:  []int{32},
+			AcceptObject: "MySQLIdentifierComponent",
+			AcceptValue:  "",
+			EndStatus:    33,
+		},
+		{
+			StartStatus:  []int{33},
+			AcceptObject: "MySQLOperatorToken",
+			AcceptValue:  "(",
+			EndStatus:    34,
+		},
+		{
+			StartStatus:  []int{34},
+			AcceptObject: "MySQLIdentifierComponent",
+			AcceptValue:  "",
+			EndStatus:    35,
+		},
+		{
+			StartStatus:  []int{35},
+			AcceptObject: "MySQLOperatorToken",
+			AcceptValue:  ")",
+			EndStatus:    36,
+		},
+		{
+			StartStatus:  []int{35},
+			AcceptObject: "MySQLDelimiterToken",
+			AcceptValue:  ",",
+			EndStatus:    34,
+		},
+		{
+			StartStatus:  []int{13, 15, 18, 19, 21, 23, 55, 26, 27, 29, 31, 36, 53},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "INTO",
+			EndStatus:    37,
+		},
+		{
+			StartStatus:  []int{37},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "OUTFILE",
+			EndStatus:    38,
+		},
+		{
+			StartStatus:  []int{38},
+			AcceptObject: "MySQLStringToken",
+			AcceptValue:  "",
+			EndStatus:    39,
+		},
+		{
+			StartStatus:  []int{39},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "CHARACTER",
+			EndStatus:    40,
+		},
+		{
+			StartStatus:  []int{40},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "SET",
+			EndStatus:    41,
+		},
+		{
+			StartStatus:  []int{39},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "CHARSET",
+			EndStatus:    41,
+		},
+		{
+			StartStatus:  []int{41},
+			AcceptObject: "MySQLCharsetNameComponent",
+			AcceptValue:  "",
+			EndStatus:    42,
+		},
+		{
+			StartStatus:  []int{39, 42},
+			AcceptObject: "MySQLExportOptionComponent",
+			AcceptValue:  "",
+			EndStatus:    43,
+		},
+		{
+			StartStatus:  []int{37},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "DUMPFILE",
+			EndStatus:    44,
+		},
+		{
+			StartStatus:  []int{44},
+			AcceptObject: "MySQLStringToken",
+			AcceptValue:  "",
+			EndStatus:    43,
+		},
+		{
+			StartStatus:  []int{37, 46},
+			AcceptObject: "MySQLVariableToken",
+			AcceptValue:  "",
+			EndStatus:    45,
+		},
+		{
+			StartStatus:  []int{45},
+			AcceptObject: "MySQLDelimiterToken",
+			AcceptValue:  ",",
+			EndStatus:    46,
+		},
+		{
+			StartStatus:  []int{10, 52},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "INTO",
+			EndStatus:    56,
+		},
+		{
+			StartStatus:  []int{56, 58},
+			AcceptObject: "MySQLVariableToken",
+			AcceptValue:  "",
+			EndStatus:    57,
+		},
+		{
+			StartStatus:  []int{57},
+			AcceptObject: "MySQLDelimiterToken",
+			AcceptValue:  ",",
+			EndStatus:    58,
+		},
+		{
+			StartStatus:  []int{57},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "FROM",
+			EndStatus:    12,
+		},
+		{
+			StartStatus:  []int{13, 15, 18, 19, 21, 23, 55, 26, 27, 29, 31, 36, 39, 42, 43, 45, 53, 57},
+			AcceptObject: "MySQLLockingClauseComponent",
+			AcceptValue:  "",
+			EndStatus:    53,
+		},
+	}
+}
+
+func NewSelectStatement(tokenList MySQLTokenList,
+	verboseFunc func(message string, level LogLevel)) (MySQLStatement, MySQLTokenList) {
+	startPos := tokenList.CurrentPos()
+	s := &SelectStatement{
+		MySQLBaseStatement: &MySQLBaseStatement{
+			ObjectList: make([]*MySQLObject, 0),
+		},
+		DatabaseList: make([]string, 0),
+		TableList:    make([]string, 0),
+		FieldList:    make([]*MySQLExpressionComponent, 0),
+		AliasList:    make([]string, 0),
+		WindowList:   make([]*MySQLWindowDefinitionComponent, 0),
+	}
+	endPos := s.ParseByFsm(s.GetFsmMap(), tokenList,
+		[]int{10, 13, 15, 18, 19, 21, 23, 26, 27, 29, 31, 36, 39, 42, 43, 45, 52, 53, 55, 57}, verboseFunc)
+	if endPos == -1 {
+		tokenList.Reset(startPos)
+		return nil, tokenList
+	} else {
+		inFieldList := true
+		lastKeyword := ""
+		for _, t := range s.ObjectList {
+			if inFieldList && (*t).Type() == "MySQLExpressionComponent" {
+				s.FieldList = append(s.FieldList, (*t).(*MySQLExpressionComponent))
+				s.AliasList = append(s.AliasList, "")
+			} else if inFieldList && lastKeyword == "AS" && (*t).Type() == "MySQLIdentifierComponent" {
+				s.AliasList[len(s.AliasList)-1] = strings.Trim((*t).Value(), "`")
+			} else if inFieldList && lastKeyword == "AS" && (*t).Type() == "MySQLStringToken" {
+				s.AliasList[len(s.AliasList)-1] = unquoteString((*t).Value())
+			}
+			if (*t).Type() == "MySQLKeywordToken" {
+				lastKeyword = strings.ToUpper((*t).Value())
+				if !InArray(lastKeyword, []string{"SELECT", "ALL", "DISTINCT", "DISTINCTROW", "HIGH_PRIORITY",
+					"STRAIGHT_JOIN", "SQL_SMALL_RESULT", "SQL_BIG_RESULT", "SQL_BUFFER_RESULT", "SQL_CACHE",
+					"SQL_NO_CACHE", "SQL_CALC_FOUND_ROWS", "AS"}) {
+					inFieldList = false
+				}
+			}
+			if (*t).Type() == "TableReferenceListComponent" {
+				s.DatabaseList = append(s.DatabaseList, (*t).(*TableReferenceListComponent).DatabaseList...)
+				s.TableList = append(s.TableList, (*t).(*TableReferenceListComponent).TableList...)
+			} else if (*t).Type() == "MySQLExpressionComponent" {
+				for _, tmpT := range (*t).(*MySQLExpressionComponent).ObjectList {
+					if (*tmpT).Type() == "SubQueryComponent" {
+						s.DatabaseList = append(s.DatabaseList, (*tmpT).(*SubQueryComponent).DatabaseList...)
+						s.TableList = append(s.TableList, (*tmpT).(*SubQueryComponent).TableList...)
+					}
+				}
+			} else if (*t).Type() == "MySQLWindowDefinitionComponent" {
+				s.WindowList = append(s.WindowList, (*t).(*MySQLWindowDefinitionComponent))
+			} else if (*t).Type() == "MySQLLockingClauseComponent" {
+				s.LockMode = mergeLockMode(s.LockMode, (*t).(*MySQLLockingClauseComponent).LockMode)
+			}
+		}
+		tokenList.Reset(endPos)
+		return s, tokenList
+	}
+}
+
+// 13.2.9.3 UNION Syntax
+// SELECT ...
+// UNION [ALL | DISTINCT] SELECT ...
+// [UNION [ALL | DISTINCT] SELECT ...]
+//
+// TABLE and VALUES statements may be used in place of any SELECT.
+//
+// (SELECT a FROM t1 WHERE a=10 AND B=1 ORDER BY a LIMIT 10)
+//  UNION
+// (SELECT a FROM t2 WHERE a=11 AND B=2 ORDER BY a LIMIT 10);
+//
+// (SELECT a FROM t1 WHERE a=10 AND B=1)
+//  UNION
+// (SELECT a FROM t2 WHERE a=11 AND B=2)
+//  ORDER BY a LIMIT 10
+//  FOR SHARE NOWAIT;
+
+type UnionStatement struct {
+	*MySQLBaseStatement
+	DatabaseList []string
+	TableList    []string
+	LockMode     LockMode
+}
+
+func (s *UnionStatement) Type() string {
+	return "UnionStatement"
+}
+
+func (s *UnionStatement) GetFsmMap() []FsmMap {
+	return []FsmMap{
+		{
+			StartStatus:  []int{0},
+			AcceptObject: "SelectStatement",
+			AcceptValue:  "",
+			EndStatus:    1,
+		},
+		{
+			StartStatus:  []int{0},
+			AcceptObject: "TableStatement",
+			AcceptValue:  "",
+			EndStatus:    1,
+		},
+		{
+			StartStatus:  []int{0},
+			AcceptObject: "ValuesStatement",
+			AcceptValue:  "",
+			EndStatus:    1,
+		},
+		{
+			StartStatus:  []int{1, 4},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "UNION",
+			EndStatus:    2,
+		},
+		{
+			StartStatus:  []int{2},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "ALL",
+			EndStatus:    3,
+		},
+		{
+			StartStatus:  []int{2},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "DISTINCT",
+			EndStatus:    3,
+		},
+		{
+			StartStatus:  []int{2, 3},
+			AcceptObject: "SelectStatement",
+			AcceptValue:  "",
+			EndStatus:    4,
+		},
+		{
+			StartStatus:  []int{2, 3},
+			AcceptObject: "TableStatement",
+			AcceptValue:  "",
+			EndStatus:    4,
+		},
+		{
+			StartStatus:  []int{2, 3},
+			AcceptObject: "ValuesStatement",
+			AcceptValue:  "",
+			EndStatus:    4,
+		},
+		{
+			StartStatus:  []int{0},
+			AcceptObject: "MySQLOperatorToken",
+			AcceptValue:  "(",
+			EndStatus:    5,
+		},
+		{
+			StartStatus:  []int{5},
+			AcceptObject: "SelectStatement",
+			AcceptValue:  "",
+			EndStatus:    6,
+		},
+		{
+			StartStatus:  []int{5},
+			AcceptObject: "TableStatement",
+			AcceptValue:  "",
+			EndStatus:    6,
+		},
+		{
+			StartStatus:  []int{5},
+			AcceptObject: "ValuesStatement",
+			AcceptValue:  "",
+			EndStatus:    6,
+		},
+		{
+			StartStatus:  []int{6},
+			AcceptObject: "MySQLOperatorToken",
+			AcceptValue:  ")",
+			EndStatus:    7,
+		},
+		{
+			StartStatus:  []int{7, 12},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "UNION",
 			EndStatus:    8,
 		},
 		{
-			StartStatus:  []int{1, 2, 3, 4, 5, 6, 7},
+			StartStatus:  []int{8},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "ALL",
+			EndStatus:    9,
+		},
+		{
+			StartStatus:  []int{8},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "SQL_NO_CACHE",
-			EndStatus:    8,
+			AcceptValue:  "DISTINCT",
+			EndStatus:    9,
 		},
 		{
-			StartStatus:  []int{1, 2, 3, 4, 5, 6, 7, 8},
+			StartStatus:  []int{8, 9},
+			AcceptObject: "MySQLOperatorToken",
+			AcceptValue:  "(",
+			EndStatus:    10,
+		},
+		{
+			StartStatus:  []int{10},
+			AcceptObject: "SelectStatement",
+			AcceptValue:  "",
+			EndStatus:    11,
+		},
+		{
+			StartStatus:  []int{10},
+			AcceptObject: "TableStatement",
+			AcceptValue:  "",
+			EndStatus:    11,
+		},
+		{
+			StartStatus:  []int{10},
+			AcceptObject: "ValuesStatement",
+			AcceptValue:  "",
+			EndStatus:    11,
+		},
+		{
+			StartStatus:  []int{11},
+			AcceptObject: "MySQLOperatorToken",
+			AcceptValue:  ")",
+			EndStatus:    12,
+		},
+		{
+			StartStatus:  []int{12},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "SQL_CALC_FOUND_ROWS",
-			EndStatus:    9,
+			AcceptValue:  "ORDER",
+			EndStatus:    13,
 		},
 		{
-			StartStatus:  []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 11},
+			StartStatus:  []int{13},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "BY",
+			EndStatus:    14,
+		},
+		{
+			StartStatus:  []int{14},
 			AcceptObject: "MySQLExpressionComponent",
 			AcceptValue:  "",
-			EndStatus:    10,
+			EndStatus:    15,
+		},
+		{
+			StartStatus:  []int{14},
+			AcceptObject: "MySQLColumnNameComponent",
+			AcceptValue:  "",
+			EndStatus:    15,
+		},
+		{
+			StartStatus:  []int{14},
+			AcceptObject: "MySQLNumericToken",
+			AcceptValue:  "",
+			EndStatus:    15,
+		},
+		{
+			StartStatus:  []int{15},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "ASC",
+			EndStatus:    16,
+		},
+		{
+			StartStatus:  []int{15},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "DESC",
+			EndStatus:    16,
+		},
+		{
+			StartStatus:  []int{16},
+			AcceptObject: "MySQLDelimiterToken",
+			AcceptValue:  ",",
+			EndStatus:    14,
+		},
+		{
+			StartStatus:  []int{12, 15, 16},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "LIMIT",
+			EndStatus:    17,
+		},
+		{
+			StartStatus:  []int{17},
+			AcceptObject: "MySQLNumericToken",
+			AcceptValue:  "",
+			EndStatus:    18,
+		},
+		{
+			StartStatus:  []int{17},
+			AcceptObject: "MySQLParamMarkerToken",
+			AcceptValue:  "",
+			EndStatus:    18,
+		},
+		{
+			StartStatus:  []int{18},
+			AcceptObject: "MySQLDelimiterToken",
+			AcceptValue:  ",",
+			EndStatus:    19,
+		},
+		{
+			StartStatus:  []int{18},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "OFFSET",
+			EndStatus:    19,
+		},
+		{
+			StartStatus:  []int{19},
+			AcceptObject: "MySQLNumericToken",
+			AcceptValue:  "",
+			EndStatus:    20,
+		},
+		{
+			StartStatus:  []int{19},
+			AcceptObject: "MySQLParamMarkerToken",
+			AcceptValue:  "",
+			EndStatus:    20,
+		},
+		{
+			StartStatus:  []int{12, 15, 16, 18, 20, 21},
+			AcceptObject: "MySQLLockingClauseComponent",
+			AcceptValue:  "",
+			EndStatus:    21,
+		},
+	}
+}
+
+func NewUnionStatement(tokenList MySQLTokenList,
+	verboseFunc func(message string, level LogLevel)) (MySQLStatement, MySQLTokenList) {
+	startPos := tokenList.CurrentPos()
+	s := &UnionStatement{
+		MySQLBaseStatement: &MySQLBaseStatement{
+			ObjectList: make([]*MySQLObject, 0),
+		},
+		DatabaseList: make([]string, 0),
+		TableList:    make([]string, 0),
+	}
+	endPos := s.ParseByFsm(s.GetFsmMap(), tokenList, []int{4, 12, 15, 16, 18, 20, 21}, verboseFunc)
+	if endPos == -1 {
+		tokenList.Reset(startPos)
+		return nil, tokenList
+	} else {
+		for _, t := range s.ObjectList {
+			if (*t).Type() == "SelectStatement" {
+				s.DatabaseList = append(s.DatabaseList, (*t).(*SelectStatement).DatabaseList...)
+				s.TableList = append(s.TableList, (*t).(*SelectStatement).TableList...)
+				s.LockMode = mergeLockMode(s.LockMode, (*t).(*SelectStatement).LockMode)
+			} else if (*t).Type() == "TableStatement" {
+				s.DatabaseList = append(s.DatabaseList, (*t).(*TableStatement).DatabaseList...)
+				s.TableList = append(s.TableList, (*t).(*TableStatement).TableList...)
+			} else if (*t).Type() == "ValuesStatement" {
+				s.DatabaseList = append(s.DatabaseList, (*t).(*ValuesStatement).DatabaseList...)
+				s.TableList = append(s.TableList, (*t).(*ValuesStatement).TableList...)
+			} else if (*t).Type() == "MySQLLockingClauseComponent" {
+				s.LockMode = mergeLockMode(s.LockMode, (*t).(*MySQLLockingClauseComponent).LockMode)
+			}
+		}
+		tokenList.Reset(endPos)
+		return s, tokenList
+	}
+}
+
+// 13.7.4.1 SET Syntax for Variable Assignment
+// SET variable_assignment [, variable_assignment] ...
+//
+// variable_assignment:
+//      user_var_name = expr
+//    | param_name = expr
+//    | local_var_name = expr
+//    | {GLOBAL | @@GLOBAL.} system_var_name = expr
+//    | {PERSIST | @@PERSIST.} system_var_name = expr
+//    | {PERSIST_ONLY | @@PERSIST_ONLY.} system_var_name = expr
+//    | [SESSION | @@SESSION. | @@] system_var_name = expr
+//
+// SET ONE_SHOT system_var_name = expr
+//
+// 13.7.4.2 SET CHARACTER SET Syntax
+// SET {CHARACTER SET | CHARSET}
+//    {'charset_name' | DEFAULT}
+//
+// 13.7.4.3 SET NAMES Syntax
+// SET NAMES {'charset_name'
+//    [COLLATE 'collation_name'] | DEFAULT}
+//
+// NAMES及CHARACTER SET可以与变量赋值写在同一条SET中，均作为AssignmentList中的一项，
+// 只有这一项时Kind为SetKindNames或SetKindCharacterSet
+//
+// 13.3.7 SET TRANSACTION Syntax
+// SET [GLOBAL | SESSION] TRANSACTION
+//    transaction_characteristic [, transaction_characteristic] ...
+//
+// transaction_characteristic: {
+//    ISOLATION LEVEL level
+//  | access_mode
+// }
+//
+// level: {
+//     REPEATABLE READ
+//   | READ COMMITTED
+//   | READ UNCOMMITTED
+//   | SERIALIZABLE
+// }
+//
+// access_mode: {
+//     READ WRITE
+//   | READ ONLY
+// }
+//
+// 13.7.1.10 SET PASSWORD Syntax
+// SET PASSWORD [FOR user] auth_option
+//    [REPLACE 'current_auth_string']
+//    [RETAIN CURRENT PASSWORD]
+//
+// auth_option: {
+//     = 'auth_string'
+//   | = PASSWORD('auth_string')
+//   | TO RANDOM
+// }
+
+type SetStatement struct {
+	*MySQLBaseStatement
+	Kind             SetKind
+	AssignmentList   []*MySQLVariableAssignmentComponent
+	Charset          string
+	Collation        string
+	DefaultCharset   bool
+	TransactionScope VariableScope
+	IsolationLevel   string
+	AccessMode       string
+	User             string
+	Host             string
+	Password         string
+}
+
+func (s *SetStatement) Type() string {
+	return "SetStatement"
+}
+
+func (s *SetStatement) GetFsmMap() []FsmMap {
+	return []FsmMap{
+		{
+			StartStatus:  []int{0},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "SET",
+			EndStatus:    1,
+		},
+		{
+			StartStatus:  []int{1},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "ONE_SHOT",
+			EndStatus:    7,
+		},
+		{
+			StartStatus:  []int{7},
+			AcceptObject: "MySQLVariableAssignmentComponent",
+			AcceptValue:  "",
+			EndStatus:    FinalStatus,
+		},
+		{
+			StartStatus:  []int{1},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "PASSWORD",
+			EndStatus:    29,
+		},
+		{
+			StartStatus:  []int{29},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "FOR",
+			EndStatus:    30,
+		},
+		{
+			StartStatus:  []int{30},
+			AcceptObject: "MySQLStringToken",
+			AcceptValue:  "",
+			EndStatus:    31,
+		},
+		{
+			StartStatus:  []int{30},
+			AcceptObject: "MySQLQuotedIdentifierToken",
+			AcceptValue:  "",
+			EndStatus:    31,
+		},
+		{
+			StartStatus:  []int{30},
+			AcceptObject: "MySQLUnquotedIdentifierToken",
+			AcceptValue:  "",
+			EndStatus:    31,
+		},
+		{
+			StartStatus:  []int{31},
+			AcceptObject: "MySQLVariableToken",
+			AcceptValue:  "",
+			EndStatus:    32,
+		},
+		{
+			StartStatus:  []int{30},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "CURRENT_USER",
+			EndStatus:    33,
+		},
+		{
+			StartStatus:  []int{33},
+			AcceptObject: "MySQLOperatorToken",
+			AcceptValue:  "(",
+			EndStatus:    34,
+		},
+		{
+			StartStatus:  []int{34},
+			AcceptObject: "MySQLOperatorToken",
+			AcceptValue:  ")",
+			EndStatus:    32,
 		},
 		{
-			StartStatus:  []int{10},
-			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "AS",
-			EndStatus:    51,
+			StartStatus:  []int{29, 31, 32, 33},
+			AcceptObject: "MySQLOperatorToken",
+			AcceptValue:  "=",
+			EndStatus:    35,
 		},
 		{
-			StartStatus:  []int{51},
+			StartStatus:  []int{35},
 			AcceptObject: "MySQLStringToken",
 			AcceptValue:  "",
-			EndStatus:    52,
+			EndStatus:    36,
 		},
 		{
-			StartStatus:  []int{51},
-			AcceptObject: "MySQLIdentifierComponent",
+			StartStatus:  []int{35},
+			AcceptObject: "MySQLExpressionComponent",
 			AcceptValue:  "",
-			EndStatus:    52,
-		},
-		{
-			StartStatus:  []int{10, 52},
-			AcceptObject: "MySQLDelimiterToken",
-			AcceptValue:  ",",
-			EndStatus:    11,
+			EndStatus:    36,
 		},
 		{
-			StartStatus:  []int{10, 52},
+			StartStatus:  []int{29, 31, 32, 33},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "FROM",
-			EndStatus:    12,
+			AcceptValue:  "TO",
+			EndStatus:    37,
 		},
 		{
-			StartStatus:  []int{12},
-			AcceptObject: "TableReferenceListComponent",
-			AcceptValue:  "",
-			EndStatus:    13,
+			StartStatus:  []int{37},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "RANDOM",
+			EndStatus:    36,
 		},
 		{
-			StartStatus:  []int{13},
+			StartStatus:  []int{36},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "WHERE",
-			EndStatus:    14,
+			AcceptValue:  "REPLACE",
+			EndStatus:    38,
 		},
 		{
-			StartStatus:  []int{14},
-			AcceptObject: "MySQLExpressionComponent",
+			StartStatus:  []int{38},
+			AcceptObject: "MySQLStringToken",
 			AcceptValue:  "",
-			EndStatus:    15,
+			EndStatus:    39,
 		},
 		{
-			StartStatus:  []int{13, 15},
+			StartStatus:  []int{36, 39},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "GROUP",
-			EndStatus:    16,
+			AcceptValue:  "RETAIN",
+			EndStatus:    40,
 		},
 		{
-			StartStatus:  []int{16},
+			StartStatus:  []int{40},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "BY",
-			EndStatus:    17,
-		},
-		{
-			StartStatus:  []int{17},
-			AcceptObject: "MySQLExpressionComponent",
-			AcceptValue:  "",
-			EndStatus:    18,
-		},
-		{
-			StartStatus:  []int{17},
-			AcceptObject: "MySQLColumnNameComponent",
-			AcceptValue:  "",
-			EndStatus:    18,
+			AcceptValue:  "CURRENT",
+			EndStatus:    41,
 		},
 		{
-			StartStatus:  []int{17},
-			AcceptObject: "MySQLNumericToken",
-			AcceptValue:  "",
-			EndStatus:    18,
+			StartStatus:  []int{41},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "PASSWORD",
+			EndStatus:    FinalStatus,
 		},
 		{
-			StartStatus:  []int{18},
+			StartStatus:  []int{1, 20},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "ASC",
-			EndStatus:    19,
+			AcceptValue:  "TRANSACTION",
+			EndStatus:    21,
 		},
 		{
-			StartStatus:  []int{18},
-			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "DESC",
-			EndStatus:    19,
+			StartStatus:  []int{1, 6},
+			AcceptObject: "MySQLVariableAssignmentComponent",
+			AcceptValue:  "",
+			EndStatus:    5,
 		},
 		{
-			StartStatus:  []int{18, 19},
+			StartStatus:  []int{5},
 			AcceptObject: "MySQLDelimiterToken",
 			AcceptValue:  ",",
-			EndStatus:    17,
+			EndStatus:    6,
 		},
 		{
-			StartStatus:  []int{18, 19},
+			StartStatus:  []int{1},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "WITH",
+			AcceptValue:  "GLOBAL",
 			EndStatus:    20,
 		},
 		{
-			StartStatus:  []int{20},
+			StartStatus:  []int{1},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "ROLLUP",
-			EndStatus:    21,
+			AcceptValue:  "SESSION",
+			EndStatus:    20,
 		},
 		{
-			StartStatus:  []int{13, 15, 18, 19, 21},
+			StartStatus:  []int{21, 27},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "HAVING",
+			AcceptValue:  "ISOLATION",
 			EndStatus:    22,
 		},
 		{
 			StartStatus:  []int{22},
-			AcceptObject: "MySQLExpressionComponent",
-			AcceptValue:  "",
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "LEVEL",
 			EndStatus:    23,
 		},
 		{
-			StartStatus:  []int{13, 15, 18, 19, 21, 23},
+			StartStatus:  []int{23},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "ORDER",
+			AcceptValue:  "REPEATABLE",
 			EndStatus:    24,
 		},
 		{
 			StartStatus:  []int{24},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "BY",
+			AcceptValue:  "READ",
+			EndStatus:    26,
+		},
+		{
+			StartStatus:  []int{23},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "READ",
 			EndStatus:    25,
 		},
 		{
 			StartStatus:  []int{25},
-			AcceptObject: "MySQLExpressionComponent",
-			AcceptValue:  "",
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "COMMITTED",
 			EndStatus:    26,
 		},
 		{
 			StartStatus:  []int{25},
-			AcceptObject: "MySQLColumnNameComponent",
-			AcceptValue:  "",
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "UNCOMMITTED",
 			EndStatus:    26,
 		},
 		{
-			StartStatus:  []int{25},
-			AcceptObject: "MySQLNumericToken",
-			AcceptValue:  "",
+			StartStatus:  []int{23},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "SERIALIZABLE",
 			EndStatus:    26,
 		},
 		{
-			StartStatus:  []int{26},
+			StartStatus:  []int{21, 27},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "ASC",
+			AcceptValue:  "READ",
+			EndStatus:    28,
+		},
+		{
+			StartStatus:  []int{28},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "WRITE",
+			EndStatus:    26,
+		},
+		{
+			StartStatus:  []int{28},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "ONLY",
+			EndStatus:    26,
+		},
+		{
+			StartStatus:  []int{26},
+			AcceptObject: "MySQLDelimiterToken",
+			AcceptValue:  ",",
 			EndStatus:    27,
 		},
+	}
+}
+
+func NewSetStatement(tokenList MySQLTokenList,
+	verboseFunc func(message string, level LogLevel)) (MySQLStatement, MySQLTokenList) {
+	startPos := tokenList.CurrentPos()
+	s := &SetStatement{
+		MySQLBaseStatement: &MySQLBaseStatement{
+			ObjectList: make([]*MySQLObject, 0),
+		},
+		AssignmentList: make([]*MySQLVariableAssignmentComponent, 0),
+	}
+	endPos := s.ParseByFsm(s.GetFsmMap(), tokenList, []int{5, 26, 36, 39}, verboseFunc)
+	if endPos == -1 {
+		tokenList.Reset(startPos)
+		return nil, tokenList
+	} else {
+		lastKeyword := ""
+		for _, t := range s.ObjectList {
+			if (*t).Type() == "MySQLVariableAssignmentComponent" {
+				s.AssignmentList = append(s.AssignmentList, (*t).(*MySQLVariableAssignmentComponent))
+				continue
+			} else if (*t).Type() == "MySQLCommentToken" || (*t).Type() == "MySQLSpaceToken" {
+				continue
+			} else if (*t).Type() == "MySQLOperatorToken" && (*t).Value() == "=" {
+				lastKeyword = "PASSWORD"
+				continue
+			} else if (*t).Type() != "MySQLKeywordToken" {
+				switch lastKeyword {
+				case "FOR":
+					if (*t).Type() == "MySQLVariableToken" {
+						s.Host = unquoteUserPart((*t).Value()[1:])
+					} else if (*t).Type() == "MySQLStringToken" || (*t).Type() == "MySQLQuotedIdentifierToken" ||
+						(*t).Type() == "MySQLUnquotedIdentifierToken" {
+						s.User = unquoteUserPart((*t).Value())
+					}
+				case "PASSWORD":
+					if (*t).Type() == "MySQLStringToken" {
+						s.Password = unquoteString((*t).Value())
+					} else if (*t).Type() == "MySQLExpressionComponent" {
+						s.Password = strings.TrimSpace((*t).Value())
+					}
+				}
+				continue
+			}
+			lastKeyword = (*t).Value()
+			switch lastKeyword {
+			case "PASSWORD":
+				s.Kind = SetKindPassword
+			case "CURRENT_USER":
+				s.User = lastKeyword
+			case "TRANSACTION":
+				s.Kind = SetKindTransaction
+			case "GLOBAL", "SESSION":
+				s.TransactionScope = getVariableScope(lastKeyword)
+			case "REPEATABLE", "SERIALIZABLE":
+				s.IsolationLevel = lastKeyword
+			case "COMMITTED", "UNCOMMITTED":
+				s.IsolationLevel = "READ " + lastKeyword
+			case "WRITE", "ONLY":
+				s.AccessMode = "READ " + lastKeyword
+			case "READ":
+				if s.IsolationLevel == "REPEATABLE" {
+					s.IsolationLevel = "REPEATABLE READ"
+				}
+			case "REPLACE", "RETAIN", "RANDOM":
+				lastKeyword = ""
+			}
+		}
+		for _, assignment := range s.AssignmentList {
+			if assignment.Name != "NAMES" && assignment.Name != "CHARACTER SET" {
+				continue
+			}
+			if assignment.ValueText == "DEFAULT" {
+				s.DefaultCharset = true
+			} else {
+				s.Charset = assignment.ValueText
+			}
+			s.Collation = assignment.Collation
+			if len(s.AssignmentList) == 1 && assignment.Name == "NAMES" {
+				s.Kind = SetKindNames
+			} else if len(s.AssignmentList) == 1 {
+				s.Kind = SetKindCharacterSet
+			}
+		}
+		tokenList.Reset(endPos)
+		return s, tokenList
+	}
+}
+
+// getSetCharsetValue 获取SET NAMES/CHARACTER SET中的字符集或校对规则名
+func getSetCharsetValue(t *MySQLObject) string {
+	switch (*t).Type() {
+	case "MySQLCharsetNameComponent":
+		return (*t).(*MySQLCharsetNameComponent).Charset
+	case "MySQLCollationNameComponent":
+		return (*t).(*MySQLCollationNameComponent).Collation
+	case "MySQLStringToken":
+		return strings.ToLower(unquoteString((*t).Value()))
+	}
+	return ""
+}
+
+// unquoteUserPart 去除账户名或主机名两端的引号
+func unquoteUserPart(str string) string {
+	if len(str) >= 2 && (str[0] == '\'' || str[0] == '"') {
+		return unquoteString(str)
+	}
+	return strings.Trim(str, "`")
+}
+
+// 13.7.5 SHOW Syntax
+// SHOW AUTHORS
+// SHOW {BINARY | MASTER} LOGS
+// SHOW BINLOG EVENTS [IN 'log_name'] [FROM pos] [LIMIT [offset,] row_count]
+// SHOW CHARACTER SET [like_or_where]
+// SHOW COLLATION [like_or_where]
+// SHOW [FULL] {COLUMNS | FIELDS} {FROM | IN} tbl_name [{FROM | IN} db_name] [like_or_where]
+// SHOW CONTRIBUTORS
+// SHOW CREATE {DATABASE | SCHEMA} [IF NOT EXISTS] db_name
+// SHOW CREATE EVENT event_name
+// SHOW CREATE FUNCTION func_name
+// SHOW CREATE PROCEDURE proc_name
+// SHOW CREATE TABLE tbl_name
+// SHOW CREATE TRIGGER trigger_name
+// SHOW CREATE VIEW view_name
+// SHOW {DATABASES | SCHEMAS} [like_or_where]
+// SHOW ENGINE engine_name {STATUS | MUTEX}
+// SHOW [STORAGE] ENGINES
+// SHOW ERRORS [LIMIT [offset,] row_count]
+// SHOW COUNT(*) ERRORS
+// SHOW EVENTS [{FROM | IN} db_name} [like_or_where]
+// SHOW FUNCTION CODE func_name
+// SHOW FUNCTION STATUS [like_or_where]
+// SHOW GRANTS [FOR user]
+// SHOW {INDEX | INDEXES | KEYS} {FROM | IN} tbl_name [{FROM | IN} db_name] [like_or_where]
+// SHOW MASTER STATUS
+// SHOW OPEN TABLES [{FROM | IN} db_name] [like_or_where]
+// SHOW PLUGINS
+// SHOW PROCEDURE CODE proc_name
+// SHOW PROCEDURE STATUS [like_or_where]
+// SHOW PRIVILEGES
+// SHOW [FULL] PROCESSLIST
+// SHOW PROFILE [types] [FOR QUERY n] [LIMIT row_count [OFFSET offset]]
+// SHOW PROFILES
+// SHOW RELAYLOG EVENTS [IN 'log_name'] [FROM pos] [LIMIT [offset,] row_count]
+// SHOW SLAVE HOSTS
+// SHOW SLAVE STATUS
+// SHOW [GLOBAL | SESSION] STATUS [like_or_where]
+// SHOW TABLE STATUS [{FROM | IN} db_name] [like_or_where]
+// SHOW [FULL] TABLES [{FROM | IN} db_name] [like_or_where]
+// SHOW TRIGGERS [{FROM | IN} db_name] [like_or_where]
+// SHOW [GLOBAL | SESSION] VARIABLES [like_or_where]
+// SHOW WARNINGS [LIMIT [offset,] row_count]
+// SHOW COUNT(*) WARNINGS
+//
+// like_or_where:
+//    LIKE 'pattern'
+//  | WHERE expr
+
+type ShowStatement struct {
+	*MySQLBaseStatement
+	Kind         ShowKind
+	Full         bool
+	Scope        VariableScope
+	Count        bool
+	Database     string
+	Table        string
+	Object       string
+	Like         string
+	Where        *MySQLExpressionComponent
+	DatabaseList []string
+	TableList    []string
+}
+
+// showKindMap SHOW后关键字序列(不含FULL/GLOBAL/SESSION/STORAGE修饰)到类型的映射
+var showKindMap = map[string]ShowKind{
+	"AUTHORS":          ShowKindAuthors,
+	"BINARY LOGS":      ShowKindBinaryLogs,
+	"MASTER LOGS":      ShowKindBinaryLogs,
+	"BINLOG EVENTS":    ShowKindBinlogEvents,
+	"CHARACTER SET":    ShowKindCharacterSet,
+	"CHARSET":          ShowKindCharacterSet,
+	"COLLATION":        ShowKindCollation,
+	"COLUMNS":          ShowKindColumns,
+	"FIELDS":           ShowKindColumns,
+	"CONTRIBUTORS":     ShowKindContributors,
+	"CREATE DATABASE":  ShowKindCreateDatabase,
+	"CREATE SCHEMA":    ShowKindCreateDatabase,
+	"CREATE EVENT":     ShowKindCreateEvent,
+	"CREATE FUNCTION":  ShowKindCreateFunction,
+	"CREATE PROCEDURE": ShowKindCreateProcedure,
+	"CREATE TABLE":     ShowKindCreateTable,
+	"CREATE TRIGGER":   ShowKindCreateTrigger,
+	"CREATE VIEW":      ShowKindCreateView,
+	"DATABASES":        ShowKindDatabases,
+	"SCHEMAS":          ShowKindDatabases,
+	"ENGINE STATUS":    ShowKindEngineStatus,
+	"ENGINE MUTEX":     ShowKindEngineMutex,
+	"ENGINES":          ShowKindEngines,
+	"ERRORS":           ShowKindErrors,
+	"COUNT ERRORS":     ShowKindErrors,
+	"EVENTS":           ShowKindEvents,
+	"FUNCTION CODE":    ShowKindFunctionCode,
+	"FUNCTION STATUS":  ShowKindFunctionStatus,
+	"GRANTS":           ShowKindGrants,
+	"INDEX":            ShowKindIndex,
+	"INDEXES":          ShowKindIndex,
+	"KEYS":             ShowKindIndex,
+	"MASTER STATUS":    ShowKindMasterStatus,
+	"OPEN TABLES":      ShowKindOpenTables,
+	"PLUGINS":          ShowKindPlugins,
+	"PROCEDURE CODE":   ShowKindProcedureCode,
+	"PROCEDURE STATUS": ShowKindProcedureStatus,
+	"PRIVILEGES":       ShowKindPrivileges,
+	"PROCESSLIST":      ShowKindProcesslist,
+	"PROFILE":          ShowKindProfile,
+	"PROFILES":         ShowKindProfiles,
+	"RELAYLOG EVENTS":  ShowKindRelaylogEvents,
+	"SLAVE HOSTS":      ShowKindSlaveHosts,
+	"SLAVE STATUS":     ShowKindSlaveStatus,
+	"STATUS":           ShowKindStatus,
+	"TABLE STATUS":     ShowKindTableStatus,
+	"TABLES":           ShowKindTables,
+	"TRIGGERS":         ShowKindTriggers,
+	"VARIABLES":        ShowKindVariables,
+	"WARNINGS":         ShowKindWarnings,
+	"COUNT WARNINGS":   ShowKindWarnings,
+}
+
+func (s *ShowStatement) Type() string {
+	return "ShowStatement"
+}
+
+func (s *ShowStatement) GetFsmMap() []FsmMap {
+	return []FsmMap{
+		{
+			StartStatus:  []int{0},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "SHOW",
+			EndStatus:    1,
+		},
+		{
+			StartStatus:  []int{1},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "AUTHORS",
+			EndStatus:    FinalStatus,
+		},
 		{
-			StartStatus:  []int{26},
+			StartStatus:  []int{1},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "DESC",
-			EndStatus:    27,
+			AcceptValue:  "BINARY",
+			EndStatus:    2,
 		},
 		{
-			StartStatus:  []int{26, 27},
-			AcceptObject: "MySQLDelimiterToken",
-			AcceptValue:  ",",
-			EndStatus:    25,
+			StartStatus:  []int{1},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "MASTER",
+			EndStatus:    2,
 		},
 		{
-			StartStatus:  []int{13, 15, 18, 19, 21, 23, 26, 27},
+			StartStatus:  []int{2},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "LIMIT",
-			EndStatus:    28,
+			AcceptValue:  "LOGS",
+			EndStatus:    FinalStatus,
 		},
 		{
-			StartStatus:  []int{28},
-			AcceptObject: "MySQLNumericToken",
-			AcceptValue:  "",
-			EndStatus:    29,
+			StartStatus:  []int{1},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "BINLOG",
+			EndStatus:    3,
 		},
 		{
-			StartStatus:  []int{29},
-			AcceptObject: "MySQLDelimiterToken",
-			AcceptValue:  ",",
-			EndStatus:    30,
+			StartStatus:  []int{3},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "EVENTS",
+			EndStatus:    4,
 		},
 		{
-			StartStatus:  []int{29},
+			StartStatus:  []int{4},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "OFFSET",
-			EndStatus:    30,
+			AcceptValue:  "IN",
+			EndStatus:    5,
 		},
 		{
-			StartStatus:  []int{30},
-			AcceptObject: "MySQLNumericToken",
+			StartStatus:  []int{5},
+			AcceptObject: "MySQLStringToken",
 			AcceptValue:  "",
-			EndStatus:    31,
+			EndStatus:    6,
 		},
 		{
-			StartStatus:  []int{13, 15, 18, 19, 21, 23, 26, 27, 29, 31},
+			StartStatus:  []int{4, 6},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "PROCEDURE",
-			EndStatus:    32,
+			AcceptValue:  "FROM",
+			EndStatus:    7,
 		},
 		{
-			StartStatus:  []int{32},
-			AcceptObject: "MySQLIdentifierComponent",
+			StartStatus:  []int{7},
+			AcceptObject: "MySQLNumericToken",
 			AcceptValue:  "",
-			EndStatus:    33,
+			EndStatus:    8,
 		},
 		{
-			StartStatus:  []int{33},
-			AcceptObject: "MySQLOperatorToken",
-			AcceptValue:  "(",
-			EndStatus:    34,
+			StartStatus:  []int{4, 6, 8},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "LIMIT",
+			EndStatus:    9,
 		},
 		{
-			StartStatus:  []int{34},
-			AcceptObject: "MySQLIdentifierComponent",
+			StartStatus:  []int{9},
+			AcceptObject: "MySQLNumericToken",
 			AcceptValue:  "",
-			EndStatus:    35,
-		},
-		{
-			StartStatus:  []int{35},
-			AcceptObject: "MySQLOperatorToken",
-			AcceptValue:  ")",
-			EndStatus:    36,
+			EndStatus:    10,
 		},
 		{
-			StartStatus:  []int{35},
+			StartStatus:  []int{10},
 			AcceptObject: "MySQLDelimiterToken",
 			AcceptValue:  ",",
-			EndStatus:    34,
-		},
-		{
-			StartStatus:  []int{13, 15, 18, 19, 21, 23, 26, 27, 29, 31, 36},
-			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "INTO",
-			EndStatus:    37,
-		},
-		{
-			StartStatus:  []int{37},
-			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "OUTFILE",
-			EndStatus:    38,
+			EndStatus:    11,
 		},
 		{
-			StartStatus:  []int{38},
-			AcceptObject: "MySQLStringToken",
+			StartStatus:  []int{11},
+			AcceptObject: "MySQLNumericToken",
 			AcceptValue:  "",
-			EndStatus:    39,
+			EndStatus:    FinalStatus,
 		},
 		{
-			StartStatus:  []int{39},
+			StartStatus:  []int{1},
 			AcceptObject: "MySQLKeywordToken",
 			AcceptValue:  "CHARACTER",
-			EndStatus:    40,
+			EndStatus:    12,
 		},
 		{
-			StartStatus:  []int{40},
+			StartStatus:  []int{12},
 			AcceptObject: "MySQLKeywordToken",
 			AcceptValue:  "SET",
-			EndStatus:    41,
+			EndStatus:    13,
 		},
 		{
-			StartStatus:  []int{39},
+			StartStatus:  []int{1},
 			AcceptObject: "MySQLKeywordToken",
 			AcceptValue:  "CHARSET",
-			EndStatus:    41,
+			EndStatus:    13,
 		},
 		{
-			StartStatus:  []int{41},
-			AcceptObject: "MySQLCharsetNameComponent",
-			AcceptValue:  "",
-			EndStatus:    42,
+			StartStatus:  []int{13, 19},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "LIKE",
+			EndStatus:    14,
 		},
 		{
-			StartStatus:  []int{39, 42},
-			AcceptObject: "MySQLExportOptionComponent",
+			StartStatus:  []int{14},
+			AcceptObject: "MySQLStringToken",
 			AcceptValue:  "",
-			EndStatus:    43,
+			EndStatus:    FinalStatus,
 		},
 		{
-			StartStatus:  []int{37},
+			StartStatus:  []int{13, 19},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "DUMPFILE",
-			EndStatus:    44,
+			AcceptValue:  "WHERE",
+			EndStatus:    15,
 		},
 		{
-			StartStatus:  []int{44},
-			AcceptObject: "MySQLStringToken",
+			StartStatus:  []int{15},
+			AcceptObject: "MySQLExpressionComponent",
 			AcceptValue:  "",
-			EndStatus:    43,
+			EndStatus:    FinalStatus,
 		},
 		{
-			StartStatus:  []int{37, 46},
-			AcceptObject: "MySQLVariableToken",
-			AcceptValue:  "",
-			EndStatus:    45,
+			StartStatus:  []int{1},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "COLLATION",
+			EndStatus:    13,
 		},
 		{
-			StartStatus:  []int{45},
-			AcceptObject: "MySQLDelimiterToken",
-			AcceptValue:  ",",
-			EndStatus:    46,
+			StartStatus:  []int{1},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "FULL",
+			EndStatus:    16,
 		},
 		{
-			StartStatus:  []int{13, 15, 18, 19, 21, 23, 26, 27, 29, 31, 36, 39, 42, 43, 45},
+			StartStatus:  []int{1, 16},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "FOR",
-			EndStatus:    47,
+			AcceptValue:  "COLUMNS",
+			EndStatus:    17,
 		},
 		{
-			StartStatus:  []int{47},
+			StartStatus:  []int{1, 16},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "UPDATE",
-			EndStatus:    53,
+			AcceptValue:  "FIELDS",
+			EndStatus:    17,
 		},
 		{
-			StartStatus:  []int{13, 15, 18, 19, 21, 23, 26, 27, 29, 31, 36, 39, 42, 43, 45},
+			StartStatus:  []int{17},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "LOCK",
-			EndStatus:    48,
+			AcceptValue:  "FROM",
+			EndStatus:    18,
 		},
 		{
-			StartStatus:  []int{48},
+			StartStatus:  []int{17},
 			AcceptObject: "MySQLKeywordToken",
 			AcceptValue:  "IN",
-			EndStatus:    49,
+			EndStatus:    18,
+		},
+		{
+			StartStatus:  []int{18},
+			AcceptObject: "MySQLTableNameComponent",
+			AcceptValue:  "",
+			EndStatus:    19,
 		},
 		{
-			StartStatus:  []int{49},
+			StartStatus:  []int{19},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "SHARE",
-			EndStatus:    50,
+			AcceptValue:  "FROM",
+			EndStatus:    20,
 		},
 		{
-			StartStatus:  []int{50},
+			StartStatus:  []int{19},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "MODE",
-			EndStatus:    53,
+			AcceptValue:  "IN",
+			EndStatus:    20,
 		},
-	}
-}
-
-func NewSelectStatement(tokenList MySQLTokenList,
-	verboseFunc func(message string, level LogLevel)) (MySQLStatement, MySQLTokenList) {
-	startPos := tokenList.CurrentPos()
-	s := &SelectStatement{
-		MySQLBaseStatement: &MySQLBaseStatement{
-			ObjectList: make([]*MySQLObject, 0),
+		{
+			StartStatus:  []int{20},
+			AcceptObject: "MySQLDatabaseNameComponent",
+			AcceptValue:  "",
+			EndStatus:    13,
+		},
+		{
+			StartStatus:  []int{1},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "CONTRIBUTORS",
+			EndStatus:    FinalStatus,
 		},
-		DatabaseList: make([]string, 0),
-		TableList:    make([]string, 0),
-	}
-	endPos := s.ParseByFsm(s.GetFsmMap(), tokenList,
-		[]int{10, 13, 15, 18, 19, 21, 23, 26, 27, 29, 31, 36, 39, 42, 43, 45, 52, 53}, verboseFunc)
-	if endPos == -1 {
-		tokenList.Reset(startPos)
-		return nil, tokenList
-	} else {
-		for _, t := range s.ObjectList {
-			if (*t).Type() == "TableReferenceListComponent" {
-				s.DatabaseList = append(s.DatabaseList, (*t).(*TableReferenceListComponent).DatabaseList...)
-				s.TableList = append(s.TableList, (*t).(*TableReferenceListComponent).TableList...)
-			} else if (*t).Type() == "MySQLExpressionComponent" {
-				for _, tmpT := range (*t).(*MySQLExpressionComponent).ObjectList {
-					if (*tmpT).Type() == "SubQueryComponent" {
-						s.DatabaseList = append(s.DatabaseList, (*tmpT).(*SubQueryComponent).DatabaseList...)
-						s.TableList = append(s.TableList, (*tmpT).(*SubQueryComponent).TableList...)
-					}
-				}
-			}
-		}
-		tokenList.Reset(endPos)
-		return s, tokenList
-	}
-}
-
-// 13.2.9.3 UNION Syntax
-// SELECT ...
-// UNION [ALL | DISTINCT] SELECT ...
-// [UNION [ALL | DISTINCT] SELECT ...]
-//
-// (SELECT a FROM t1 WHERE a=10 AND B=1 ORDER BY a LIMIT 10)
-//  UNION
-// (SELECT a FROM t2 WHERE a=11 AND B=2 ORDER BY a LIMIT 10);
-//
-// (SELECT a FROM t1 WHERE a=10 AND B=1)
-//  UNION
-// (SELECT a FROM t2 WHERE a=11 AND B=2)
-//  ORDER BY a LIMIT 10;
-
-type UnionStatement struct {
-	*MySQLBaseStatement
-	DatabaseList []string
-	TableList    []string
-}
-
-func (s *UnionStatement) Type() string {
-	return "UnionStatement"
-}
-
-func (s *UnionStatement) GetFsmMap() []FsmMap {
-	return []FsmMap{
 		{
-			StartStatus:  []int{0},
-			AcceptObject: "SelectStatement",
-			AcceptValue:  "",
-			EndStatus:    1,
+			StartStatus:  []int{1},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "CREATE",
+			EndStatus:    21,
 		},
 		{
-			StartStatus:  []int{1, 4},
+			StartStatus:  []int{21},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "UNION",
-			EndStatus:    2,
+			AcceptValue:  "DATABASE",
+			EndStatus:    22,
 		},
 		{
-			StartStatus:  []int{2},
+			StartStatus:  []int{21},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "ALL",
-			EndStatus:    3,
+			AcceptValue:  "SCHEMA",
+			EndStatus:    22,
 		},
 		{
-			StartStatus:  []int{2},
+			StartStatus:  []int{22},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "DISTINCT",
-			EndStatus:    3,
+			AcceptValue:  "IF",
+			EndStatus:    32,
 		},
 		{
-			StartStatus:  []int{2, 3},
-			AcceptObject: "SelectStatement",
-			AcceptValue:  "",
-			EndStatus:    4,
+			StartStatus:  []int{32},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "NOT",
+			EndStatus:    33,
 		},
 		{
-			StartStatus:  []int{0},
-			AcceptObject: "MySQLOperatorToken",
-			AcceptValue:  "(",
-			EndStatus:    5,
+			StartStatus:  []int{33},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "EXISTS",
+			EndStatus:    34,
 		},
 		{
-			StartStatus:  []int{5},
-			AcceptObject: "SelectStatement",
+			StartStatus:  []int{22, 34},
+			AcceptObject: "MySQLDatabaseNameComponent",
 			AcceptValue:  "",
-			EndStatus:    6,
+			EndStatus:    FinalStatus,
 		},
 		{
-			StartStatus:  []int{6},
-			AcceptObject: "MySQLOperatorToken",
-			AcceptValue:  ")",
-			EndStatus:    7,
+			StartStatus:  []int{21},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "EVENT",
+			EndStatus:    23,
 		},
 		{
-			StartStatus:  []int{7, 12},
-			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "UNION",
-			EndStatus:    8,
+			StartStatus:  []int{23},
+			AcceptObject: "MySQLTableNameComponent",
+			AcceptValue:  "",
+			EndStatus:    FinalStatus,
 		},
 		{
-			StartStatus:  []int{8},
+			StartStatus:  []int{21},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "ALL",
-			EndStatus:    9,
+			AcceptValue:  "FUNCTION",
+			EndStatus:    23,
 		},
 		{
-			StartStatus:  []int{8},
+			StartStatus:  []int{21},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "DISTINCT",
-			EndStatus:    9,
+			AcceptValue:  "PROCEDURE",
+			EndStatus:    23,
 		},
 		{
-			StartStatus:  []int{8, 9},
-			AcceptObject: "MySQLOperatorToken",
-			AcceptValue:  "(",
-			EndStatus:    10,
+			StartStatus:  []int{21},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "TABLE",
+			EndStatus:    24,
 		},
 		{
-			StartStatus:  []int{10},
-			AcceptObject: "SelectStatement",
+			StartStatus:  []int{24},
+			AcceptObject: "MySQLTableNameComponent",
 			AcceptValue:  "",
-			EndStatus:    11,
+			EndStatus:    FinalStatus,
 		},
 		{
-			StartStatus:  []int{11},
-			AcceptObject: "MySQLOperatorToken",
-			AcceptValue:  ")",
-			EndStatus:    12,
+			StartStatus:  []int{21},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "TRIGGER",
+			EndStatus:    23,
 		},
 		{
-			StartStatus:  []int{12},
+			StartStatus:  []int{21},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "ORDER",
-			EndStatus:    13,
+			AcceptValue:  "VIEW",
+			EndStatus:    23,
 		},
 		{
-			StartStatus:  []int{13},
+			StartStatus:  []int{1},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "BY",
-			EndStatus:    14,
+			AcceptValue:  "DATABASES",
+			EndStatus:    13,
 		},
 		{
-			StartStatus:  []int{14},
-			AcceptObject: "MySQLExpressionComponent",
-			AcceptValue:  "",
-			EndStatus:    15,
+			StartStatus:  []int{1},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "SCHEMAS",
+			EndStatus:    13,
 		},
 		{
-			StartStatus:  []int{14},
-			AcceptObject: "MySQLColumnNameComponent",
-			AcceptValue:  "",
-			EndStatus:    15,
+			StartStatus:  []int{1},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "ENGINE",
+			EndStatus:    25,
 		},
 		{
-			StartStatus:  []int{14},
-			AcceptObject: "MySQLNumericToken",
+			StartStatus:  []int{25},
+			AcceptObject: "MySQLEngineNameComponent",
 			AcceptValue:  "",
-			EndStatus:    15,
+			EndStatus:    26,
 		},
 		{
-			StartStatus:  []int{15},
+			StartStatus:  []int{26, 42, 56},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "ASC",
-			EndStatus:    16,
+			AcceptValue:  "STATUS",
+			EndStatus:    FinalStatus,
 		},
 		{
-			StartStatus:  []int{15},
+			StartStatus:  []int{26},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "DESC",
-			EndStatus:    16,
-		},
-		{
-			StartStatus:  []int{16},
-			AcceptObject: "MySQLDelimiterToken",
-			AcceptValue:  ",",
-			EndStatus:    14,
+			AcceptValue:  "MUTEX",
+			EndStatus:    FinalStatus,
 		},
 		{
-			StartStatus:  []int{12, 15, 16},
+			StartStatus:  []int{1},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "LIMIT",
-			EndStatus:    17,
+			AcceptValue:  "STORAGE",
+			EndStatus:    27,
 		},
 		{
-			StartStatus:  []int{17},
-			AcceptObject: "MySQLNumericToken",
-			AcceptValue:  "",
-			EndStatus:    18,
+			StartStatus:  []int{1, 27},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "ENGINES",
+			EndStatus:    FinalStatus,
 		},
 		{
-			StartStatus:  []int{18},
-			AcceptObject: "MySQLDelimiterToken",
-			AcceptValue:  ",",
-			EndStatus:    19,
+			StartStatus:  []int{1},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "ERRORS",
+			EndStatus:    8,
 		},
 		{
-			StartStatus:  []int{18},
+			StartStatus:  []int{1},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "OFFSET",
-			EndStatus:    19,
+			AcceptValue:  "COUNT",
+			EndStatus:    35,
 		},
 		{
-			StartStatus:  []int{19},
-			AcceptObject: "MySQLNumericToken",
-			AcceptValue:  "",
-			EndStatus:    FinalStatus,
+			StartStatus:  []int{35},
+			AcceptObject: "MySQLOperatorToken",
+			AcceptValue:  "(",
+			EndStatus:    36,
 		},
-	}
-}
-
-func NewUnionStatement(tokenList MySQLTokenList,
-	verboseFunc func(message string, level LogLevel)) (MySQLStatement, MySQLTokenList) {
-	startPos := tokenList.CurrentPos()
-	s := &UnionStatement{
-		MySQLBaseStatement: &MySQLBaseStatement{
-			ObjectList: make([]*MySQLObject, 0),
+		{
+			StartStatus:  []int{36},
+			AcceptObject: "MySQLOperatorToken",
+			AcceptValue:  "*",
+			EndStatus:    37,
+		},
+		{
+			StartStatus:  []int{37},
+			AcceptObject: "MySQLOperatorToken",
+			AcceptValue:  ")",
+			EndStatus:    38,
 		},
-		DatabaseList: make([]string, 0),
-		TableList:    make([]string, 0),
-	}
-	endPos := s.ParseByFsm(s.GetFsmMap(), tokenList, []int{4, 12, 15, 16, 18}, verboseFunc)
-	if endPos == -1 {
-		tokenList.Reset(startPos)
-		return nil, tokenList
-	} else {
-		for _, t := range s.ObjectList {
-			if (*t).Type() == "SelectStatement" {
-				s.DatabaseList = append(s.DatabaseList, (*t).(*SelectStatement).DatabaseList...)
-				s.TableList = append(s.TableList, (*t).(*SelectStatement).TableList...)
-			}
-		}
-		tokenList.Reset(endPos)
-		return s, tokenList
-	}
-}
-
-// 13.7.4.1 SET Syntax for Variable Assignment
-// SET variable_assignment [, variable_assignment] ...
-//
-// variable_assignment:
-//      user_var_name = expr
-//    | param_name = expr
-//    | local_var_name = expr
-//    | [GLOBAL | SESSION]
-//        system_var_name = expr
-//    | [@@global. | @@session. | @@]
-//        system_var_name = expr
-//
-// SET ONE_SHOT system_var_name = expr
-//
-// 13.7.4.2 SET CHARACTER SET Syntax
-// SET {CHARACTER SET | CHARSET}
-//    {'charset_name' | DEFAULT}
-//
-// 13.7.4.3 SET NAMES Syntax
-// SET NAMES {'charset_name'
-//    [COLLATE 'collation_name'] | DEFAULT}
-
-type SetStatement struct {
-	*MySQLBaseStatement
-}
-
-func (s *SetStatement) Type() string {
-	return "SetStatement"
-}
-
-func (s *SetStatement) GetFsmMap() []FsmMap {
-	return []FsmMap{
 		{
-			StartStatus:  []int{0},
+			StartStatus:  []int{38},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "SET",
-			EndStatus:    1,
+			AcceptValue:  "ERRORS",
+			EndStatus:    FinalStatus,
 		},
 		{
-			StartStatus:  []int{1, 6},
+			StartStatus:  []int{1},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "GLOBAL",
-			EndStatus:    2,
+			AcceptValue:  "EVENTS",
+			EndStatus:    19,
 		},
 		{
-			StartStatus:  []int{1, 6},
+			StartStatus:  []int{1},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "SESSION",
-			EndStatus:    2,
+			AcceptValue:  "FUNCTION",
+			EndStatus:    28,
 		},
 		{
-			StartStatus:  []int{1, 6},
-			AcceptObject: "MySQLVariableToken",
-			AcceptValue:  "",
-			EndStatus:    3,
+			StartStatus:  []int{28},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "CODE",
+			EndStatus:    29,
 		},
 		{
-			StartStatus:  []int{1, 2, 6},
-			AcceptObject: "MySQLUnquotedIdentifierToken",
+			StartStatus:  []int{29},
+			AcceptObject: "MySQLTableNameComponent",
 			AcceptValue:  "",
-			EndStatus:    3,
+			EndStatus:    FinalStatus,
 		},
 		{
-			StartStatus:  []int{1, 2, 6},
+			StartStatus:  []int{28},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "",
-			EndStatus:    3,
+			AcceptValue:  "STATUS",
+			EndStatus:    13,
 		},
 		{
-			StartStatus:  []int{3},
-			AcceptObject: "MySQLOperatorToken",
-			AcceptValue:  "=",
-			EndStatus:    4,
+			StartStatus:  []int{1},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "GRANTS",
+			EndStatus:    30,
 		},
 		{
-			StartStatus:  []int{4},
-			AcceptObject: "MySQLExpressionComponent",
-			AcceptValue:  "",
-			EndStatus:    5,
+			StartStatus:  []int{30},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "FOR",
+			EndStatus:    31,
 		},
 		{
-			StartStatus:  []int{5},
-			AcceptObject: "MySQLDelimiterToken",
-			AcceptValue:  ",",
-			EndStatus:    6,
+			StartStatus:  []int{31},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "CURRENT_USER",
+			EndStatus:    39,
 		},
 		{
-			StartStatus:  []int{1},
-			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "ONE_SHOT",
-			EndStatus:    7,
+			StartStatus:  []int{39},
+			AcceptObject: "MySQLOperatorToken",
+			AcceptValue:  "(",
+			EndStatus:    40,
 		},
 		{
-			StartStatus:  []int{7},
-			AcceptObject: "MySQLUnquotedIdentifierToken",
+			StartStatus:  []int{40},
+			AcceptObject: "MySQLOperatorToken",
+			AcceptValue:  ")",
+			EndStatus:    FinalStatus,
+		},
+		{
+			StartStatus:  []int{31},
+			AcceptObject: "MySQLStringToken",
 			AcceptValue:  "",
-			EndStatus:    8,
+			EndStatus:    41,
 		},
 		{
-			StartStatus:  []int{7},
-			AcceptObject: "MySQLKeywordToken",
+			StartStatus:  []int{41},
+			AcceptObject: "MySQLVariableToken",
 			AcceptValue:  "",
-			EndStatus:    8,
+			EndStatus:    FinalStatus,
 		},
 		{
-			StartStatus:  []int{8},
-			AcceptObject: "MySQLOperatorToken",
-			AcceptValue:  "=",
-			EndStatus:    9,
+			StartStatus:  []int{1},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "INDEX",
+			EndStatus:    17,
 		},
 		{
-			StartStatus:  []int{9},
-			AcceptObject: "MySQLExpressionComponent",
-			AcceptValue:  "",
-			EndStatus:    FinalStatus,
+			StartStatus:  []int{1},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "INDEXES",
+			EndStatus:    17,
 		},
 		{
 			StartStatus:  []int{1},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "CHARACTER",
-			EndStatus:    10,
+			AcceptValue:  "KEYS",
+			EndStatus:    17,
 		},
 		{
-			StartStatus:  []int{10},
+			StartStatus:  []int{1},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "SET",
-			EndStatus:    11,
+			AcceptValue:  "MASTER",
+			EndStatus:    42,
 		},
 		{
 			StartStatus:  []int{1},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "CHARSET",
-			EndStatus:    11,
+			AcceptValue:  "OPEN",
+			EndStatus:    43,
 		},
 		{
-			StartStatus:  []int{11},
+			StartStatus:  []int{43},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "DEFAULT",
+			AcceptValue:  "TABLES",
+			EndStatus:    19,
+		},
+		{
+			StartStatus:  []int{1},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "PLUGINS",
 			EndStatus:    FinalStatus,
 		},
 		{
-			StartStatus:  []int{11},
-			AcceptObject: "MySQLCharsetNameComponent",
-			AcceptValue:  "",
+			StartStatus:  []int{1},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "PRIVILEGES",
 			EndStatus:    FinalStatus,
 		},
 		{
 			StartStatus:  []int{1},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "NAMES",
-			EndStatus:    12,
+			AcceptValue:  "PROCEDURE",
+			EndStatus:    28,
 		},
 		{
-			StartStatus:  []int{12},
+			StartStatus:  []int{1, 16},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "DEFAULT",
+			AcceptValue:  "PROCESSLIST",
 			EndStatus:    FinalStatus,
 		},
 		{
-			StartStatus:  []int{12},
-			AcceptObject: "MySQLCharsetNameComponent",
-			AcceptValue:  "",
-			EndStatus:    13,
+			StartStatus:  []int{1},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "PROFILE",
+			EndStatus:    44,
 		},
 		{
-			StartStatus:  []int{13},
+			StartStatus:  []int{44, 49},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "COLLATE",
-			EndStatus:    14,
+			AcceptValue:  "ALL",
+			EndStatus:    45,
 		},
 		{
-			StartStatus:  []int{14},
-			AcceptObject: "MySQLCollationNameComponent",
-			AcceptValue:  "",
-			EndStatus:    FinalStatus,
+			StartStatus:  []int{44, 49},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "BLOCK",
+			EndStatus:    46,
 		},
-	}
-}
-
-func NewSetStatement(tokenList MySQLTokenList,
-	verboseFunc func(message string, level LogLevel)) (MySQLStatement, MySQLTokenList) {
-	startPos := tokenList.CurrentPos()
-	s := &SetStatement{
-		MySQLBaseStatement: &MySQLBaseStatement{
-			ObjectList: make([]*MySQLObject, 0),
+		{
+			StartStatus:  []int{46},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "IO",
+			EndStatus:    45,
 		},
-	}
-	endPos := s.ParseByFsm(s.GetFsmMap(), tokenList, []int{5, 13}, verboseFunc)
-	if endPos == -1 {
-		tokenList.Reset(startPos)
-		return nil, tokenList
-	} else {
-		tokenList.Reset(endPos)
-		return s, tokenList
-	}
-}
-
-// 13.7.5 SHOW Syntax
-// SHOW AUTHORS
-// SHOW {BINARY | MASTER} LOGS
-// SHOW BINLOG EVENTS [IN 'log_name'] [FROM pos] [LIMIT [offset,] row_count]
-// SHOW CHARACTER SET [like_or_where]
-// SHOW COLLATION [like_or_where]
-// SHOW [FULL] {COLUMNS | FIELDS} {FROM | IN} tbl_name [{FROM | IN} db_name] [like_or_where]
-// SHOW CONTRIBUTORS
-// SHOW CREATE {DATABASE | SCHEMA} [IF NOT EXISTS] db_name
-// SHOW CREATE EVENT event_name
-// SHOW CREATE FUNCTION func_name
-// SHOW CREATE PROCEDURE proc_name
-// SHOW CREATE TABLE tbl_name
-// SHOW CREATE TRIGGER trigger_name
-// SHOW CREATE VIEW view_name
-// SHOW {DATABASES | SCHEMAS} [like_or_where]
-// SHOW ENGINE engine_name {STATUS | MUTEX}
-// SHOW [STORAGE] ENGINES
-// SHOW ERRORS [LIMIT [offset,] row_count]
-// SHOW COUNT(*) ERRORS
-// SHOW EVENTS [{FROM | IN} db_name} [like_or_where]
-// SHOW FUNCTION CODE func_name
-// SHOW FUNCTION STATUS [like_or_where]
-// SHOW GRANTS [FOR user]
-// SHOW {INDEX | INDEXES | KEYS} {FROM | IN} tbl_name [{FROM | IN} db_name] [like_or_where]
-// SHOW MASTER STATUS
-// SHOW OPEN TABLES [{FROM | IN} db_name] [like_or_where]
-// SHOW PLUGINS
-// SHOW PROCEDURE CODE proc_name
-// SHOW PROCEDURE STATUS [like_or_where]
-// SHOW PRIVILEGES
-// SHOW [FULL] PROCESSLIST
-// SHOW PROFILE [types] [FOR QUERY n] [LIMIT row_count [OFFSET offset]]
-// SHOW PROFILES
-// SHOW RELAYLOG EVENTS [IN 'log_name'] [FROM pos] [LIMIT [offset,] row_count]
-// SHOW SLAVE HOSTS
-// SHOW SLAVE STATUS
-// SHOW [GLOBAL | SESSION] STATUS [like_or_where]
-// SHOW TABLE STATUS [{FROM | IN} db_name] [like_or_where]
-// SHOW [FULL] TABLES [{FROM | IN} db_name] [like_or_where]
-// SHOW TRIGGERS [{FROM | IN} db_name] [like_or_where]
-// SHOW [GLOBAL | SESSION] VARIABLES [like_or_where]
-// SHOW WARNINGS [LIMIT [offset,] row_count]
-// SHOW COUNT(*) WARNINGS
-//
-// like_or_where:
-//    LIKE 'pattern'
-//  | WHERE expr
-
-type ShowStatement struct {
-	*MySQLBaseStatement
-	DatabaseList []string
-	TableList    []string
-}
-
-func (s *ShowStatement) Type() string {
-	return "ShowStatement"
-}
-
-func (s *ShowStatement) GetFsmMap() []FsmMap {
-	return []FsmMap{
 		{
-			StartStatus:  []int{0},
+			StartStatus:  []int{44, 49},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "SHOW",
-			EndStatus:    1,
+			AcceptValue:  "CONTEXT",
+			EndStatus:    47,
+		},
+		{
+			StartStatus:  []int{47},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "SWITCHES",
+			EndStatus:    45,
 		},
 		{
-			StartStatus:  []int{1},
+			StartStatus:  []int{44, 49},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "AUTHORS",
-			EndStatus:    FinalStatus,
+			AcceptValue:  "CPU",
+			EndStatus:    45,
 		},
 		{
-			StartStatus:  []int{1},
+			StartStatus:  []int{44, 49},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "BINARY",
-			EndStatus:    2,
+			AcceptValue:  "IPC",
+			EndStatus:    45,
 		},
 		{
-			StartStatus:  []int{1},
+			StartStatus:  []int{44, 49},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "MASTER",
-			EndStatus:    2,
+			AcceptValue:  "MEMORY",
+			EndStatus:    45,
 		},
 		{
-			StartStatus:  []int{2},
+			StartStatus:  []int{44, 49},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "LOGS",
-			EndStatus:    FinalStatus,
+			AcceptValue:  "PAGE",
+			EndStatus:    48,
 		},
 		{
-			StartStatus:  []int{1},
+			StartStatus:  []int{48},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "BINLOG",
-			EndStatus:    3,
+			AcceptValue:  "FAULTS",
+			EndStatus:    45,
 		},
 		{
-			StartStatus:  []int{3},
+			StartStatus:  []int{44, 49},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "EVENTS",
-			EndStatus:    4,
+			AcceptValue:  "SOURCE",
+			EndStatus:    45,
 		},
 		{
-			StartStatus:  []int{4},
+			StartStatus:  []int{44, 49},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "IN",
-			EndStatus:    5,
+			AcceptValue:  "SWAPS",
+			EndStatus:    45,
 		},
 		{
-			StartStatus:  []int{5},
-			AcceptObject: "MySQLStringToken",
-			AcceptValue:  "",
-			EndStatus:    6,
+			StartStatus:  []int{45},
+			AcceptObject: "MySQLDelimiterToken",
+			AcceptValue:  ",",
+			EndStatus:    49,
 		},
 		{
-			StartStatus:  []int{4, 6},
+			StartStatus:  []int{44, 45},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "FROM",
-			EndStatus:    7,
+			AcceptValue:  "FOR",
+			EndStatus:    50,
 		},
 		{
-			StartStatus:  []int{7},
+			StartStatus:  []int{50},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "QUERY",
+			EndStatus:    51,
+		},
+		{
+			StartStatus:  []int{51},
 			AcceptObject: "MySQLNumericToken",
 			AcceptValue:  "",
-			EndStatus:    8,
+			EndStatus:    52,
 		},
 		{
-			StartStatus:  []int{4, 6, 8},
+			StartStatus:  []int{44, 45, 52},
 			AcceptObject: "MySQLKeywordToken",
 			AcceptValue:  "LIMIT",
-			EndStatus:    9,
+			EndStatus:    53,
 		},
 		{
-			StartStatus:  []int{9},
+			StartStatus:  []int{53},
 			AcceptObject: "MySQLNumericToken",
 			AcceptValue:  "",
-			EndStatus:    10,
+			EndStatus:    54,
 		},
 		{
-			StartStatus:  []int{10},
-			AcceptObject: "MySQLDelimiterToken",
-			AcceptValue:  ",",
-			EndStatus:    11,
+			StartStatus:  []int{54},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "OFFSET",
+			EndStatus:    55,
 		},
 		{
-			StartStatus:  []int{11},
+			StartStatus:  []int{55},
 			AcceptObject: "MySQLNumericToken",
 			AcceptValue:  "",
 			EndStatus:    FinalStatus,
@@ -3000,659 +6640,1150 @@ func (s *ShowStatement) GetFsmMap() []FsmMap {
 		{
 			StartStatus:  []int{1},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "CHARACTER",
-			EndStatus:    12,
+			AcceptValue:  "PROFILES",
+			EndStatus:    FinalStatus,
 		},
 		{
-			StartStatus:  []int{12},
+			StartStatus:  []int{1},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "SET",
-			EndStatus:    13,
+			AcceptValue:  "RELAYLOG",
+			EndStatus:    3,
 		},
 		{
 			StartStatus:  []int{1},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "CHARSET",
-			EndStatus:    13,
+			AcceptValue:  "SLAVE",
+			EndStatus:    56,
 		},
 		{
-			StartStatus:  []int{13, 19},
+			StartStatus:  []int{56},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "LIKE",
-			EndStatus:    14,
-		},
-		{
-			StartStatus:  []int{14},
-			AcceptObject: "MySQLStringToken",
-			AcceptValue:  "",
+			AcceptValue:  "HOSTS",
 			EndStatus:    FinalStatus,
 		},
 		{
-			StartStatus:  []int{13, 19},
+			StartStatus:  []int{1},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "WHERE",
-			EndStatus:    15,
+			AcceptValue:  "GLOBAL",
+			EndStatus:    57,
 		},
 		{
-			StartStatus:  []int{15},
-			AcceptObject: "MySQLExpressionComponent",
-			AcceptValue:  "",
-			EndStatus:    FinalStatus,
+			StartStatus:  []int{1},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "SESSION",
+			EndStatus:    57,
 		},
 		{
-			StartStatus:  []int{1},
+			StartStatus:  []int{1, 57},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "COLLATION",
+			AcceptValue:  "STATUS",
 			EndStatus:    13,
 		},
 		{
 			StartStatus:  []int{1},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "FULL",
-			EndStatus:    16,
+			AcceptValue:  "TABLE",
+			EndStatus:    58,
 		},
 		{
-			StartStatus:  []int{1, 16},
+			StartStatus:  []int{58},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "COLUMNS",
-			EndStatus:    17,
+			AcceptValue:  "STATUS",
+			EndStatus:    19,
 		},
 		{
 			StartStatus:  []int{1, 16},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "FIELDS",
-			EndStatus:    17,
+			AcceptValue:  "TABLES",
+			EndStatus:    19,
 		},
 		{
-			StartStatus:  []int{17},
+			StartStatus:  []int{1},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "FROM",
-			EndStatus:    18,
+			AcceptValue:  "TRIGGERS",
+			EndStatus:    19,
 		},
 		{
-			StartStatus:  []int{17},
+			StartStatus:  []int{1, 57},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "IN",
-			EndStatus:    18,
+			AcceptValue:  "VARIABLES",
+			EndStatus:    13,
 		},
 		{
-			StartStatus:  []int{18},
-			AcceptObject: "MySQLTableNameComponent",
-			AcceptValue:  "",
-			EndStatus:    19,
+			StartStatus:  []int{1},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "WARNINGS",
+			EndStatus:    8,
 		},
 		{
-			StartStatus:  []int{19},
+			StartStatus:  []int{38},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "FROM",
-			EndStatus:    20,
+			AcceptValue:  "WARNINGS",
+			EndStatus:    FinalStatus,
+		},
+	}
+}
+
+func NewShowStatement(tokenList MySQLTokenList,
+	verboseFunc func(message string, level LogLevel)) (MySQLStatement, MySQLTokenList) {
+	startPos := tokenList.CurrentPos()
+	s := &ShowStatement{
+		MySQLBaseStatement: &MySQLBaseStatement{
+			ObjectList: make([]*MySQLObject, 0),
+		},
+		DatabaseList: make([]string, 0),
+		TableList:    make([]string, 0),
+	}
+	endPos := s.ParseByFsm(s.GetFsmMap(), tokenList, []int{4, 6, 8, 10, 13, 19, 30, 39, 44, 45, 52, 54}, verboseFunc)
+	if endPos == -1 {
+		tokenList.Reset(startPos)
+		return nil, tokenList
+	} else {
+		kindKey := ""
+		kindDone := false
+		lastKeyword := ""
+		for _, t := range s.ObjectList {
+			if (*t).Type() == "MySQLKeywordToken" {
+				keyword := strings.ToUpper((*t).Value())
+				if lastKeyword == "SHOW" && keyword == "FULL" {
+					s.Full = true
+				} else if lastKeyword == "SHOW" && keyword == "GLOBAL" {
+					s.Scope = VariableScopeGlobal
+				} else if lastKeyword == "SHOW" && keyword == "SESSION" {
+					s.Scope = VariableScopeSession
+				} else if keyword == "COUNT" {
+					s.Count = true
+				} else if InArray(keyword, []string{"LIKE", "WHERE", "FROM", "IN", "FOR", "LIMIT", "IF"}) {
+					kindDone = true
+				}
+				if keyword != "SHOW" && keyword != "FULL" && keyword != "GLOBAL" && keyword != "SESSION" &&
+					keyword != "STORAGE" && !kindDone {
+					if kindKey == "" {
+						kindKey = keyword
+					} else {
+						kindKey += " " + keyword
+					}
+					if kind, ok := showKindMap[kindKey]; ok {
+						s.Kind = kind
+					}
+				}
+				lastKeyword = keyword
+			} else if (*t).Type() == "MySQLStringToken" && lastKeyword == "LIKE" {
+				s.Like = unquoteString((*t).Value())
+			} else if (*t).Type() == "MySQLIdentifierComponent" {
+				s.Object = strings.Trim((*t).Value(), "`")
+			} else if (*t).Type() == "MySQLEngineNameComponent" {
+				s.Object = (*t).(*MySQLEngineNameComponent).Engine
+			}
+			if (*t).Type() == "MySQLDatabaseNameComponent" {
+				s.Database = (*t).(*MySQLDatabaseNameComponent).Database
+				s.DatabaseList = append(s.DatabaseList, (*t).(*MySQLDatabaseNameComponent).Database)
+				s.TableList = append(s.TableList, "")
+			} else if (*t).Type() == "MySQLTableNameComponent" &&
+				InArray(lastKeyword, []string{"EVENT", "FUNCTION", "PROCEDURE", "TRIGGER", "VIEW", "CODE"}) {
+				// [db_name.]name形式的对象名
+				s.Database = (*t).(*MySQLTableNameComponent).Database
+				s.Object = (*t).(*MySQLTableNameComponent).Table
+			} else if (*t).Type() == "MySQLTableNameComponent" {
+				if (*t).(*MySQLTableNameComponent).Database != "" {
+					s.Database = (*t).(*MySQLTableNameComponent).Database
+				}
+				s.Table = (*t).(*MySQLTableNameComponent).Table
+				s.DatabaseList = append(s.DatabaseList, (*t).(*MySQLTableNameComponent).Database)
+				s.TableList = append(s.TableList, (*t).(*MySQLTableNameComponent).Table)
+			} else if (*t).Type() == "MySQLExpressionComponent" {
+				s.Where = (*t).(*MySQLExpressionComponent)
+				for _, tmpT := range (*t).(*MySQLExpressionComponent).ObjectList {
+					if (*tmpT).Type() == "SubQueryComponent" {
+						s.DatabaseList = append(s.DatabaseList, (*tmpT).(*SubQueryComponent).DatabaseList...)
+						s.TableList = append(s.TableList, (*tmpT).(*SubQueryComponent).TableList...)
+					}
+				}
+			}
+		}
+		tokenList.Reset(endPos)
+		return s, tokenList
+	}
+}
+
+// 13.7.6.7 SHUTDOWN Syntax
+// SHUTDOWN
+
+type ShutdownStatement struct {
+	*MySQLBaseStatement
+}
+
+func (s *ShutdownStatement) Type() string {
+	return "ShutdownStatement"
+}
+
+func (s *ShutdownStatement) GetFsmMap() []FsmMap {
+	return []FsmMap{
+		{
+			StartStatus:  []int{0},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "SHUTDOWN",
+			EndStatus:    FinalStatus,
+		},
+	}
+}
+
+func NewShutdownStatement(tokenList MySQLTokenList,
+	verboseFunc func(message string, level LogLevel)) (MySQLStatement, MySQLTokenList) {
+	startPos := tokenList.CurrentPos()
+	s := &ShutdownStatement{
+		MySQLBaseStatement: &MySQLBaseStatement{
+			ObjectList: make([]*MySQLObject, 0),
 		},
+	}
+	endPos := s.ParseByFsm(s.GetFsmMap(), tokenList, []int{}, verboseFunc)
+	if endPos == -1 {
+		tokenList.Reset(startPos)
+		return nil, tokenList
+	} else {
+		tokenList.Reset(endPos)
+		return s, tokenList
+	}
+}
+
+// 13.4.2.5 START SLAVE Syntax
+// START {SLAVE | REPLICA} [thread_types] [until_option] [connection_options] [channel_option]
+//
+// thread_types:
+//    [thread_type [, thread_type] ... ]
+//
+// thread_type:
+//    IO_THREAD | SQL_THREAD
+//
+// until_option:
+//    UNTIL {   {SQL_BEFORE_GTIDS | SQL_AFTER_GTIDS} = gtid_set
+//          |   MASTER_LOG_FILE = 'log_name', MASTER_LOG_POS = log_pos
+//          |   RELAY_LOG_FILE = 'log_name', RELAY_LOG_POS = log_pos
+//          |   SQL_AFTER_MTS_GAPS  }
+//
+// connection_options:
+//    [USER='user_name'] [PASSWORD='user_pass'] [DEFAULT_AUTH='plugin_name'] [PLUGIN_DIR='plugin_dir']
+//
+// channel_option:
+//    FOR CHANNEL channel
+
+type StartSlaveStatement struct {
+	*MySQLBaseStatement
+	ThreadList        []string
+	UntilMap          map[string]string
+	UntilAfterMtsGaps bool
+	User              string
+	Password          string
+	DefaultAuth       string
+	PluginDir         string
+	Channel           string
+}
+
+func (s *StartSlaveStatement) Type() string {
+	return "StartSlaveStatement"
+}
+
+func (s *StartSlaveStatement) Value() string {
+	return redactReplicationPassword(s.ObjectList)
+}
+
+func (s *StartSlaveStatement) GetFsmMap() []FsmMap {
+	return []FsmMap{
 		{
-			StartStatus:  []int{19},
+			StartStatus:  []int{0},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "IN",
-			EndStatus:    20,
-		},
-		{
-			StartStatus:  []int{20},
-			AcceptObject: "MySQLDatabaseNameComponent",
-			AcceptValue:  "",
-			EndStatus:    13,
+			AcceptValue:  "START",
+			EndStatus:    1,
 		},
 		{
 			StartStatus:  []int{1},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "CONTRIBUTORS",
-			EndStatus:    FinalStatus,
+			AcceptValue:  "SLAVE",
+			EndStatus:    2,
 		},
 		{
 			StartStatus:  []int{1},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "CREATE",
-			EndStatus:    21,
+			AcceptValue:  "REPLICA",
+			EndStatus:    2,
 		},
 		{
-			StartStatus:  []int{21},
+			StartStatus:  []int{2, 4},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "DATABASE",
-			EndStatus:    22,
+			AcceptValue:  "IO_THREAD",
+			EndStatus:    3,
 		},
 		{
-			StartStatus:  []int{21},
+			StartStatus:  []int{2, 4},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "SCHEMA",
-			EndStatus:    22,
+			AcceptValue:  "SQL_THREAD",
+			EndStatus:    3,
 		},
 		{
-			StartStatus:  []int{22},
-			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "IF",
-			EndStatus:    32,
+			StartStatus:  []int{3},
+			AcceptObject: "MySQLDelimiterToken",
+			AcceptValue:  ",",
+			EndStatus:    4,
 		},
 		{
-			StartStatus:  []int{32},
+			StartStatus:  []int{2, 3},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "NOT",
-			EndStatus:    33,
+			AcceptValue:  "UNTIL",
+			EndStatus:    5,
 		},
 		{
-			StartStatus:  []int{33},
+			StartStatus:  []int{5},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "EXISTS",
-			EndStatus:    34,
+			AcceptValue:  "SQL_AFTER_MTS_GAPS",
+			EndStatus:    6,
 		},
 		{
-			StartStatus:  []int{22, 34},
-			AcceptObject: "MySQLDatabaseNameComponent",
+			StartStatus:  []int{5, 7},
+			AcceptObject: "MySQLReplicationOptionComponent",
 			AcceptValue:  "",
-			EndStatus:    FinalStatus,
+			EndStatus:    6,
 		},
 		{
-			StartStatus:  []int{21},
-			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "EVENT",
-			EndStatus:    23,
+			StartStatus:  []int{6},
+			AcceptObject: "MySQLDelimiterToken",
+			AcceptValue:  ",",
+			EndStatus:    7,
 		},
 		{
-			StartStatus:  []int{23},
-			AcceptObject: "MySQLIdentifierComponent",
+			StartStatus:  []int{2, 3, 6, 8},
+			AcceptObject: "MySQLReplicationOptionComponent",
 			AcceptValue:  "",
-			EndStatus:    FinalStatus,
-		},
-		{
-			StartStatus:  []int{21},
-			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "FUNCTION",
-			EndStatus:    23,
+			EndStatus:    8,
 		},
 		{
-			StartStatus:  []int{21},
+			StartStatus:  []int{2, 3, 6, 8},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "PROCEDURE",
-			EndStatus:    23,
+			AcceptValue:  "FOR",
+			EndStatus:    9,
 		},
 		{
-			StartStatus:  []int{21},
+			StartStatus:  []int{9},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "TABLE",
-			EndStatus:    24,
+			AcceptValue:  "CHANNEL",
+			EndStatus:    10,
 		},
 		{
-			StartStatus:  []int{24},
-			AcceptObject: "MySQLTableNameComponent",
+			StartStatus:  []int{10},
+			AcceptObject: "MySQLIdentifierComponent",
 			AcceptValue:  "",
 			EndStatus:    FinalStatus,
 		},
 		{
-			StartStatus:  []int{21},
-			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "TRIGGER",
-			EndStatus:    23,
+			StartStatus:  []int{10},
+			AcceptObject: "MySQLStringToken",
+			AcceptValue:  "",
+			EndStatus:    FinalStatus,
 		},
-		{
-			StartStatus:  []int{21},
-			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "VIEW",
-			EndStatus:    23,
+	}
+}
+
+func NewStartSlaveStatement(tokenList MySQLTokenList,
+	verboseFunc func(message string, level LogLevel)) (MySQLStatement, MySQLTokenList) {
+	startPos := tokenList.CurrentPos()
+	s := &StartSlaveStatement{
+		MySQLBaseStatement: &MySQLBaseStatement{
+			ObjectList: make([]*MySQLObject, 0),
 		},
+		ThreadList: make([]string, 0),
+		UntilMap:   make(map[string]string),
+	}
+	endPos := s.ParseByFsm(s.GetFsmMap(), tokenList, []int{2, 3, 6, 8}, verboseFunc)
+	if endPos == -1 {
+		tokenList.Reset(startPos)
+		return nil, tokenList
+	} else {
+		for _, t := range s.ObjectList {
+			if (*t).Type() == "MySQLKeywordToken" && InArray((*t).Value(), []string{"IO_THREAD", "SQL_THREAD"}) {
+				s.ThreadList = append(s.ThreadList, (*t).Value())
+			} else if (*t).Type() == "MySQLKeywordToken" && (*t).Value() == "SQL_AFTER_MTS_GAPS" {
+				s.UntilAfterMtsGaps = true
+			} else if (*t).Type() == "MySQLReplicationOptionComponent" {
+				option := (*t).(*MySQLReplicationOptionComponent)
+				switch option.Name {
+				case "USER":
+					s.User = option.OptionValue
+				case "PASSWORD":
+					s.Password = option.OptionValue
+				case "DEFAULT_AUTH":
+					s.DefaultAuth = option.OptionValue
+				case "PLUGIN_DIR":
+					s.PluginDir = option.OptionValue
+				default:
+					s.UntilMap[option.Name] = option.OptionValue
+				}
+			} else if (*t).Type() == "MySQLIdentifierComponent" {
+				s.Channel = strings.Trim((*t).Value(), "`")
+			} else if (*t).Type() == "MySQLStringToken" {
+				s.Channel = unquoteString((*t).Value())
+			}
+		}
+		tokenList.Reset(endPos)
+		return s, tokenList
+	}
+}
+
+// 13.4.2.6 STOP SLAVE Syntax
+// STOP {SLAVE | REPLICA} [thread_types] [channel_option]
+//
+// thread_types:
+//    [thread_type [, thread_type] ... ]
+//
+// thread_type: IO_THREAD | SQL_THREAD
+//
+// channel_option:
+//    FOR CHANNEL channel
+
+type StopSlaveStatement struct {
+	*MySQLBaseStatement
+	ThreadList []string
+	Channel    string
+}
+
+func (s *StopSlaveStatement) Type() string {
+	return "StopSlaveStatement"
+}
+
+func (s *StopSlaveStatement) GetFsmMap() []FsmMap {
+	return []FsmMap{
 		{
-			StartStatus:  []int{1},
+			StartStatus:  []int{0},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "DATABASES",
-			EndStatus:    13,
+			AcceptValue:  "STOP",
+			EndStatus:    1,
 		},
 		{
 			StartStatus:  []int{1},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "SCHEMAS",
-			EndStatus:    13,
+			AcceptValue:  "SLAVE",
+			EndStatus:    2,
 		},
 		{
 			StartStatus:  []int{1},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "ENGINE",
-			EndStatus:    25,
-		},
-		{
-			StartStatus:  []int{25},
-			AcceptObject: "MySQLEngineNameComponent",
-			AcceptValue:  "",
-			EndStatus:    26,
-		},
-		{
-			StartStatus:  []int{26, 42, 56},
-			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "STATUS",
-			EndStatus:    FinalStatus,
+			AcceptValue:  "REPLICA",
+			EndStatus:    2,
 		},
 		{
-			StartStatus:  []int{26},
+			StartStatus:  []int{2, 4},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "MUTEX",
-			EndStatus:    FinalStatus,
+			AcceptValue:  "IO_THREAD",
+			EndStatus:    3,
 		},
 		{
-			StartStatus:  []int{1},
+			StartStatus:  []int{2, 4},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "STORAGE",
-			EndStatus:    27,
+			AcceptValue:  "SQL_THREAD",
+			EndStatus:    3,
 		},
 		{
-			StartStatus:  []int{1, 27},
-			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "ENGINES",
-			EndStatus:    FinalStatus,
+			StartStatus:  []int{3},
+			AcceptObject: "MySQLDelimiterToken",
+			AcceptValue:  ",",
+			EndStatus:    4,
 		},
 		{
-			StartStatus:  []int{1},
+			StartStatus:  []int{2, 3},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "ERRORS",
-			EndStatus:    8,
+			AcceptValue:  "FOR",
+			EndStatus:    5,
 		},
 		{
-			StartStatus:  []int{1},
+			StartStatus:  []int{5},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "COUNT",
-			EndStatus:    35,
-		},
-		{
-			StartStatus:  []int{35},
-			AcceptObject: "MySQLOperatorToken",
-			AcceptValue:  "(",
-			EndStatus:    36,
-		},
-		{
-			StartStatus:  []int{36},
-			AcceptObject: "MySQLOperatorToken",
-			AcceptValue:  "*",
-			EndStatus:    37,
-		},
-		{
-			StartStatus:  []int{37},
-			AcceptObject: "MySQLOperatorToken",
-			AcceptValue:  ")",
-			EndStatus:    38,
+			AcceptValue:  "CHANNEL",
+			EndStatus:    6,
 		},
 		{
-			StartStatus:  []int{38},
-			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "ERRORS",
+			StartStatus:  []int{6},
+			AcceptObject: "MySQLIdentifierComponent",
+			AcceptValue:  "",
 			EndStatus:    FinalStatus,
 		},
 		{
-			StartStatus:  []int{1},
-			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "EVENTS",
-			EndStatus:    19,
+			StartStatus:  []int{6},
+			AcceptObject: "MySQLStringToken",
+			AcceptValue:  "",
+			EndStatus:    FinalStatus,
 		},
-		{
-			StartStatus:  []int{1},
-			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "FUNCTION",
-			EndStatus:    28,
+	}
+}
+
+func NewStopSlaveStatement(tokenList MySQLTokenList,
+	verboseFunc func(message string, level LogLevel)) (MySQLStatement, MySQLTokenList) {
+	startPos := tokenList.CurrentPos()
+	s := &StopSlaveStatement{
+		MySQLBaseStatement: &MySQLBaseStatement{
+			ObjectList: make([]*MySQLObject, 0),
 		},
+		ThreadList: make([]string, 0),
+	}
+	endPos := s.ParseByFsm(s.GetFsmMap(), tokenList, []int{2, 3}, verboseFunc)
+	if endPos == -1 {
+		tokenList.Reset(startPos)
+		return nil, tokenList
+	} else {
+		for _, t := range s.ObjectList {
+			if (*t).Type() == "MySQLKeywordToken" && InArray((*t).Value(), []string{"IO_THREAD", "SQL_THREAD"}) {
+				s.ThreadList = append(s.ThreadList, (*t).Value())
+			} else if (*t).Type() == "MySQLIdentifierComponent" {
+				s.Channel = strings.Trim((*t).Value(), "`")
+			} else if (*t).Type() == "MySQLStringToken" {
+				s.Channel = unquoteString((*t).Value())
+			}
+		}
+		tokenList.Reset(endPos)
+		return s, tokenList
+	}
+}
+
+// 13.2.12 TABLE Syntax
+// TABLE table_name [ORDER BY column_name] [LIMIT number [OFFSET number]]
+
+type TableStatement struct {
+	*MySQLBaseStatement
+	DatabaseList []string
+	TableList    []string
+	OrderBy      *MySQLOrderListOptionComponent
+	Limit        string
+	Offset       string
+}
+
+func (s *TableStatement) Type() string {
+	return "TableStatement"
+}
+
+func (s *TableStatement) GetFsmMap() []FsmMap {
+	return []FsmMap{
 		{
-			StartStatus:  []int{28},
+			StartStatus:  []int{0},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "CODE",
-			EndStatus:    29,
+			AcceptValue:  "TABLE",
+			EndStatus:    1,
 		},
 		{
-			StartStatus:  []int{29},
-			AcceptObject: "MySQLIdentifierComponent",
+			StartStatus:  []int{1},
+			AcceptObject: "MySQLTableNameComponent",
 			AcceptValue:  "",
-			EndStatus:    FinalStatus,
+			EndStatus:    2,
 		},
 		{
-			StartStatus:  []int{28},
+			StartStatus:  []int{2},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "STATUS",
-			EndStatus:    13,
+			AcceptValue:  "ORDER",
+			EndStatus:    3,
 		},
 		{
-			StartStatus:  []int{1},
+			StartStatus:  []int{3},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "GRANTS",
-			EndStatus:    30,
+			AcceptValue:  "BY",
+			EndStatus:    4,
 		},
 		{
-			StartStatus:  []int{30},
-			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "FOR",
-			EndStatus:    31,
+			StartStatus:  []int{4},
+			AcceptObject: "MySQLOrderListOptionComponent",
+			AcceptValue:  "",
+			EndStatus:    5,
 		},
 		{
-			StartStatus:  []int{31},
+			StartStatus:  []int{2, 5},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "CURRENT_USER",
-			EndStatus:    39,
+			AcceptValue:  "LIMIT",
+			EndStatus:    6,
 		},
 		{
-			StartStatus:  []int{39},
-			AcceptObject: "MySQLOperatorToken",
-			AcceptValue:  "(",
-			EndStatus:    40,
+			StartStatus:  []int{6},
+			AcceptObject: "MySQLNumericToken",
+			AcceptValue:  "",
+			EndStatus:    7,
 		},
 		{
-			StartStatus:  []int{40},
-			AcceptObject: "MySQLOperatorToken",
-			AcceptValue:  ")",
-			EndStatus:    FinalStatus,
+			StartStatus:  []int{6},
+			AcceptObject: "MySQLParamMarkerToken",
+			AcceptValue:  "",
+			EndStatus:    7,
 		},
 		{
-			StartStatus:  []int{31},
-			AcceptObject: "MySQLStringToken",
+			StartStatus:  []int{7},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "OFFSET",
+			EndStatus:    8,
+		},
+		{
+			StartStatus:  []int{8},
+			AcceptObject: "MySQLNumericToken",
 			AcceptValue:  "",
-			EndStatus:    41,
+			EndStatus:    FinalStatus,
 		},
 		{
-			StartStatus:  []int{41},
-			AcceptObject: "MySQLVariableToken",
+			StartStatus:  []int{8},
+			AcceptObject: "MySQLParamMarkerToken",
 			AcceptValue:  "",
 			EndStatus:    FinalStatus,
 		},
+	}
+}
+
+func NewTableStatement(tokenList MySQLTokenList,
+	verboseFunc func(message string, level LogLevel)) (MySQLStatement, MySQLTokenList) {
+	startPos := tokenList.CurrentPos()
+	s := &TableStatement{
+		MySQLBaseStatement: &MySQLBaseStatement{
+			ObjectList: make([]*MySQLObject, 0),
+		},
+		DatabaseList: make([]string, 0),
+		TableList:    make([]string, 0),
+	}
+	endPos := s.ParseByFsm(s.GetFsmMap(), tokenList, []int{2, 5, 7}, verboseFunc)
+	if endPos == -1 {
+		tokenList.Reset(startPos)
+		return nil, tokenList
+	} else {
+		lastKeyword := ""
+		for _, t := range s.ObjectList {
+			if (*t).Type() == "MySQLKeywordToken" {
+				lastKeyword = (*t).Value()
+			} else if (*t).Type() == "MySQLTableNameComponent" {
+				s.DatabaseList = append(s.DatabaseList, (*t).(*MySQLTableNameComponent).Database)
+				s.TableList = append(s.TableList, (*t).(*MySQLTableNameComponent).Table)
+			} else if (*t).Type() == "MySQLOrderListOptionComponent" {
+				s.OrderBy = (*t).(*MySQLOrderListOptionComponent)
+			} else if (*t).Type() == "MySQLNumericToken" || (*t).Type() == "MySQLParamMarkerToken" {
+				if lastKeyword == "OFFSET" {
+					s.Offset = (*t).Value()
+				} else {
+					s.Limit = (*t).Value()
+				}
+			}
+		}
+		tokenList.Reset(endPos)
+		return s, tokenList
+	}
+}
+
+// 13.3.1 START TRANSACTION, COMMIT, and ROLLBACK Syntax
+// START TRANSACTION
+//     [transaction_characteristic [, transaction_characteristic] ...]
+//
+// transaction_characteristic: {
+//     WITH CONSISTENT SNAPSHOT
+//   | READ WRITE
+//   | READ ONLY
+// }
+//
+// BEGIN [WORK]
+// COMMIT [WORK] [AND [NO] CHAIN] [[NO] RELEASE]
+// ROLLBACK [WORK] [AND [NO] CHAIN] [[NO] RELEASE]
+//
+// 13.3.4 SAVEPOINT, ROLLBACK TO SAVEPOINT, and RELEASE SAVEPOINT Syntax
+// SAVEPOINT identifier
+// ROLLBACK [WORK] TO [SAVEPOINT] identifier
+// RELEASE SAVEPOINT identifier
+
+type TransactionStatement struct {
+	*MySQLBaseStatement
+	Action             string
+	ConsistentSnapshot bool
+	AccessMode         string
+	Chain              bool
+	Release            bool
+	Savepoint          string
+}
+
+func (s *TransactionStatement) Type() string {
+	return "TransactionStatement"
+}
+
+func (s *TransactionStatement) GetFsmMap() []FsmMap {
+	return []FsmMap{
 		{
-			StartStatus:  []int{1},
+			StartStatus:  []int{0},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "INDEX",
-			EndStatus:    17,
+			AcceptValue:  "START",
+			EndStatus:    1,
 		},
 		{
 			StartStatus:  []int{1},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "INDEXES",
-			EndStatus:    17,
+			AcceptValue:  "TRANSACTION",
+			EndStatus:    2,
 		},
 		{
-			StartStatus:  []int{1},
+			StartStatus:  []int{2, 5},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "KEYS",
-			EndStatus:    17,
+			AcceptValue:  "WITH",
+			EndStatus:    3,
 		},
 		{
-			StartStatus:  []int{1},
+			StartStatus:  []int{3},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "MASTER",
-			EndStatus:    42,
+			AcceptValue:  "CONSISTENT",
+			EndStatus:    6,
 		},
 		{
-			StartStatus:  []int{1},
+			StartStatus:  []int{6},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "OPEN",
-			EndStatus:    43,
+			AcceptValue:  "SNAPSHOT",
+			EndStatus:    4,
 		},
 		{
-			StartStatus:  []int{43},
+			StartStatus:  []int{2, 5},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "TABLES",
-			EndStatus:    19,
+			AcceptValue:  "READ",
+			EndStatus:    7,
 		},
 		{
-			StartStatus:  []int{1},
+			StartStatus:  []int{7},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "PLUGINS",
-			EndStatus:    FinalStatus,
+			AcceptValue:  "WRITE",
+			EndStatus:    4,
 		},
 		{
-			StartStatus:  []int{1},
+			StartStatus:  []int{7},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "PRIVILEGES",
-			EndStatus:    FinalStatus,
+			AcceptValue:  "ONLY",
+			EndStatus:    4,
 		},
 		{
-			StartStatus:  []int{1},
+			StartStatus:  []int{4},
+			AcceptObject: "MySQLDelimiterToken",
+			AcceptValue:  ",",
+			EndStatus:    5,
+		},
+		{
+			StartStatus:  []int{0},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "PROCEDURE",
-			EndStatus:    28,
+			AcceptValue:  "BEGIN",
+			EndStatus:    10,
 		},
 		{
-			StartStatus:  []int{1, 16},
+			StartStatus:  []int{10},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "PROCESSLIST",
+			AcceptValue:  "WORK",
 			EndStatus:    FinalStatus,
 		},
 		{
-			StartStatus:  []int{1},
+			StartStatus:  []int{0},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "PROFILE",
-			EndStatus:    44,
+			AcceptValue:  "COMMIT",
+			EndStatus:    20,
 		},
 		{
-			StartStatus:  []int{44, 49},
+			StartStatus:  []int{0},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "ALL",
-			EndStatus:    45,
+			AcceptValue:  "ROLLBACK",
+			EndStatus:    20,
 		},
 		{
-			StartStatus:  []int{44, 49},
+			StartStatus:  []int{20},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "BLOCK",
-			EndStatus:    46,
+			AcceptValue:  "WORK",
+			EndStatus:    21,
 		},
 		{
-			StartStatus:  []int{46},
+			StartStatus:  []int{20, 21},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "IO",
-			EndStatus:    45,
+			AcceptValue:  "AND",
+			EndStatus:    22,
 		},
 		{
-			StartStatus:  []int{44, 49},
+			StartStatus:  []int{22},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "CONTEXT",
-			EndStatus:    47,
+			AcceptValue:  "NO",
+			EndStatus:    23,
 		},
 		{
-			StartStatus:  []int{47},
+			StartStatus:  []int{22, 23},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "SWITCHES",
-			EndStatus:    45,
+			AcceptValue:  "CHAIN",
+			EndStatus:    24,
 		},
 		{
-			StartStatus:  []int{44, 49},
+			StartStatus:  []int{20, 21, 24},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "CPU",
-			EndStatus:    45,
+			AcceptValue:  "NO",
+			EndStatus:    25,
 		},
 		{
-			StartStatus:  []int{44, 49},
+			StartStatus:  []int{20, 21, 24, 25},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "IPC",
-			EndStatus:    45,
+			AcceptValue:  "RELEASE",
+			EndStatus:    FinalStatus,
 		},
 		{
-			StartStatus:  []int{44, 49},
+			StartStatus:  []int{20, 21},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "MEMORY",
-			EndStatus:    45,
+			AcceptValue:  "TO",
+			EndStatus:    26,
 		},
 		{
-			StartStatus:  []int{44, 49},
+			StartStatus:  []int{26},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "PAGE",
-			EndStatus:    48,
+			AcceptValue:  "SAVEPOINT",
+			EndStatus:    27,
 		},
 		{
-			StartStatus:  []int{48},
-			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "FAULTS",
-			EndStatus:    45,
+			StartStatus:  []int{26, 27, 30, 32},
+			AcceptObject: "MySQLIdentifierComponent",
+			AcceptValue:  "",
+			EndStatus:    FinalStatus,
 		},
 		{
-			StartStatus:  []int{44, 49},
+			StartStatus:  []int{0},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "SOURCE",
-			EndStatus:    45,
+			AcceptValue:  "SAVEPOINT",
+			EndStatus:    30,
 		},
 		{
-			StartStatus:  []int{44, 49},
+			StartStatus:  []int{0},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "SWAPS",
-			EndStatus:    45,
+			AcceptValue:  "RELEASE",
+			EndStatus:    31,
 		},
 		{
-			StartStatus:  []int{45},
-			AcceptObject: "MySQLDelimiterToken",
-			AcceptValue:  ",",
-			EndStatus:    49,
+			StartStatus:  []int{31},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "SAVEPOINT",
+			EndStatus:    32,
+		},
+	}
+}
+
+func NewTransactionStatement(tokenList MySQLTokenList,
+	verboseFunc func(message string, level LogLevel)) (MySQLStatement, MySQLTokenList) {
+	startPos := tokenList.CurrentPos()
+	s := &TransactionStatement{
+		MySQLBaseStatement: &MySQLBaseStatement{
+			ObjectList: make([]*MySQLObject, 0),
 		},
+	}
+	endPos := s.ParseByFsm(s.GetFsmMap(), tokenList, []int{2, 4, 10, 20, 21, 24}, verboseFunc)
+	if endPos == -1 {
+		tokenList.Reset(startPos)
+		return nil, tokenList
+	} else {
+		lastKeyword := ""
+		for _, t := range s.ObjectList {
+			if (*t).Type() == "MySQLKeywordToken" && s.Action == "" {
+				s.Action = (*t).Value()
+				if s.Action == "BEGIN" {
+					s.Action = "START"
+				}
+			} else if (*t).Type() == "MySQLIdentifierComponent" {
+				s.Savepoint = strings.Trim((*t).Value(), "`")
+			} else if (*t).Type() == "MySQLKeywordToken" {
+				switch (*t).Value() {
+				case "SNAPSHOT":
+					s.ConsistentSnapshot = true
+				case "WRITE", "ONLY":
+					s.AccessMode = "READ " + (*t).Value()
+				case "CHAIN":
+					s.Chain = lastKeyword != "NO"
+				case "RELEASE":
+					s.Release = lastKeyword != "NO"
+				}
+				lastKeyword = (*t).Value()
+			}
+		}
+		tokenList.Reset(endPos)
+		return s, tokenList
+	}
+}
+
+// 13.1.33 TRUNCATE TABLE Syntax
+// TRUNCATE [TABLE] tbl_name
+
+type TruncateTableStatement struct {
+	*MySQLBaseStatement
+	Database string
+	Table    string
+}
+
+func (s *TruncateTableStatement) Type() string {
+	return "TruncateTableStatement"
+}
+
+func (s *TruncateTableStatement) GetFsmMap() []FsmMap {
+	return []FsmMap{
 		{
-			StartStatus:  []int{44, 45},
+			StartStatus:  []int{0},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "FOR",
-			EndStatus:    50,
+			AcceptValue:  "TRUNCATE",
+			EndStatus:    1,
 		},
 		{
-			StartStatus:  []int{50},
+			StartStatus:  []int{1},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "QUERY",
-			EndStatus:    51,
+			AcceptValue:  "TABLE",
+			EndStatus:    2,
 		},
 		{
-			StartStatus:  []int{51},
-			AcceptObject: "MySQLNumericToken",
+			StartStatus:  []int{1, 2},
+			AcceptObject: "MySQLTableNameComponent",
 			AcceptValue:  "",
-			EndStatus:    52,
+			EndStatus:    FinalStatus,
+		},
+	}
+}
+
+func NewTruncateTableStatement(tokenList MySQLTokenList,
+	verboseFunc func(message string, level LogLevel)) (MySQLStatement, MySQLTokenList) {
+	startPos := tokenList.CurrentPos()
+	s := &TruncateTableStatement{
+		MySQLBaseStatement: &MySQLBaseStatement{
+			ObjectList: make([]*MySQLObject, 0),
 		},
+	}
+	endPos := s.ParseByFsm(s.GetFsmMap(), tokenList, []int{}, verboseFunc)
+	if endPos == -1 {
+		tokenList.Reset(startPos)
+		return nil, tokenList
+	} else {
+		for _, t := range s.ObjectList {
+			if (*t).Type() == "MySQLTableNameComponent" {
+				s.Database = (*t).(*MySQLTableNameComponent).Database
+				s.Table = (*t).(*MySQLTableNameComponent).Table
+			}
+		}
+		tokenList.Reset(endPos)
+		return s, tokenList
+	}
+}
+
+// 13.2.11 UPDATE Syntax
+// UPDATE [LOW_PRIORITY] [IGNORE] table_reference
+//    SET assignment_list
+//    [WHERE where_condition]
+//    [ORDER BY ...]
+//    [LIMIT row_count]
+//
+// UPDATE [LOW_PRIORITY] [IGNORE] table_references
+//    SET assignment_list
+//    [WHERE where_condition]
+//
+// value:
+//    {expr | DEFAULT}
+
+type UpdateStatement struct {
+	*MySQLBaseStatement
+	DatabaseList     []string
+	TableList        []string
+	FromDatabaseList []string
+	FromTableList    []string
+	TargetTables     []*MySQLTableNameComponent
+	ReadTables       []*MySQLTableNameComponent
+	Assignments      []*MySQLAssignmentExpressionComponent
+	Where            *MySQLExpressionComponent
+	OrderBy          *MySQLOrderListOptionComponent
+	Limit            string
+}
+
+func (s *UpdateStatement) Type() string {
+	return "UpdateStatement"
+}
+
+func (s *UpdateStatement) GetFsmMap() []FsmMap {
+	return []FsmMap{
 		{
-			StartStatus:  []int{44, 45, 52},
+			StartStatus:  []int{0},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "LIMIT",
-			EndStatus:    53,
+			AcceptValue:  "UPDATE",
+			EndStatus:    1,
 		},
 		{
-			StartStatus:  []int{53},
-			AcceptObject: "MySQLNumericToken",
-			AcceptValue:  "",
-			EndStatus:    54,
+			StartStatus:  []int{1},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "LOW_PRIORITY",
+			EndStatus:    2,
 		},
 		{
-			StartStatus:  []int{54},
+			StartStatus:  []int{1, 2},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "OFFSET",
-			EndStatus:    55,
+			AcceptValue:  "IGNORE",
+			EndStatus:    3,
 		},
 		{
-			StartStatus:  []int{55},
-			AcceptObject: "MySQLNumericToken",
+			StartStatus:  []int{1, 2, 3},
+			AcceptObject: "TableReferenceListComponent",
 			AcceptValue:  "",
-			EndStatus:    FinalStatus,
+			EndStatus:    4,
 		},
 		{
-			StartStatus:  []int{1},
+			StartStatus:  []int{4},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "PROFILES",
-			EndStatus:    FinalStatus,
+			AcceptValue:  "SET",
+			EndStatus:    5,
 		},
 		{
-			StartStatus:  []int{1},
-			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "RELAYLOG",
-			EndStatus:    3,
+			StartStatus:  []int{5},
+			AcceptObject: "MySQLAssignmentListExpressionComponent",
+			AcceptValue:  "",
+			EndStatus:    6,
 		},
 		{
-			StartStatus:  []int{1},
+			StartStatus:  []int{6},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "SLAVE",
-			EndStatus:    56,
+			AcceptValue:  "WHERE",
+			EndStatus:    7,
 		},
 		{
-			StartStatus:  []int{56},
-			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "HOSTS",
-			EndStatus:    FinalStatus,
+			StartStatus:  []int{7},
+			AcceptObject: "MySQLExpressionComponent",
+			AcceptValue:  "",
+			EndStatus:    8,
 		},
 		{
-			StartStatus:  []int{1},
+			StartStatus:  []int{6, 8},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "GLOBAL",
-			EndStatus:    57,
+			AcceptValue:  "ORDER",
+			EndStatus:    9,
 		},
 		{
-			StartStatus:  []int{1},
+			StartStatus:  []int{9},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "SESSION",
-			EndStatus:    57,
+			AcceptValue:  "BY",
+			EndStatus:    10,
 		},
 		{
-			StartStatus:  []int{1, 57},
-			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "STATUS",
-			EndStatus:    13,
+			StartStatus:  []int{10},
+			AcceptObject: "MySQLOrderListOptionComponent",
+			AcceptValue:  "",
+			EndStatus:    11,
 		},
 		{
-			StartStatus:  []int{1},
+			StartStatus:  []int{6, 8, 11},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "TABLE",
-			EndStatus:    58,
+			AcceptValue:  "LIMIT",
+			EndStatus:    13,
 		},
 		{
-			StartStatus:  []int{58},
-			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "STATUS",
-			EndStatus:    19,
+			StartStatus:  []int{13},
+			AcceptObject: "MySQLNumericToken",
+			AcceptValue:  "",
+			EndStatus:    FinalStatus,
 		},
 		{
-			StartStatus:  []int{1, 16},
-			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "TABLES",
-			EndStatus:    19,
+			StartStatus:  []int{13},
+			AcceptObject: "MySQLParamMarkerToken",
+			AcceptValue:  "",
+			EndStatus:    FinalStatus,
 		},
-		{
-			StartStatus:  []int{1},
-			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "TRIGGERS",
-			EndStatus:    19,
+	}
+}
+
+func NewUpdateStatement(tokenList MySQLTokenList,
+	verboseFunc func(message string, level LogLevel)) (MySQLStatement, MySQLTokenList) {
+	startPos := tokenList.CurrentPos()
+	s := &UpdateStatement{
+		MySQLBaseStatement: &MySQLBaseStatement{
+			ObjectList: make([]*MySQLObject, 0),
 		},
+		DatabaseList:     make([]string, 0),
+		TableList:        make([]string, 0),
+		FromDatabaseList: make([]string, 0),
+		FromTableList:    make([]string, 0),
+		TargetTables:     make([]*MySQLTableNameComponent, 0),
+		ReadTables:       make([]*MySQLTableNameComponent, 0),
+		Assignments:      make([]*MySQLAssignmentExpressionComponent, 0),
+	}
+	endPos := s.ParseByFsm(s.GetFsmMap(), tokenList, []int{6, 8, 11}, verboseFunc)
+	if endPos == -1 {
+		tokenList.Reset(startPos)
+		return nil, tokenList
+	} else {
+		for _, t := range s.ObjectList {
+			if (*t).Type() == "TableReferenceListComponent" {
+				s.DatabaseList = append(s.DatabaseList, (*t).(*TableReferenceListComponent).DatabaseList...)
+				s.TableList = append(s.TableList, (*t).(*TableReferenceListComponent).TableList...)
+			} else if (*t).Type() == "MySQLAssignmentListExpressionComponent" {
+				s.Assignments = append(s.Assignments, (*t).(*MySQLAssignmentListExpressionComponent).AssignmentList...)
+			} else if (*t).Type() == "MySQLExpressionComponent" {
+				s.Where = (*t).(*MySQLExpressionComponent)
+				for _, tmpT := range (*t).(*MySQLExpressionComponent).ObjectList {
+					if (*tmpT).Type() == "SubQueryComponent" {
+						s.FromDatabaseList = append(s.FromDatabaseList, (*tmpT).(*SubQueryComponent).DatabaseList...)
+						s.FromTableList = append(s.FromTableList, (*tmpT).(*SubQueryComponent).TableList...)
+					}
+				}
+			} else if (*t).Type() == "MySQLOrderListOptionComponent" {
+				s.OrderBy = (*t).(*MySQLOrderListOptionComponent)
+			} else if (*t).Type() == "MySQLNumericToken" || (*t).Type() == "MySQLParamMarkerToken" {
+				s.Limit = (*t).Value()
+			}
+		}
+		factorList := getTableFactorList(s.ObjectList, false)
+		for _, assignment := range s.Assignments {
+			if assignment.Column == nil {
+				continue
+			}
+			if assignment.Column.Table != "" {
+				factor := findTableFactor(factorList, assignment.Column.Database, assignment.Column.Table)
+				if factor != nil && factor.TableName != nil {
+					s.TargetTables = appendTableName(s.TargetTables, factor.TableName)
+				}
+				continue
+			}
+			for _, factor := range factorList {
+				if factor.TableName != nil {
+					s.TargetTables = appendTableName(s.TargetTables, factor.TableName)
+				}
+			}
+		}
+		s.ReadTables = getReadTableList(getTableFactorList(s.ObjectList, true), s.TargetTables)
+		tokenList.Reset(endPos)
+		return s, tokenList
+	}
+}
+
+// 13.8.4 USE Syntax
+// USE db_name
+
+type UseStatement struct {
+	*MySQLBaseStatement
+	Database string
+}
+
+func (s *UseStatement) Type() string {
+	return "UseStatement"
+}
+
+func (s *UseStatement) GetFsmMap() []FsmMap {
+	return []FsmMap{
 		{
-			StartStatus:  []int{1, 57},
+			StartStatus:  []int{0},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "VARIABLES",
-			EndStatus:    13,
+			AcceptValue:  "USE",
+			EndStatus:    1,
 		},
 		{
 			StartStatus:  []int{1},
-			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "WARNINGS",
-			EndStatus:    8,
-		},
-		{
-			StartStatus:  []int{38},
-			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "WARNINGS",
+			AcceptObject: "MySQLDatabaseNameComponent",
+			AcceptValue:  "",
 			EndStatus:    FinalStatus,
 		},
 	}
 }
 
-func NewShowStatement(tokenList MySQLTokenList,
+func NewUseStatement(tokenList MySQLTokenList,
 	verboseFunc func(message string, level LogLevel)) (MySQLStatement, MySQLTokenList) {
 	startPos := tokenList.CurrentPos()
-	s := &ShowStatement{
+	s := &UseStatement{
 		MySQLBaseStatement: &MySQLBaseStatement{
 			ObjectList: make([]*MySQLObject, 0),
 		},
-		DatabaseList: make([]string, 0),
-		TableList:    make([]string, 0),
 	}
-	endPos := s.ParseByFsm(s.GetFsmMap(), tokenList, []int{4, 6, 8, 10, 13, 19, 30, 39, 44, 45, 52, 54}, verboseFunc)
+	endPos := s.ParseByFsm(s.GetFsmMap(), tokenList, []int{}, verboseFunc)
 	if endPos == -1 {
 		tokenList.Reset(startPos)
 		return nil, tokenList
 	} else {
 		for _, t := range s.ObjectList {
 			if (*t).Type() == "MySQLDatabaseNameComponent" {
-				s.DatabaseList = append(s.DatabaseList, (*t).(*MySQLDatabaseNameComponent).Database)
-				s.TableList = append(s.TableList, "")
-			} else if (*t).Type() == "MySQLTableNameComponent" {
-				s.DatabaseList = append(s.DatabaseList, (*t).(*MySQLTableNameComponent).Database)
-				s.TableList = append(s.TableList, (*t).(*MySQLTableNameComponent).Table)
-			} else if (*t).Type() == "MySQLExpressionComponent" {
-				for _, tmpT := range (*t).(*MySQLExpressionComponent).ObjectList {
-					if (*tmpT).Type() == "SubQueryComponent" {
-						s.DatabaseList = append(s.DatabaseList, (*tmpT).(*SubQueryComponent).DatabaseList...)
-						s.TableList = append(s.TableList, (*tmpT).(*SubQueryComponent).TableList...)
-					}
-				}
+				s.Database = (*t).(*MySQLDatabaseNameComponent).Database
 			}
 		}
 		tokenList.Reset(endPos)
@@ -3660,59 +7791,125 @@ func NewShowStatement(tokenList MySQLTokenList,
 	}
 }
 
-// 13.1.33 TRUNCATE TABLE Syntax
-// TRUNCATE [TABLE] tbl_name
+// 13.2.14 VALUES Syntax
+// VALUES row_constructor_list [ORDER BY column_designator] [LIMIT number]
+//
+// row_constructor_list:
+//    ROW(value_list)[, ROW(value_list)][, ...]
+//
+// value_list:
+//    value[, value][, ...]
+//
+// column_designator:
+//    column_index
 
-type TruncateTableStatement struct {
+type ValuesStatement struct {
 	*MySQLBaseStatement
-	Database string
-	Table    string
+	RowList      [][]*MySQLExpressionComponent
+	DatabaseList []string
+	TableList    []string
+	OrderBy      *MySQLOrderListOptionComponent
+	Limit        string
 }
 
-func (s *TruncateTableStatement) Type() string {
-	return "TruncateTableStatement"
+func (s *ValuesStatement) Type() string {
+	return "ValuesStatement"
 }
 
-func (s *TruncateTableStatement) GetFsmMap() []FsmMap {
+func (s *ValuesStatement) GetFsmMap() []FsmMap {
 	return []FsmMap{
 		{
 			StartStatus:  []int{0},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "TRUNCATE",
+			AcceptValue:  "VALUES",
 			EndStatus:    1,
 		},
 		{
 			StartStatus:  []int{1},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "TABLE",
+			AcceptValue:  "ROW",
 			EndStatus:    2,
 		},
 		{
-			StartStatus:  []int{1, 2},
-			AcceptObject: "MySQLTableNameComponent",
+			StartStatus:  []int{2},
+			AcceptObject: "MySQLValueListComponent",
+			AcceptValue:  "",
+			EndStatus:    3,
+		},
+		{
+			StartStatus:  []int{3},
+			AcceptObject: "MySQLDelimiterToken",
+			AcceptValue:  ",",
+			EndStatus:    1,
+		},
+		{
+			StartStatus:  []int{3},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "ORDER",
+			EndStatus:    4,
+		},
+		{
+			StartStatus:  []int{4},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "BY",
+			EndStatus:    5,
+		},
+		{
+			StartStatus:  []int{5},
+			AcceptObject: "MySQLOrderListOptionComponent",
+			AcceptValue:  "",
+			EndStatus:    6,
+		},
+		{
+			StartStatus:  []int{3, 6},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "LIMIT",
+			EndStatus:    7,
+		},
+		{
+			StartStatus:  []int{7},
+			AcceptObject: "MySQLNumericToken",
+			AcceptValue:  "",
+			EndStatus:    FinalStatus,
+		},
+		{
+			StartStatus:  []int{7},
+			AcceptObject: "MySQLParamMarkerToken",
 			AcceptValue:  "",
 			EndStatus:    FinalStatus,
 		},
 	}
 }
 
-func NewTruncateTableStatement(tokenList MySQLTokenList,
+func NewValuesStatement(tokenList MySQLTokenList,
 	verboseFunc func(message string, level LogLevel)) (MySQLStatement, MySQLTokenList) {
 	startPos := tokenList.CurrentPos()
-	s := &TruncateTableStatement{
+	s := &ValuesStatement{
 		MySQLBaseStatement: &MySQLBaseStatement{
 			ObjectList: make([]*MySQLObject, 0),
 		},
+		RowList:      make([][]*MySQLExpressionComponent, 0),
+		DatabaseList: make([]string, 0),
+		TableList:    make([]string, 0),
 	}
-	endPos := s.ParseByFsm(s.GetFsmMap(), tokenList, []int{}, verboseFunc)
+	endPos := s.ParseByFsm(s.GetFsmMap(), tokenList, []int{3, 6}, verboseFunc)
 	if endPos == -1 {
 		tokenList.Reset(startPos)
 		return nil, tokenList
 	} else {
 		for _, t := range s.ObjectList {
-			if (*t).Type() == "MySQLTableNameComponent" {
-				s.Database = (*t).(*MySQLTableNameComponent).Database
-				s.Table = (*t).(*MySQLTableNameComponent).Table
+			if (*t).Type() == "MySQLValueListComponent" {
+				valueList := (*t).(*MySQLValueListComponent).ValueList
+				s.RowList = append(s.RowList, valueList)
+				for _, value := range valueList {
+					databaseList, tableList := getSubQueryTableList(value)
+					s.DatabaseList = append(s.DatabaseList, databaseList...)
+					s.TableList = append(s.TableList, tableList...)
+				}
+			} else if (*t).Type() == "MySQLOrderListOptionComponent" {
+				s.OrderBy = (*t).(*MySQLOrderListOptionComponent)
+			} else if (*t).Type() == "MySQLNumericToken" || (*t).Type() == "MySQLParamMarkerToken" {
+				s.Limit = (*t).Value()
 			}
 		}
 		tokenList.Reset(endPos)
@@ -3720,225 +7917,202 @@ func NewTruncateTableStatement(tokenList MySQLTokenList,
 	}
 }
 
-// 13.2.11 UPDATE Syntax
-// UPDATE [LOW_PRIORITY] [IGNORE] table_reference
-//    SET assignment_list
-//    [WHERE where_condition]
-//    [ORDER BY ...]
-//    [LIMIT row_count]
+// 13.3.7.1 XA Transaction SQL Syntax
+// XA {START|BEGIN} xid [JOIN|RESUME]
 //
-// UPDATE [LOW_PRIORITY] [IGNORE] table_references
-//    SET assignment_list
-//    [WHERE where_condition]
+// XA END xid [SUSPEND [FOR MIGRATE]]
 //
-// value:
-//    {expr | DEFAULT}
+// XA PREPARE xid
+//
+// XA COMMIT xid [ONE PHASE]
+//
+// XA ROLLBACK xid
+//
+// XA RECOVER [CONVERT XID]
 
-type UpdateStatement struct {
+type XAStatement struct {
 	*MySQLBaseStatement
-	DatabaseList     []string
-	TableList        []string
-	FromDatabaseList []string
-	FromTableList    []string
+	Action     string
+	Xid        *MySQLXidComponent
+	Join       bool
+	Resume     bool
+	Suspend    bool
+	ForMigrate bool
+	OnePhase   bool
+	ConvertXid bool
 }
 
-func (s *UpdateStatement) Type() string {
-	return "UpdateStatement"
+func (s *XAStatement) Type() string {
+	return "XAStatement"
 }
 
-func (s *UpdateStatement) GetFsmMap() []FsmMap {
+func (s *XAStatement) GetFsmMap() []FsmMap {
 	return []FsmMap{
 		{
 			StartStatus:  []int{0},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "UPDATE",
+			AcceptValue:  "XA",
 			EndStatus:    1,
 		},
 		{
 			StartStatus:  []int{1},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "LOW_PRIORITY",
+			AcceptValue:  "START",
 			EndStatus:    2,
 		},
 		{
-			StartStatus:  []int{1, 2},
+			StartStatus:  []int{1},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "IGNORE",
-			EndStatus:    3,
+			AcceptValue:  "BEGIN",
+			EndStatus:    2,
 		},
 		{
-			StartStatus:  []int{1, 2, 3},
-			AcceptObject: "TableReferenceListComponent",
+			StartStatus:  []int{2},
+			AcceptObject: "MySQLXidComponent",
 			AcceptValue:  "",
+			EndStatus:    3,
+		},
+		{
+			StartStatus:  []int{3},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "JOIN",
+			EndStatus:    FinalStatus,
+		},
+		{
+			StartStatus:  []int{3},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "RESUME",
+			EndStatus:    FinalStatus,
+		},
+		{
+			StartStatus:  []int{1},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "END",
 			EndStatus:    4,
 		},
 		{
 			StartStatus:  []int{4},
-			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "SET",
+			AcceptObject: "MySQLXidComponent",
+			AcceptValue:  "",
 			EndStatus:    5,
 		},
 		{
 			StartStatus:  []int{5},
-			AcceptObject: "MySQLAssignmentListExpressionComponent",
-			AcceptValue:  "",
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "SUSPEND",
 			EndStatus:    6,
 		},
 		{
 			StartStatus:  []int{6},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "WHERE",
+			AcceptValue:  "FOR",
 			EndStatus:    7,
 		},
 		{
 			StartStatus:  []int{7},
-			AcceptObject: "MySQLExpressionComponent",
-			AcceptValue:  "",
-			EndStatus:    8,
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "MIGRATE",
+			EndStatus:    FinalStatus,
 		},
 		{
-			StartStatus:  []int{6, 8},
+			StartStatus:  []int{1},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "ORDER",
-			EndStatus:    9,
+			AcceptValue:  "PREPARE",
+			EndStatus:    8,
 		},
 		{
-			StartStatus:  []int{9},
+			StartStatus:  []int{1},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "BY",
-			EndStatus:    10,
+			AcceptValue:  "ROLLBACK",
+			EndStatus:    8,
 		},
 		{
-			StartStatus:  []int{10},
-			AcceptObject: "MySQLExpressionComponent",
+			StartStatus:  []int{8},
+			AcceptObject: "MySQLXidComponent",
 			AcceptValue:  "",
-			EndStatus:    11,
+			EndStatus:    FinalStatus,
 		},
 		{
-			StartStatus:  []int{10},
-			AcceptObject: "MySQLColumnNameComponent",
+			StartStatus:  []int{1},
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "COMMIT",
+			EndStatus:    9,
+		},
+		{
+			StartStatus:  []int{9},
+			AcceptObject: "MySQLXidComponent",
 			AcceptValue:  "",
-			EndStatus:    11,
+			EndStatus:    10,
 		},
 		{
 			StartStatus:  []int{10},
-			AcceptObject: "MySQLNumericToken",
-			AcceptValue:  "",
+			AcceptObject: "MySQLKeywordToken",
+			AcceptValue:  "ONE",
 			EndStatus:    11,
 		},
 		{
 			StartStatus:  []int{11},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "ASC",
-			EndStatus:    12,
+			AcceptValue:  "PHASE",
+			EndStatus:    FinalStatus,
 		},
 		{
-			StartStatus:  []int{11},
+			StartStatus:  []int{1},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "DESC",
+			AcceptValue:  "RECOVER",
 			EndStatus:    12,
 		},
 		{
 			StartStatus:  []int{12},
-			AcceptObject: "MySQLDelimiterToken",
-			AcceptValue:  ",",
-			EndStatus:    10,
-		},
-		{
-			StartStatus:  []int{6, 8, 11, 12},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "LIMIT",
+			AcceptValue:  "CONVERT",
 			EndStatus:    13,
 		},
 		{
 			StartStatus:  []int{13},
-			AcceptObject: "MySQLNumericToken",
-			AcceptValue:  "",
-			EndStatus:    FinalStatus,
-		},
-	}
-}
-
-func NewUpdateStatement(tokenList MySQLTokenList,
-	verboseFunc func(message string, level LogLevel)) (MySQLStatement, MySQLTokenList) {
-	startPos := tokenList.CurrentPos()
-	s := &UpdateStatement{
-		MySQLBaseStatement: &MySQLBaseStatement{
-			ObjectList: make([]*MySQLObject, 0),
-		},
-		DatabaseList:     make([]string, 0),
-		TableList:        make([]string, 0),
-		FromDatabaseList: make([]string, 0),
-		FromTableList:    make([]string, 0),
-	}
-	endPos := s.ParseByFsm(s.GetFsmMap(), tokenList, []int{6, 8, 11, 12}, verboseFunc)
-	if endPos == -1 {
-		tokenList.Reset(startPos)
-		return nil, tokenList
-	} else {
-		for _, t := range s.ObjectList {
-			if (*t).Type() == "TableReferenceListComponent" {
-				s.DatabaseList = append(s.DatabaseList, (*t).(*TableReferenceListComponent).DatabaseList...)
-				s.TableList = append(s.TableList, (*t).(*TableReferenceListComponent).TableList...)
-			} else if (*t).Type() == "MySQLExpressionComponent" {
-				for _, tmpT := range (*t).(*MySQLExpressionComponent).ObjectList {
-					if (*tmpT).Type() == "SubQueryComponent" {
-						s.FromDatabaseList = append(s.FromDatabaseList, (*tmpT).(*SubQueryComponent).DatabaseList...)
-						s.FromTableList = append(s.FromTableList, (*tmpT).(*SubQueryComponent).TableList...)
-					}
-				}
-			}
-		}
-		tokenList.Reset(endPos)
-		return s, tokenList
-	}
-}
-
-// 13.8.4 USE Syntax
-// USE db_name
-
-type UseStatement struct {
-	*MySQLBaseStatement
-	Database string
-}
-
-func (s *UseStatement) Type() string {
-	return "UseStatement"
-}
-
-func (s *UseStatement) GetFsmMap() []FsmMap {
-	return []FsmMap{
-		{
-			StartStatus:  []int{0},
 			AcceptObject: "MySQLKeywordToken",
-			AcceptValue:  "USE",
-			EndStatus:    1,
-		},
-		{
-			StartStatus:  []int{1},
-			AcceptObject: "MySQLDatabaseNameComponent",
-			AcceptValue:  "",
+			AcceptValue:  "XID",
 			EndStatus:    FinalStatus,
 		},
 	}
 }
 
-func NewUseStatement(tokenList MySQLTokenList,
+func NewXAStatement(tokenList MySQLTokenList,
 	verboseFunc func(message string, level LogLevel)) (MySQLStatement, MySQLTokenList) {
 	startPos := tokenList.CurrentPos()
-	s := &UseStatement{
+	s := &XAStatement{
 		MySQLBaseStatement: &MySQLBaseStatement{
 			ObjectList: make([]*MySQLObject, 0),
 		},
 	}
-	endPos := s.ParseByFsm(s.GetFsmMap(), tokenList, []int{}, verboseFunc)
+	endPos := s.ParseByFsm(s.GetFsmMap(), tokenList, []int{3, 5, 6, 10, 12}, verboseFunc)
 	if endPos == -1 {
 		tokenList.Reset(startPos)
 		return nil, tokenList
 	} else {
 		for _, t := range s.ObjectList {
-			if (*t).Type() == "MySQLDatabaseNameComponent" {
-				s.Database = (*t).(*MySQLDatabaseNameComponent).Database
+			if (*t).Type() == "MySQLKeywordToken" && s.Action == "" && (*t).Value() != "XA" {
+				s.Action = (*t).Value()
+				if s.Action == "BEGIN" {
+					s.Action = "START"
+				}
+			} else if (*t).Type() == "MySQLXidComponent" {
+				s.Xid = (*t).(*MySQLXidComponent)
+			} else if (*t).Type() == "MySQLKeywordToken" {
+				switch (*t).Value() {
+				case "JOIN":
+					s.Join = true
+				case "RESUME":
+					s.Resume = true
+				case "SUSPEND":
+					s.Suspend = true
+				case "MIGRATE":
+					s.ForMigrate = true
+				case "PHASE":
+					s.OnePhase = true
+				case "XID":
+					s.ConvertXid = true
+				}
 			}
 		}
 		tokenList.Reset(endPos)
@@ -25,9 +25,10 @@ var parseList = []func(sql string) (MySQLToken, error, string){
 	NewMySQLStringToken,
 	NewMySQLQuotedIdentifierToken,
 	NewMySQLOperatorToken,
-	NewMySQLNumericToken,
+	NewMySQLParamMarkerToken,
 	NewMySQLHexadecimalToken,
 	NewMySQLBitToken,
+	NewMySQLNumericToken,
 	NewMySQLVariableToken,
 	NewMySQLKeywordToken,
 	NewMySQLUnquotedIdentifierToken,
@@ -282,7 +283,7 @@ func NewMySQLHexadecimalToken(sql string) (MySQLToken, error, string) {
 	if err != nil {
 		return nil, err, sql
 	}
-	dRegex, err := regexp.Compile("^0x([0-9A-Fa-f][0-9A-Fa-f])+")
+	dRegex, err := regexp.Compile("^0x[0-9A-Fa-f]+")
 	if err != nil {
 		return nil, err, sql
 	}
@@ -311,21 +312,22 @@ func (t *MySQLHexadecimalToken) Type() string {
 }
 
 type MySQLKeywordToken struct {
-	value string
+	value  string
+	origin string
 }
 
 var (
 	Keywords = []string{
 		"ABS", "ACOS", "ACTION", "ADDDATE", "ADDTIME",
 		"AES_DECRYPT", "AES_ENCRYPT", "AFTER", "AGAINST", "AGGREGATE",
-		"ALGORITHM", "ANY", "ASCII", "ASIN", "AT",
+		"ALGORITHM", "ALWAYS", "ANY", "ASCII", "ASIN", "AT",
 		"ATAN", "ATAN2", "AUTHORS", "AUTO_INCREMENT", "AUTOEXTEND_SIZE",
 		"AVG", "AVG_ROW_LENGTH", "BACKUP", "BEGIN", "BENCHMARK",
 		"BIN", "BINLOG", "BIT", "BIT_AND", "BIT_COUNT",
 		"BIT_LENGTH", "BIT_OR", "BIT_XOR", "BLOCK", "BOOL",
-		"BOOLEAN", "BTREE", "BYTE", "CACHE", "CASCADED",
+		"BOOLEAN", "BTREE", "BUCKETS", "BYTE", "CACHE", "CASCADED",
 		"CAST", "CATALOG_NAME", "CCONCAT_WS", "CEIL", "CEILING",
-		"CHAIN", "CHANGED", "CHAR_LENGTH", "CHARACTER_LENGTH", "CHARSET",
+		"CHAIN", "CHANGED", "CHANNEL", "CHAR_LENGTH", "CHARACTER_LENGTH", "CHARSET",
 		"CHECKSUM", "CIPHER", "CLASS_ORIGIN", "CLIENT", "CLOSE",
 		"COALESCE", "CODE", "COERCIBILITY", "COLLATION", "COLUMN_NAME",
 		"COLUMNS", "COMMENT", "COMMIT", "COMMITTED", "COMPACT",
@@ -333,32 +335,32 @@ var (
 		"CONNECTION", "CONNECTION_ID", "CONSISTENT", "CONSTRAINT_CATALOG", "CONSTRAINT_NAME",
 		"CONSTRAINT_SCHEMA", "CONTAINS", "CONTEXT", "CONTRIBUTORS", "CONV",
 		"CONVERT_TZ", "COS", "COT", "COUNT", "CPU",
-		"CRC32", "CUBE", "CURDATE", "CURSOR_NAME", "CURTIME",
+		"CRC32", "CUBE", "CURDATE", "CURRENT", "CURSOR_NAME", "CURTIME",
 		"DATA", "DATAFILE", "DATE", "DATE_ADD", "DATE_FORMAT",
 		"DATE_SUB", "DATEDIFF", "DATETIME", "DAY", "DAYNAME",
 		"DAYOFMONTH", "DAYOFWEEK", "DAYOFYEAR", "DEALLOCATE", "DECODE",
 		"DEFINER", "DEGREES", "DELAY_KEY_WRITE", "DES_DECRYPT", "DES_ENCRYPT",
 		"DES_KEY_FILE", "DIRECTORY", "DISABLE", "DISCARD", "DISK",
-		"DO", "DUMPFILE", "DUPLICATE", "DYNAMIC", "ELT",
-		"ENABLE", "ENCODE", "ENCRYPT", "END", "ENDS",
+		"DO", "DUMPFILE", "DUPLICATE", "DYNAMIC", "ELT", "EMPTY",
+		"ENABLE", "ENCODE", "ENCRYPT", "END", "ENDS", "ENFORCED",
 		"ENGINE", "ENGINES", "ENUM", "ERROR", "ERRORS",
 		"ESCAPE", "EVENT", "EVENTS", "EVERY", "EXECUTE",
-		"EXP", "EXPANSION", "EXPORT_SET", "EXTENDED", "EXTENT_SIZE",
+		"EXP", "EXPANSION", "EXPORT", "EXPORT_SET", "EXTENDED", "EXTENT_SIZE",
 		"EXTRACT", "FAST", "FAULTS", "FIELD", "FIELDS",
-		"FILE", "FIND_IN_SET", "FIRST", "FIXED", "FLOOR",
-		"FLUSH", "FORM_UNIXTIME", "FORMAT", "FOUND", "FOUND_ROWS",
+		"FILE", "FILTER", "FIND_IN_SET", "FIRST", "FIXED", "FLOOR",
+		"FLUSH", "FOLLOWING", "FORM_UNIXTIME", "FORMAT", "FOUND", "FOUND_ROWS",
 		"FRAC_SECOND", "FROM_DAYS", "FULL", "FUNCTION", "GEOMETRY",
 		"GEOMETRYCOLLECTION", "GET_FORMAT", "GET_LOCK", "GLOBAL", "GRANTS",
-		"GROUP_CONCAT", "HANDLER", "HASH", "HELP", "HEX",
+		"GROUP_CONCAT", "HANDLER", "HASH", "HELP", "HEX", "HISTOGRAM",
 		"HOST", "HOSTS", "HOUR", "IDENTIFIED", "IFNULL",
 		"IGNORE_SERVER_IDS", "IMPORT", "INDEXES", "INET_ATON", "INET_NTOA",
 		"INITIAL_SIZE", "INNOBASE", "INNODB", "INSERT_METHOD", "INSTALL",
-		"INSTR", "INTERNAL", "INTO", "INVOKER", "IO", "IO_THREAD",
-		"IPC", "IS_FREE_LOCK", "IS_USED_LOCK", "ISOLATION", "ISSUER",
+		"INSTR", "INTERNAL", "INVISIBLE", "INVOKER", "IO", "IO_THREAD",
+		"IPC", "IS_FREE_LOCK", "IS_USED_LOCK", "ISOLATION", "ISSUER", "JSON",
 		"KEY_BLOCK_SIZE", "LANGUAGE", "LAST", "LAST_DAY", "LAST_INSERT_ID",
 		"LCASE", "LEAVES", "LENGTH", "LESS", "LEVEL",
 		"LINESTRING", "LIST", "LN", "LOAD_FILE", "LOCAL",
-		"LOCATE", "LOCKS", "LOG", "LOG10", "LOG2",
+		"LOCATE", "LOCKED", "LOCKS", "LOG", "LOG10", "LOG2",
 		"LOGFILE", "LOGS", "LOWER", "LPAD", "LTRIM",
 		"MAKE_SET", "MAKEDATE", "MAKETIME", "MASTER", "MASTER_CONNECT_RETRY",
 		"MASTER_HEARTBEAT_PERIOD", "MASTER_HOST", "MASTER_LOG_FILE", "MASTER_LOG_POS", "MASTER_PASSWORD",
@@ -370,31 +372,31 @@ var (
 		"MIN", "MIN_ROWS", "MINUTE", "MODE", "MODIFY",
 		"MONTH", "MONTHNAME", "MULTILINESTRING", "MULTIPOINT", "MULTIPOLYGON",
 		"MUTEX", "MYSQL_ERRNO", "NAME", "NAME_CONST", "NAMES",
-		"NATIONAL", "NCHAR", "NDB", "NDBCLUSTER", "NEW",
+		"NATIONAL", "NCHAR", "NDB", "NDBCLUSTER", "NESTED", "NEW",
 		"NEXT", "NO", "NO_WAIT", "NODEGROUP", "NONE",
-		"NOW", "NULLIF", "NVARCHAR", "OCT", "OCTET_LENGTH",
-		"OFFSET", "OJ", "OLD_PASSWORD", "ONE", "ONE_SHOT",
-		"OPEN", "OPTIONS", "ORD", "OWNER", "PACK_KEYS",
+		"NOW", "NOWAIT", "NULLIF", "NVARCHAR", "OCT", "OCTET_LENGTH",
+		"OFFSET", "OJ", "OLD_PASSWORD", "ONE", "ONE_SHOT", "ONLY",
+		"OPEN", "OPTIMIZER_COSTS", "OPTIONS", "ORD", "ORDINALITY", "OWNER", "PACK_KEYS",
 		"PAGE", "PARSER", "PARTIAL", "PARTITION", "PARTITIONING",
-		"PARTITIONS", "PASSWORD", "PERIOD_ADD", "PERIOD_DIFF", "PHASE",
+		"PARTITIONS", "PASSWORD", "PATH", "PERIOD_ADD", "PERIOD_DIFF", "PERSIST", "PERSIST_ONLY", "PHASE",
 		"PI", "PLUGIN", "PLUGINS", "POINT", "POLYGON", "PORT",
-		"POSITION", "POW", "POWER", "PREPARE", "PRESERVE",
+		"POSITION", "POW", "POWER", "PRECEDING", "PREPARE", "PRESERVE",
 		"PREV", "PRIVILEGES", "PROCESSLIST", "PROFILE", "PROFILES",
 		"PROXY", "QUARTER", "QUERY", "QUICK", "QUOTE",
-		"RADIANS", "RAND", "READ_ONLY", "REBUILD", "RECOVER",
+		"RADIANS", "RAND", "RANDOM", "READ_ONLY", "REBUILD", "RECOVER",
 		"REDO_BUFFER_SIZE", "REDOFILE", "REDUNDANT", "RELAY", "RELAY_LOG_FILE",
 		"RELAY_LOG_POS", "RELAY_THREAD", "RELAYLOG", "RELEASE_LOCK", "RELOAD",
-		"REMOVE", "REORGANIZE", "REPAIR", "REPEATABLE", "REPLICATION",
-		"RESET", "RESTORE", "RESUME", "RETURNS", "REVERSE",
+		"REMOVE", "REORGANIZE", "REPAIR", "REPEATABLE", "REPLICA", "REPLICATION",
+		"RESET", "RESTORE", "RESUME", "RETAIN", "RETURNS", "REVERSE",
 		"ROLLBACK", "ROLLUP", "ROUND", "ROUTINE", "ROW",
 		"ROW_COUNT", "ROW_FORMAT", "ROWS", "RPAD", "RTREE",
 		"RTRIM", "SAVEPOINT", "SCHEDULE", "SCHEMA_NAME", "SECOND",
 		"SECURITY", "SERIAL", "SERIALIZABLE", "SERVER", "SESSION",
 		"SESSION_USER", "SET_TO_TIME", "SHA", "SHA1", "SHA2",
 		"SHARE", "SHUTDOWN", "SIGN", "SIGNED", "SIMPLE",
-		"SIN", "SLAVE", "SLEEP", "SNAPSHOT", "SOCKET",
+		"SIN", "SKIP", "SLAVE", "SLEEP", "SNAPSHOT", "SOCKET",
 		"SOME", "SONAME", "SOUNDEX", "SOUNDS", "SOURCE",
-		"SPACE", "SQL_BUFFER_RESULT", "SQL_CACHE", "SQL_NO_CACHE", "SQL_THREAD",
+		"SPACE", "SQL_AFTER_MTS_GAPS", "SQL_BUFFER_RESULT", "SQL_CACHE", "SQL_NO_CACHE", "SQL_THREAD",
 		"SQL_TSI_DAY", "SQL_TSI_FRAC_SECOND", "SQL_TSI_HOUR", "SQL_TSI_MINUTE", "SQL_TSI_MONTH",
 		"SQL_TSI_QUARTER", "SQL_TSI_SECOND", "SQL_TSI_WEEK", "SQL_TSI_YEAR", "SQRT",
 		"START", "STARTS", "STATUS", "STD", "STDDEV",
@@ -407,14 +409,14 @@ var (
 		"THAN", "TIME", "TIME_FORMAT", "TIME_TO_SEC", "TIMEDIFF",
 		"TIMESTAMP", "TIMESTAMPADD", "TIMESTAMPDIFF", "TO_DAYS", "TO_SECONDS",
 		"TRANSACTION", "TRIGGERS", "TRIM", "TRUNCATE", "TYPE",
-		"TYPES", "UCASE", "UNCOMMITTED", "UNCOMPRESS", "UNCOMPRESSED_LENGTH",
+		"TYPES", "UCASE", "UNBOUNDED", "UNCOMMITTED", "UNCOMPRESS", "UNCOMPRESSED_LENGTH",
 		"UNDEFINED", "UNDO_BUFFER_SIZE", "UNDOFILE", "UNHEX", "UNICODE",
 		"UNINSTALL", "UNIX_TIMESTAMP", "UNKNOWN", "UNTIL", "UPGRADE",
 		"UPPER", "USE_FRM", "USER", "USER_RESOURCES", "UUID",
 		"UUID_SHORT", "VALUE", "VAR_POP", "VAR_SAMP", "VARIABLES",
-		"VARIANCE", "VERSION", "VIEW", "WAIT", "WARNINGS",
+		"VARIANCE", "VERSION", "VIEW", "VISIBLE", "WAIT", "WARNINGS",
 		"WEEK", "WEEKDAY", "WEEKOFYEAR", "WORK", "WRAPPER",
-		"X509", "XA", "XML", "YEAR", "YEARWEEK",
+		"X509", "XA", "XID", "XML", "YEAR", "YEARWEEK",
 	}
 	reservedKeywords = []string{
 		"ACCESSIBLE", "ADD", "ALL", "ALTER", "ANALYZE",
@@ -423,45 +425,45 @@ var (
 		"BY", "CALL", "CASCADE", "CASE", "CHANGE",
 		"CHAR", "CHARACTER", "CHECK", "COLLATE", "COLUMN",
 		"CONDITION", "CONSTRAINT", "CONTINUE", "CONVERT", "CREATE",
-		"CROSS", "CURRENT_DATE", "CURRENT_TIME", "CURRENT_TIMESTAMP", "CURRENT_USER",
+		"CROSS", "CUME_DIST", "CURRENT_DATE", "CURRENT_TIME", "CURRENT_TIMESTAMP", "CURRENT_USER",
 		"CURSOR", "DATABASE", "DATABASES", "DAY_HOUR", "DAY_MICROSECOND",
 		"DAY_MINUTE", "DAY_SECOND", "DEC", "DECIMAL", "DECLARE",
-		"DEFAULT", "DELAYED", "DELETE", "DESC", "DESCRIBE",
+		"DEFAULT", "DELAYED", "DELETE", "DENSE_RANK", "DESC", "DESCRIBE",
 		"DETERMINISTIC", "DISTINCT", "DISTINCTROW", "DIV", "DOUBLE",
 		"DROP", "DUAL", "EACH", "ELSE", "ELSEIF",
 		"ENCLOSED", "ESCAPED", "EXISTS", "EXIT", "EXPLAIN",
-		"FALSE", "FETCH", "FLOAT", "FLOAT4", "FLOAT8",
+		"FALSE", "FETCH", "FIRST_VALUE", "FLOAT", "FLOAT4", "FLOAT8",
 		"FOR", "FORCE", "FOREIGN", "FROM", "FULLTEXT",
-		"GENERAL", "GRANT", "GROUP", "HAVING", "HIGH_PRIORITY",
+		"GENERAL", "GENERATED", "GRANT", "GROUP", "HAVING", "HIGH_PRIORITY",
 		"HOUR_MICROSECOND", "HOUR_MINUTE", "HOUR_SECOND", "IF", "IGNORE",
 		"IN", "INDEX", "INFILE", "INNER", "INOUT",
 		"INSENSITIVE", "INSERT", "INT", "INT1", "INT2",
 		"INT3", "INT4", "INT8", "INTERGER", "INTERVAL",
-		"INFO", "IS", "ITERATE", "JOIN", "KEY",
-		"KEYS", "KILL", "LEADING", "LEAVE", "LEFT",
+		"INTO", "IS", "ITERATE", "JOIN", "JSON_TABLE", "KEY",
+		"KEYS", "KILL", "LAG", "LAST_VALUE", "LEAD", "LEADING", "LEAVE", "LEFT",
 		"LIKE", "LIMIT", "LINEAR", "LINES", "LOAD",
 		"LOCALTIME", "LOCALTIMESTAMP", "LOCK", "LONG", "LONGBLOB",
 		"LONGTEXT", "LOOP", "LOW_PRIORITY", "MASTER_SSL_VERIFY_SERVER_CERT", "MATCH",
 		"MAXVALUE", "MEDIUMBLOB", "MEDIUMINT", "MEDIUMTEXT", "MIDDLEINT",
 		"MINUTE_MICROSECOND", "MINUTE_SECOND", "MOD", "MODIFIES", "NATURAL",
-		"NOT", "NO_WRITE_TO_BINLOG", "NULL", "NUMERIC", "ON",
+		"NOT", "NO_WRITE_TO_BINLOG", "NTH_VALUE", "NTILE", "NULL", "NUMERIC", "OF", "ON",
 		"OPTIMIZE", "OPTION", "OPTIONALLY", "OR", "ORDER",
-		"OUT", "OUTER", "OUTFILE", "PRECISION", "PRIMARY",
-		"PROCEDURE", "PURGE", "RANGE", "READ", "READS",
+		"OUT", "OUTER", "OUTFILE", "OVER", "PERCENT_RANK", "PRECISION", "PRIMARY",
+		"PROCEDURE", "PURGE", "RANGE", "RANK", "READ", "READS",
 		"READ_WRITE", "REAL", "REFERENCES", "REGEXP", "RELEASE",
 		"RENAME", "REPEAT", "REPLACE", "REQUIRE", "RESIGNAL",
-		"RESTRICT", "RETURN", "REVOKE", "RIGHT", "RLIKE",
+		"RESTRICT", "RETURN", "REVOKE", "RIGHT", "RLIKE", "ROW_NUMBER",
 		"SCHEMA", "SCHEMAS", "SECOND_MICROSECOND", "SELECT", "SENSITIVE",
 		"SEPARATOR", "SET", "SHOW", "SIGNAL", "SLOW",
 		"SMALLINT", "SPATIAL", "SPECIFIC", "SQL", "SQLEXCEPTION",
 		"SQLSTATE", "SQLWARNING", "SQL_BIG_RESULT", "SQL_CALC_FOUND_ROWS", "SQL_SMALL_RESULT",
-		"SSL", "STARTING", "STRAIGHT_JOIN", "TABLE", "TERMINATED",
+		"SSL", "STARTING", "STORED", "STRAIGHT_JOIN", "TABLE", "TERMINATED",
 		"THEN", "TINYBLOB", "TINYINT", "TINYTEXT", "TO",
 		"TRAILING", "TRIGGER", "TRUE", "UNDO", "UNION",
 		"UNIQUE", "UNLOCK", "UNSIGNED", "UPDATE", "USAGE",
 		"USE", "USING", "UTC_DATE", "UTC_TIME", "UTC_TIMESTAMP",
-		"VALUES", "VARBINARY", "VARCHAR", "VARCHARACTER", "VARYING",
-		"WHEN", "WHERE", "WHILE", "WITH", "WRITE",
+		"VALUES", "VARBINARY", "VARCHAR", "VARCHARACTER", "VARYING", "VIRTUAL",
+		"WHEN", "WHERE", "WHILE", "WINDOW", "WITH", "WRITE",
 		"XOR", "YEAR_MONTH", "ZEROFILL",
 	}
 )
@@ -478,7 +480,8 @@ func NewMySQLKeywordToken(sql string) (MySQLToken, error, string) {
 	token := MySQLKeywordToken{}
 	reservedKeywordsTest := reservedKeywordsRegex.MatchString(sql)
 	if reservedKeywordsTest {
-		token.value = strings.ToUpper(reservedKeywordsRegex.FindStringSubmatch(sql)[0])
+		token.origin = reservedKeywordsRegex.FindStringSubmatch(sql)[0]
+		token.value = strings.ToUpper(token.origin)
 		sql = sql[len(token.value):]
 		return &token, nil, sql
 	}
@@ -488,7 +491,8 @@ func NewMySQLKeywordToken(sql string) (MySQLToken, error, string) {
 	}
 	keywordsTest := keywordsRegex.MatchString(sql)
 	if keywordsTest {
-		token.value = strings.ToUpper(keywordsRegex.FindStringSubmatch(sql)[0])
+		token.origin = keywordsRegex.FindStringSubmatch(sql)[0]
+		token.value = strings.ToUpper(token.origin)
 		sql = sql[len(token.value):]
 		return &token, nil, sql
 	}
@@ -507,7 +511,8 @@ func NewMySQLKeywordTokenByExpectKeywords(sql string, expectKeywords []string) (
 	token := MySQLKeywordToken{}
 	expectKeywordsTest := expectKeywordsRegex.MatchString(sql)
 	if expectKeywordsTest {
-		token.value = strings.ToUpper(expectKeywordsRegex.FindStringSubmatch(sql)[0])
+		token.origin = expectKeywordsRegex.FindStringSubmatch(sql)[0]
+		token.value = strings.ToUpper(token.origin)
 		sql = sql[len(token.value):]
 		return &token, nil, sql
 	}
@@ -522,6 +527,11 @@ func (t *MySQLKeywordToken) Type() string {
 	return "MySQLKeywordToken"
 }
 
+// OriginValue 关键字在SQL中的原始写法，Value为其大写形式
+func (t *MySQLKeywordToken) OriginValue() string {
+	return t.origin
+}
+
 type MySQLNullToken struct {
 	value string
 }
@@ -531,7 +541,7 @@ func NewMySQLNullToken(sql string) (MySQLToken, error, string) {
 		return nil, nil, sql
 	}
 	token := MySQLNullToken{}
-	if sql[:2] == "\\N" {
+	if strings.HasPrefix(sql, "\\N") {
 		token.value = "\\N"
 		sql = sql[2:]
 		return &token, nil, sql
@@ -599,9 +609,9 @@ var (
 		"&&", "&", "||", "|", "~",
 		"<<", "<=>", ">>", "<=", ">=",
 		"<>", ">", "<", "!=", "!",
-		"+", "-", "*", "/", "^",
-		"%", "=", ":=", "(", ")",
-		".",
+		"+", "->>", "->", "-", "*",
+		"/", "^", "%", "=", ":=",
+		"(", ")", ".",
 	}
 )
 
@@ -633,6 +643,28 @@ func (t *MySQLOperatorToken) Type() string {
 	return "MySQLOperatorToken"
 }
 
+type MySQLParamMarkerToken struct {
+	value string
+}
+
+func NewMySQLParamMarkerToken(sql string) (MySQLToken, error, string) {
+	if sql[0] != '?' {
+		return nil, nil, sql
+	}
+	token := MySQLParamMarkerToken{}
+	token.value = sql[:1]
+	sql = sql[1:]
+	return &token, nil, sql
+}
+
+func (t *MySQLParamMarkerToken) Value() string {
+	return t.value
+}
+
+func (t *MySQLParamMarkerToken) Type() string {
+	return "MySQLParamMarkerToken"
+}
+
 type MySQLQuotedIdentifierToken struct {
 	value string
 }
@@ -703,11 +735,11 @@ func NewMySQLStringToken(sql string) (MySQLToken, error, string) {
 	if sql[0] != 'N' && sql[0] != '"' && sql[0] != '\'' {
 		return nil, nil, sql
 	}
-	singleQuotesRegex, err := regexp.Compile("(?i)^N?(''|'.*?[^\\\\]')")
+	singleQuotesRegex, err := regexp.Compile("(?is)^N?'(''|\\\\.|[^'\\\\])*'")
 	if err != nil {
 		return nil, err, sql
 	}
-	doubleQuotesRegex, err := regexp.Compile("(?is)^N?(\"\"|\".*?[^\\\\]\")")
+	doubleQuotesRegex, err := regexp.Compile("(?is)^N?\"(\"\"|\\\\.|[^\"\\\\])*\"")
 	if err != nil {
 		return nil, err, sql
 	}
@@ -772,7 +804,7 @@ func NewMySQLVariableToken(sql string) (MySQLToken, error, string) {
 	if sql[0] != '@' {
 		return nil, nil, sql
 	}
-	singleQuotesRegex, err := regexp.Compile("(?i)^@'(''|\\\\|\\'|[^'])*'")
+	singleQuotesRegex, err := regexp.Compile("(?i)^@'(''|\\\\.|[^'\\\\])*'")
 	if err != nil {
 		return nil, err, sql
 	}
@@ -788,7 +820,7 @@ func NewMySQLVariableToken(sql string) (MySQLToken, error, string) {
 	if err != nil {
 		return nil, err, sql
 	}
-	systemRegex, err := regexp.Compile("^@@(global\\.|session\\.)?[a-zA-Z-_]+")
+	systemRegex, err := regexp.Compile("(?i)^@@((global|session|local|persist|persist_only)\\.)?[0-9a-z_$]+")
 	if err != nil {
 		return nil, err, sql
 	}
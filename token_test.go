@@ -56,6 +56,7 @@ func Test_Hexadecimal(t *testing.T) {
 		"0xa7cd":  "0xa7cd",
 		"X'89a1'": "X'89a1'",
 		"0xa7beq": "0xa7be",
+		"0x123":   "0x123",
 	}
 	tokenTestTemplate(t, NewMySQLHexadecimalToken, sqlmap)
 }
@@ -70,11 +71,32 @@ func Test_Keyword(t *testing.T) {
 	tokenTestTemplate(t, NewMySQLKeywordToken, sqlmap)
 }
 
+func Test_Keyword_Origin(t *testing.T) {
+	token, err, _ := NewMySQLKeywordToken("rank FROM t")
+	if err != nil || token.Value() != "RANK" || token.(*MySQLKeywordToken).OriginValue() != "rank" {
+		t.Errorf("Got unexpected keyword: %+v, %+v", token, err)
+	}
+	sqlList := []string{
+		"SELECT rank FROM t",
+		"SELECT t.lag, Row_Number FROM t WHERE lead > 1 ORDER BY rank DESC",
+		"SELECT RANK() OVER (ORDER BY rank) FROM t",
+	}
+	for _, sql := range sqlList {
+		statementList, err := Parse(sql)
+		if err != nil {
+			t.Errorf("%s: Error: %+v", sql, err)
+		} else if len(statementList) != 1 || statementList[0].Value() != sql {
+			t.Errorf("Respect: %s, Got: %+v", sql, statementList)
+		}
+	}
+}
+
 func Test_Null(t *testing.T) {
 	sqlmap := map[string]string{
 		"\\N":    "\\N",
 		"null":   "NULL",
 		"NULLIF": "",
+		"n":      "",
 	}
 	tokenTestTemplate(t, NewMySQLNullToken, sqlmap)
 }
@@ -94,10 +116,21 @@ func Test_Operator(t *testing.T) {
 		"(abcd + 1)": "(",
 		"|| a":       "||",
 		"|a":         "|",
+		"->'$.a'":    "->",
+		"->>'$.a'":   "->>",
 	}
 	tokenTestTemplate(t, NewMySQLOperatorToken, sqlmap)
 }
 
+func Test_Param_Marker(t *testing.T) {
+	sqlmap := map[string]string{
+		"?":     "?",
+		"?, ?)": "?",
+		"abc ?": "",
+	}
+	tokenTestTemplate(t, NewMySQLParamMarkerToken, sqlmap)
+}
+
 func Test_Quoted_Identifier(t *testing.T) {
 	sqlmap := map[string]string{
 		"`abc`": "`abc`",
@@ -118,13 +151,15 @@ func Test_Space(t *testing.T) {
 
 func Test_String(t *testing.T) {
 	sqlmap := map[string]string{
-		"\"\"":       "\"\"",
-		"\"abc\"":    "\"abc\"",
-		"\"abc\"def": "\"abc\"",
-		"'abc'":      "'abc'",
-		"'abc'd":     "'abc'",
-		"'',''":      "''",
-		"'\\'',''":   "'\\''",
+		"\"\"":        "\"\"",
+		"\"abc\"":     "\"abc\"",
+		"\"abc\"def":  "\"abc\"",
+		"'abc'":       "'abc'",
+		"'abc'd":      "'abc'",
+		"'',''":       "''",
+		"'\\'',''":    "'\\''",
+		"'it''s',''":  "'it''s'",
+		"\"a\"\"b\"c": "\"a\"\"b\"",
 	}
 	tokenTestTemplate(t, NewMySQLStringToken, sqlmap)
 }
@@ -1,6 +1,10 @@
 package mysqlparser_go
 
-import "reflect"
+import (
+	"encoding/hex"
+	"reflect"
+	"strings"
+)
 
 // InArray 判断是否在数组中
 func InArray(needle interface{}, haystack interface{}) (exists bool) {
@@ -20,3 +24,98 @@ func InArray(needle interface{}, haystack interface{}) (exists bool) {
 
 	return exists
 }
+
+// unquoteString 去除字符串两端的引号并还原转义字符
+func unquoteString(str string) string {
+	if len(str) > 0 && (str[0] == 'N' || str[0] == 'n') {
+		str = str[1:]
+	}
+	if len(str) < 2 {
+		return str
+	}
+	quote := str[0]
+	str = str[1 : len(str)-1]
+	str = strings.ReplaceAll(str, string([]byte{quote, quote}), string(quote))
+	var builder strings.Builder
+	for i := 0; i < len(str); i++ {
+		if str[i] != '\\' || i == len(str)-1 {
+			builder.WriteByte(str[i])
+			continue
+		}
+		i++
+		switch str[i] {
+		case '0':
+			builder.WriteByte(0)
+		case 'b':
+			builder.WriteByte('\b')
+		case 'n':
+			builder.WriteByte('\n')
+		case 'r':
+			builder.WriteByte('\r')
+		case 't':
+			builder.WriteByte('\t')
+		case 'Z':
+			builder.WriteByte(26)
+		default:
+			builder.WriteByte(str[i])
+		}
+	}
+	return builder.String()
+}
+
+// unquoteHexadecimal 将X'...'或0x...形式的十六进制字面量还原为其表示的字节串，格式不正确时原样返回
+func unquoteHexadecimal(str string) string {
+	digits := str
+	if len(str) >= 3 && (str[0] == 'X' || str[0] == 'x') && str[1] == '\'' {
+		digits = str[2 : len(str)-1]
+	} else if len(str) >= 2 && str[0] == '0' && str[1] == 'x' {
+		digits = str[2:]
+	}
+	if len(digits)%2 == 1 {
+		digits = "0" + digits
+	}
+	value, err := hex.DecodeString(digits)
+	if err != nil {
+		return str
+	}
+	return string(value)
+}
+
+// getObjectList 获取组件或语句的对象列表，token返回nil
+func getObjectList(t *MySQLObject) []*MySQLObject {
+	if container, ok := (*t).(interface{ GetObjectList() []*MySQLObject }); ok {
+		return container.GetObjectList()
+	}
+	return nil
+}
+
+// walkObjectList 深度优先遍历对象及其子对象，visit返回false时不再遍历该对象的子对象
+func walkObjectList(objectList []*MySQLObject, visit func(t *MySQLObject) bool) {
+	for _, t := range objectList {
+		if visit(t) {
+			walkObjectList(getObjectList(t), visit)
+		}
+	}
+}
+
+// walkStatement 遍历语句本身及其包含的全部对象
+func walkStatement(s MySQLStatement, visit func(t *MySQLObject) bool) {
+	obj := s.(MySQLObject)
+	walkObjectList([]*MySQLObject{&obj}, visit)
+}
+
+// getValidObjectList 去除对象列表中的空白和注释
+func getValidObjectList(objectList []*MySQLObject) []*MySQLObject {
+	validList := make([]*MySQLObject, 0)
+	for _, t := range objectList {
+		if (*t).Type() != "MySQLSpaceToken" && (*t).Type() != "MySQLCommentToken" {
+			validList = append(validList, t)
+		}
+	}
+	return validList
+}
+
+// isKeywordToken 判断对象是否为valueList中的关键字
+func isKeywordToken(t *MySQLObject, valueList ...string) bool {
+	return (*t).Type() == "MySQLKeywordToken" && InArray((*t).Value(), valueList)
+}